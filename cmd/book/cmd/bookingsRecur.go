@@ -0,0 +1,161 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/ory/viper"
+	apiclient "github.com/practable/book/internal/client/client"
+	"github.com/practable/book/internal/client/client/admin"
+	"github.com/practable/book/internal/client/models"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// bookingsRecurCmd represents the recurring bookings command
+var bookingsRecurCmd = &cobra.Command{
+	Use:   "recur",
+	Short: "Make a recurring booking in the booking server",
+	Long: `Make a recurring booking in the booking server, e.g. for a class
+that uses the same kit every week of a term. Either all the occurrences
+are booked, or none are, in which case the occurrences that could not
+be booked are listed.
+
+example usage:
+export BOOK_CLIENT_HOST=example.org
+export BOOK_CLIENT_BASE_PATH=/book/api/v1
+export BOOK_CLIENT_SCHEME=http
+export BOOK_CLIENT_TOKEN=$secret
+export BOOK_CLIENT_FORMAT=yaml
+book bookings recur recurring.yaml
+
+The recurring booking must be in a file, in yaml format, e.g.
+
+slot: sl-a
+user: staff-class-a
+when:
+  start: 2022-11-08T10:00:00Z
+  end: 2022-11-08T12:00:00Z
+recurrence:
+  frequency: weekly
+  time_zone: Europe/London
+  until: 2022-12-13T12:00:00Z
+  except:
+  - 2022-11-22T00:00:00Z
+
+The bookings that are made are printed to stdout.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK_CLIENT")
+		viper.AutomaticEnv()
+		viper.SetDefault("host", "book.practable.io")
+		viper.SetDefault("scheme", "https")
+		viper.SetDefault("format", "yaml")
+		viper.SetDefault("base_path", "/api/v1")
+
+		basePath := viper.GetString("base_path")
+		host := viper.GetString("host")
+		scheme := viper.GetString("scheme")
+		token := viper.GetString("token")
+		format := strings.ToLower(viper.GetString("format"))
+
+		if token == "" {
+			fmt.Println("BOOK_CLIENT_TOKEN not set")
+			os.Exit(1)
+		}
+
+		switch format {
+		case "json", "yaml", "yml":
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		if len(os.Args) < 4 {
+			fmt.Println("usage: book bookings recur <file.yaml>")
+			os.Exit(1)
+		}
+
+		f := os.Args[3]
+		rby, err := ioutil.ReadFile(f)
+		if err != nil {
+			fmt.Printf("Error: failed to read recurring booking from file %s because %s\n", f, err.Error())
+			os.Exit(1)
+		}
+
+		var rb models.RecurringBooking
+		err = yaml.Unmarshal(rby, &rb)
+		if err != nil {
+			fmt.Printf("Error: failed to parse recurring booking because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		cfg := apiclient.DefaultTransportConfig().WithHost(host).WithSchemes([]string{scheme}).WithBasePath(basePath)
+		auth := httptransport.APIKeyAuth("Authorization", "header", token)
+		bc := apiclient.NewHTTPClientWithConfig(nil, cfg)
+		timeout := 10 * time.Second
+
+		params := admin.NewMakeRecurringBookingParams().WithTimeout(timeout).WithRecurringBooking(&rb)
+		status, err := bc.Admin.MakeRecurringBooking(params, auth)
+
+		if c, ok := err.(*admin.MakeRecurringBookingConflict); ok {
+			fmt.Printf("Error: failed to make recurring booking because %s\n", *c.Payload.Message)
+			for _, m := range c.Payload.Errors {
+				fmt.Println(m)
+			}
+			os.Exit(1)
+		}
+
+		if err != nil {
+			fmt.Printf("Error: failed to make recurring booking because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json":
+			mj, err := json.Marshal(status.Payload)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal bookings because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(mj))
+		default:
+			my, err := yaml.Marshal(status.Payload)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal bookings because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(my))
+		}
+
+		os.Exit(0)
+
+	},
+}
+
+func init() {
+	bookingsCmd.AddCommand(bookingsRecurCmd)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ory/viper"
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/store"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+var replayUntil string
+var replayResource string
+
+// replayResult represents the state of the store at the time the replay stopped
+type replayResult struct {
+	Until       time.Time                     `json:"until" yaml:"until"`
+	Locked      bool                          `json:"locked" yaml:"locked"`
+	Message     string                        `json:"message" yaml:"message"`
+	Bookings    map[string]store.Booking      `json:"bookings" yaml:"bookings"`
+	OldBookings map[string]store.Booking      `json:"old_bookings" yaml:"old_bookings"`
+	Users       map[string]store.UserExternal `json:"users" yaml:"users"`
+	// Diary holds the current bookings for the resource, if one was specified
+	Diary []store.Booking `json:"diary,omitempty" yaml:"diary,omitempty"`
+}
+
+// historyReplayCmd represents the history replay command
+var historyReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Rebuild the bookings and users as they were at a given time",
+	Long: `Rebuild the bookings and users as they were at a given time, by replaying 
+the actions in the journal that were issued before that time, e.g. to see 
+whether a booking was cancelled by the user, an admin, or the grace period check.
+
+example usage:
+export BOOK_PERSIST_DIR=/var/lib/book/
+export BOOK_CLIENT_FORMAT=yaml
+book history replay --until 2022-11-05T10:30:00Z
+book history replay --until 2022-11-05T10:30:00Z --resource r-a
+
+If --until is omitted, all actions are replayed. If --resource is given, the 
+bookings in that resource's diary are listed in order of their start time.
+The result is printed to stdout, and can be piped to a file if required.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK")
+		viper.AutomaticEnv()
+		viper.SetDefault("persist_dir", "/var/lib/book/")
+		viper.SetDefault("client_format", "yaml")
+
+		persistDir := viper.GetString("persist_dir")
+		format := strings.ToLower(viper.GetString("client_format"))
+
+		switch format {
+		case "json", "yaml", "yml":
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		until := time.Now()
+
+		if replayUntil != "" {
+			t, err := time.Parse(time.RFC3339, replayUntil)
+			if err != nil {
+				fmt.Printf("Error: specify --until in RFC3339 format, e.g. 2022-11-05T10:30:00Z, because %s\n", err.Error())
+				os.Exit(1)
+			}
+			until = t
+		}
+
+		fn := filepath.Join(persistDir, store.JournalFile)
+
+		actions, err := history.Load(fn)
+
+		if err != nil {
+			fmt.Printf("Error: failed to load journal from %s because %s\n", fn, err.Error())
+			os.Exit(1)
+		}
+
+		h := history.New(fn)
+
+		for _, a := range actions {
+			h.Add(a)
+		}
+
+		s, err, msgs := store.NewFromHistory(h, until)
+
+		if err != nil {
+			// report the problems, but still show the result, because
+			// failed actions are usually of interest in a dispute
+			fmt.Fprintln(os.Stderr, err.Error())
+			for k, v := range msgs {
+				fmt.Fprintln(os.Stderr, strconv.Itoa(k)+": "+v)
+			}
+		}
+
+		r := replayResult{
+			Until:       until,
+			Locked:      s.Locked,
+			Message:     s.Message,
+			Bookings:    s.ExportBookings(),
+			OldBookings: s.ExportOldBookings(),
+			Users:       s.ExportUsers(),
+		}
+
+		if replayResource != "" {
+
+			d, err := s.GetDiaryFor(replayResource)
+
+			if err != nil {
+				fmt.Printf("Error: failed to get diary because %s\n", err.Error())
+				os.Exit(1)
+			}
+
+			r.Diary = d
+		}
+
+		switch format {
+
+		case "json":
+			rj, err := json.Marshal(r)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal replay because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(rj))
+		default:
+			ry, err := yaml.Marshal(r)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal replay because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(ry))
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	historyCmd.AddCommand(historyReplayCmd)
+
+	historyReplayCmd.Flags().StringVar(&replayUntil, "until", "", "replay actions issued before this time (RFC3339), default now")
+	historyReplayCmd.Flags().StringVar(&replayResource, "resource", "", "list the bookings in the diary of this resource")
+}
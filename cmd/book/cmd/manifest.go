@@ -28,8 +28,14 @@ var manifestCmd = &cobra.Command{
 	Short: "Operations on the manifest",
 	Long: `Operations on the booking server manifest include 
 - check a manifest file for correctness  (without affecting the booking server)
+- delete individual entities from the manifest in the booking server
+- diff a manifest file against the manifest in the booking server, reporting affected bookings
+- expand the families in a manifest file (without affecting the booking server)
 - export the manifest from the booking server
+- patch individual entities of the manifest in the booking server
 - replace the manifest in the booking server
+- rollback the manifest in the booking server to an earlier version
+- list the versions of the manifest kept by the booking server
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
@@ -23,8 +23,8 @@ import (
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/practable/book/internal/convert"
 	"github.com/practable/book/internal/store"
-	"gopkg.in/yaml.v2"
 )
 
 // checkCmd represents the check command
@@ -37,7 +37,7 @@ example usage:
 (no environment variables are required for this command)
 book manifest check manifest.yaml
 
-The manifest must be in a file, in yaml format.
+The manifest must be in a file, in yaml format. Any families are expanded before checking.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 
@@ -53,9 +53,7 @@ The manifest must be in a file, in yaml format.
 			os.Exit(1)
 		}
 
-		m := store.Manifest{}
-
-		err = yaml.Unmarshal(mfest, &m)
+		_, m, err := convert.YAMLToManifests(mfest)
 		if err != nil {
 			fmt.Printf("Error: failed to unmarshal manifest from file because %s\n", err.Error())
 			os.Exit(1)
@@ -0,0 +1,98 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cmodels "github.com/practable/book/internal/client/models"
+	"github.com/spf13/cobra"
+)
+
+// manifestDeleteCmd represents the manifest delete command
+var manifestDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete individual entities from the manifest in the booking server",
+	Long: `Delete one or more entities of the same kind from the manifest in the booking server.
+The booking server checks the resulting manifest before making any change, so
+entities that are still referenced by others cannot be deleted on their own, and
+entities that existing bookings depend on cannot be deleted (the bookings are listed).
+
+kind is one of blackout, description, display_guide, group, policy, pool,
+resource, slot, stream, ui, ui_set, window
+
+example usage:
+
+export BOOK_CLIENT_TOKEN=$SECRET
+export BOOK_CLIENT_SCHEME=https
+export BOOK_CLIENT_HOST=example.org
+export BOOK_CLIENT_BASE_PATH=/book/api/v1
+book manifest delete slot sl-old sl-older
+
+To delete entities of more than one kind at once, use book manifest patch.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		if len(os.Args) < 5 {
+			fmt.Println("usage: book manifest delete <kind> <name> [<name>...]")
+			os.Exit(1)
+		}
+
+		kind := os.Args[3]
+		names := os.Args[4:]
+
+		d := cmodels.ManifestDeletions{}
+
+		switch kind {
+		case "blackout":
+			d.Blackouts = names
+		case "description":
+			d.Descriptions = names
+		case "display_guide":
+			d.DisplayGuides = names
+		case "group":
+			d.Groups = names
+		case "policy":
+			d.Policies = names
+		case "pool":
+			d.Pools = names
+		case "resource":
+			d.Resources = names
+		case "slot":
+			d.Slots = names
+		case "stream":
+			d.Streams = names
+		case "ui":
+			d.Uis = names
+		case "ui_set":
+			d.UISets = names
+		case "window":
+			d.Windows = names
+		default:
+			fmt.Println("unknown kind " + kind)
+			os.Exit(1)
+		}
+
+		patchManifest(cmodels.ManifestPatch{Delete: &d})
+
+	},
+}
+
+func init() {
+	manifestCmd.AddCommand(manifestDeleteCmd)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/ory/viper"
+	apiclient "github.com/practable/book/internal/client/client"
+	"github.com/practable/book/internal/client/client/admin"
+	cmodels "github.com/practable/book/internal/client/models"
+	"github.com/practable/book/internal/convert"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// manifestDiffCmd represents the manifest diff command
+var manifestDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare a manifest to the manifest in the booking server",
+	Long: `Compare a manifest to the manifest in the booking server, without changing anything.
+Lists the entities that replacing the manifest would add, change or remove, and every
+existing booking that would reference a missing slot, policy, resource or group, or
+fall outside the periods allowed by its window, with the reasons why.
+
+example usage:
+
+export BOOK_CLIENT_TOKEN=$SECRET
+export BOOK_CLIENT_SCHEME=https
+export BOOK_CLIENT_HOST=example.org
+export BOOK_CLIENT_BASE_PATH=/book/api/v1
+export BOOK_CLIENT_FORMAT=YAML
+book manifest diff manifest.yaml
+
+The manifest must be in a file, default type is YAML. The differences are printed
+to stdout in the same format.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK_CLIENT")
+		viper.AutomaticEnv()
+		viper.SetDefault("host", "localhost")
+		viper.SetDefault("scheme", "http")
+		viper.SetDefault("format", "yaml")
+		viper.SetDefault("base_path", "/api/v1")
+
+		basePath := viper.GetString("base_path")
+		host := viper.GetString("host")
+		scheme := viper.GetString("scheme")
+		token := viper.GetString("token")
+		format := strings.ToLower(viper.GetString("format"))
+
+		if token == "" {
+			fmt.Println("BOOK_CLIENT_TOKEN not set")
+			os.Exit(1)
+		}
+
+		if len(os.Args) < 4 {
+			fmt.Println("usage: book manifest diff <file>")
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json", "yaml", "yml":
+
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		f := os.Args[3]
+		mfest, err := ioutil.ReadFile(f)
+		if err != nil {
+			fmt.Printf("Error: failed to read manifest from file %s because %s\n", f, err.Error())
+			os.Exit(1)
+		}
+
+		clientManifest := cmodels.Manifest{}
+
+		switch format {
+
+		case "yaml", "yml":
+
+			clientManifest, _, err = convert.YAMLToManifests(mfest)
+
+		case "json":
+
+			clientManifest, _, err = convert.JSONToManifests(mfest)
+
+		}
+
+		if err != nil {
+			fmt.Printf("Error: failed to unmarshal manifest into client format for uploading because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		cfg := apiclient.DefaultTransportConfig().WithSchemes([]string{scheme}).WithHost(host).WithBasePath(basePath)
+		auth := httptransport.APIKeyAuth("Authorization", "header", token)
+		bc := apiclient.NewHTTPClientWithConfig(nil, cfg)
+		timeout := 10 * time.Second
+		params := admin.NewDiffManifestParams().WithTimeout(timeout).WithManifest(&clientManifest)
+		status, err := bc.Admin.DiffManifest(params, auth)
+
+		if c, ok := err.(*admin.DiffManifestConflict); ok {
+			fmt.Printf("Error: failed to compare manifest because %s\n", *c.Payload.Message)
+			for _, m := range c.Payload.Errors {
+				fmt.Println(m)
+			}
+			os.Exit(1)
+		}
+
+		if err != nil {
+			fmt.Printf("Error: failed to compare manifest because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json":
+			dj, err := json.Marshal(status.Payload)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal manifest differences because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(dj))
+		default:
+			dy, err := yaml.Marshal(status.Payload)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal manifest differences because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(dy))
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	manifestCmd.AddCommand(manifestDiffCmd)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ory/viper"
+	"github.com/practable/book/internal/convert"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// manifestExpandCmd represents the manifest expand command
+var manifestExpandCmd = &cobra.Command{
+	Use:   "expand",
+	Short: "Expand the families in a manifest",
+	Long: `Expand the families in a manifest into the entities that they generate, without
+affecting the manifest loaded into the booking server. Families save repeating the
+entities of identical kit by hand, e.g. for sixteen pendulums:
+
+families:
+  pendulums:
+    count: 16
+    digits: 2
+    add_slots_to_policies:
+    - p-pendulum
+    template:
+      resources:
+        r-pend{{n}}:
+          description: d-r-pend
+          streams:
+          - st-data
+          - st-video
+          topic_stub: pend{{n}}
+      slots:
+        sl-pend{{n}}:
+          description: d-sl-pend
+          policy: p-pendulum
+          resource: r-pend{{n}}
+          ui_set: us-pend
+          window: w-pend
+
+generates r-pend01 to r-pend16 and sl-pend01 to sl-pend16, and adds the slots to the
+policy p-pendulum. Every entity in the template is generated for each member of the
+family, with {{n}} replaced by its number, in names and values alike. Numbers start
+at one, unless first is set, and are padded with leading zeros to at least digits
+digits. The resources of each member can be added to pools with add_resources_to_pools.
+Generated entities must not have the same name as any other entity of the same kind.
+
+Families are expanded in the same way when checking, diffing or replacing a manifest.
+
+example usage:
+(no environment variables are required for this command)
+export BOOK_CLIENT_FORMAT=YAML
+book manifest expand manifest.yaml
+
+The manifest must be in a file, default type is YAML. The expanded manifest is printed
+to stdout in the same format, and can be piped to a file if required.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK_CLIENT")
+		viper.AutomaticEnv()
+		viper.SetDefault("format", "yaml")
+
+		format := strings.ToLower(viper.GetString("format"))
+
+		if len(os.Args) < 4 {
+			fmt.Println("usage: book manifest expand <file>")
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json", "yaml", "yml":
+
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		f := os.Args[3]
+		mfest, err := ioutil.ReadFile(f)
+		if err != nil {
+			fmt.Printf("Error: failed to read manifest from file %s because %s\n", f, err.Error())
+			os.Exit(1)
+		}
+
+		if format != "json" {
+			mfest, err = yaml.YAMLToJSON(mfest)
+			if err != nil {
+				fmt.Printf("Error: failed to process manifest because %s\n", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		mj, err := convert.ExpandManifest(mfest)
+		if err != nil {
+			fmt.Printf("Error: failed to expand manifest because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		if format == "json" {
+			fmt.Println(string(mj))
+			os.Exit(0)
+		}
+
+		my, err := yaml.JSONToYAML(mj)
+		if err != nil {
+			fmt.Printf("Error: failed to convert expanded manifest to YAML because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		fmt.Println(string(my))
+		os.Exit(0)
+	},
+}
+
+func init() {
+	manifestCmd.AddCommand(manifestExpandCmd)
+}
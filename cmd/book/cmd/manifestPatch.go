@@ -0,0 +1,169 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/ory/viper"
+	apiclient "github.com/practable/book/internal/client/client"
+	"github.com/practable/book/internal/client/client/admin"
+	cmodels "github.com/practable/book/internal/client/models"
+	"github.com/practable/book/internal/convert"
+	"github.com/spf13/cobra"
+)
+
+// manifestPatchCmd represents the manifest patch command
+var manifestPatchCmd = &cobra.Command{
+	Use:   "patch",
+	Short: "Add, update or delete individual entities of the manifest in the booking server",
+	Long: `Add, update or delete individual entities of the manifest in the booking server,
+without replacing the whole manifest. Entities under set are added, or replace the
+existing entity of the same kind and name, then entities listed under delete are
+removed. The booking server checks the resulting manifest before making any change.
+The patch is refused if it would affect any existing bookings, which are listed;
+use book manifest replace with BOOK_CLIENT_RECONCILE to change them too.
+Resources that are kept retain their bookings.
+
+example usage:
+
+export BOOK_CLIENT_TOKEN=$SECRET
+export BOOK_CLIENT_SCHEME=https
+export BOOK_CLIENT_HOST=example.org
+export BOOK_CLIENT_BASE_PATH=/book/api/v1
+export BOOK_CLIENT_FORMAT=YAML
+book manifest patch patch.yaml
+
+The patch must be in a file, default type is YAML, e.g.
+
+set:
+  windows:
+    w-a:
+      allowed:
+      - start: 2022-11-04T00:00:00Z
+        end: 2022-11-06T00:00:00Z
+delete:
+  slots:
+  - sl-old
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK_CLIENT")
+		viper.AutomaticEnv()
+		viper.SetDefault("format", "yaml")
+
+		format := strings.ToLower(viper.GetString("format"))
+
+		if len(os.Args) < 4 {
+			fmt.Println("usage: book manifest patch <file>")
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json", "yaml", "yml":
+
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		f := os.Args[3]
+		pb, err := ioutil.ReadFile(f)
+		if err != nil {
+			fmt.Printf("Error: failed to read manifest patch from file %s because %s\n", f, err.Error())
+			os.Exit(1)
+		}
+
+		var p cmodels.ManifestPatch
+
+		switch format {
+
+		case "yaml", "yml":
+
+			p, err = convert.YAMLToManifestPatch(pb)
+
+		case "json":
+
+			p, err = convert.JSONToManifestPatch(pb)
+
+		}
+
+		if err != nil {
+			fmt.Printf("Error: failed to unmarshal manifest patch into client format for uploading because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		patchManifest(p)
+
+	},
+}
+
+// patchManifest uploads the patch to the booking server, printing the reasons
+// for any failed checks, and exits
+func patchManifest(p cmodels.ManifestPatch) {
+
+	viper.SetEnvPrefix("BOOK_CLIENT")
+	viper.AutomaticEnv()
+	viper.SetDefault("host", "localhost")
+	viper.SetDefault("scheme", "http")
+	viper.SetDefault("base_path", "/api/v1")
+
+	basePath := viper.GetString("base_path")
+	host := viper.GetString("host")
+	scheme := viper.GetString("scheme")
+	token := viper.GetString("token")
+
+	if token == "" {
+		fmt.Println("BOOK_CLIENT_TOKEN not set")
+		os.Exit(1)
+	}
+
+	cfg := apiclient.DefaultTransportConfig().WithSchemes([]string{scheme}).WithHost(host).WithBasePath(basePath)
+	auth := httptransport.APIKeyAuth("Authorization", "header", token)
+	bc := apiclient.NewHTTPClientWithConfig(nil, cfg)
+	timeout := 10 * time.Second
+	params := admin.NewPatchManifestParams().WithTimeout(timeout).WithPatch(&p)
+	_, err := bc.Admin.PatchManifest(params, auth)
+
+	if c, ok := err.(*admin.PatchManifestConflict); ok {
+		fmt.Printf("Error: failed to patch manifest because %s\n", *c.Payload.Message)
+		for _, m := range c.Payload.Errors {
+			fmt.Println(m)
+		}
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Printf("Error: failed to patch manifest because %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	// print nothing so that we can tell successful patching
+	// admin can always export the manifest with a separate command
+
+	os.Exit(0)
+}
+
+func init() {
+	manifestCmd.AddCommand(manifestPatchCmd)
+}
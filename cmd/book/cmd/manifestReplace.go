@@ -17,6 +17,7 @@ along with this program. If not, see <http://www.gnu.org/licenses/>.
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -24,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/ory/viper"
 	apiclient "github.com/practable/book/internal/client/client"
@@ -32,6 +34,7 @@ import (
 	"github.com/practable/book/internal/convert"
 	"github.com/practable/book/internal/store"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
 )
 
 // checkCmd represents the check command
@@ -50,6 +53,18 @@ export BOOK_CLIENT_FORMAT=YAML
 book manifest replace manifest.yaml
 
 The manifest must be in a file, default type is YAML.
+
+Existing bookings that the new manifest affects are left as they are, unless
+BOOK_CLIENT_RECONCILE is set to one of:
+
+keep   : leave the affected bookings as they are
+cancel : cancel the affected bookings, refunding any unused time
+move   : move the affected bookings to a free slot in the same policy,
+         or cancel them if there is none
+
+in which case a report of what was done to each affected booking is printed
+to stdout in the same format as the manifest. Use book manifest diff to see
+which bookings would be affected, before replacing the manifest.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 
@@ -65,6 +80,7 @@ The manifest must be in a file, default type is YAML.
 		scheme := viper.GetString("scheme")
 		token := viper.GetString("token")
 		format := strings.ToLower(viper.GetString("format"))
+		reconcile := strings.ToLower(viper.GetString("reconcile"))
 
 		if token == "" {
 			fmt.Println("BOOK_CLIENT_TOKEN not set")
@@ -129,6 +145,11 @@ The manifest must be in a file, default type is YAML.
 		auth := httptransport.APIKeyAuth("Authorization", "header", token)
 		bc := apiclient.NewHTTPClientWithConfig(nil, cfg)
 		timeout := 10 * time.Second
+
+		if reconcile != "" {
+			reconcileManifest(bc, auth, timeout, &clientManifest, reconcile, format)
+		}
+
 		params := admin.NewReplaceManifestParams().WithTimeout(timeout).WithManifest(&clientManifest)
 		_, err = bc.Admin.ReplaceManifest(params, auth)
 		if err != nil {
@@ -144,6 +165,47 @@ The manifest must be in a file, default type is YAML.
 	},
 }
 
+// reconcileManifest replaces the manifest, reconciling the affected bookings according to the mode,
+// and prints the report in the given format, then exits
+func reconcileManifest(bc *apiclient.Client, auth runtime.ClientAuthInfoWriter, timeout time.Duration, m *cmodels.Manifest, mode, format string) {
+
+	params := admin.NewReconcileManifestParams().WithTimeout(timeout).WithManifest(m).WithMode(mode)
+	status, err := bc.Admin.ReconcileManifest(params, auth)
+
+	if c, ok := err.(*admin.ReconcileManifestConflict); ok {
+		fmt.Printf("Error: failed to replace manifest because %s\n", *c.Payload.Message)
+		for _, m := range c.Payload.Errors {
+			fmt.Println(m)
+		}
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Printf("Error: failed to replace manifest because %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	switch format {
+
+	case "json":
+		rj, err := json.Marshal(status.Payload)
+		if err != nil {
+			fmt.Printf("Error: failed to marshal reconcile report because %s\n", err.Error())
+			os.Exit(1)
+		}
+		fmt.Println(string(rj))
+	default:
+		ry, err := yaml.Marshal(status.Payload)
+		if err != nil {
+			fmt.Printf("Error: failed to marshal reconcile report because %s\n", err.Error())
+			os.Exit(1)
+		}
+		fmt.Println(string(ry))
+	}
+
+	os.Exit(0)
+}
+
 func init() {
 	manifestCmd.AddCommand(manifestReplaceCmd)
 
@@ -0,0 +1,137 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/ory/viper"
+	apiclient "github.com/practable/book/internal/client/client"
+	"github.com/practable/book/internal/client/client/admin"
+	"github.com/spf13/cobra"
+)
+
+// manifestRollbackCmd represents the manifest rollback command
+var manifestRollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Reapply an earlier version of the manifest in the booking server",
+	Long: `Reapply an earlier version of the manifest in the booking server, by replacing the
+current manifest with it. The reapplied manifest becomes the latest version, so a rollback
+can itself be rolled back. Use book manifest versions to list the versions that are kept.
+
+example usage:
+
+export BOOK_CLIENT_TOKEN=$SECRET
+export BOOK_CLIENT_SCHEME=https
+export BOOK_CLIENT_HOST=example.org
+export BOOK_CLIENT_BASE_PATH=/book/api/v1
+book manifest rollback 3
+
+Existing bookings are reconciled with the earlier manifest as for book manifest replace, according
+to BOOK_CLIENT_RECONCILE, which can be keep, cancel or move (default keep). Resources that are kept
+retain their diaries, so existing bookings still prevent their slots being booked twice. A report
+of what was done to each affected booking is printed to stdout in the format set by
+BOOK_CLIENT_FORMAT (default type is YAML).
+
+Setting BOOK_CLIENT_RECONCILE=none replaces the manifest without reconciling, as for book manifest
+replace without BOOK_CLIENT_RECONCILE. This empties the diaries, so slots with existing bookings
+can be booked again, and should only be used if the bookings are to be replaced as well.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK_CLIENT")
+		viper.AutomaticEnv()
+		viper.SetDefault("host", "localhost")
+		viper.SetDefault("scheme", "http")
+		viper.SetDefault("format", "yaml")
+		viper.SetDefault("base_path", "/api/v1")
+		viper.SetDefault("reconcile", "keep")
+
+		basePath := viper.GetString("base_path")
+		host := viper.GetString("host")
+		scheme := viper.GetString("scheme")
+		token := viper.GetString("token")
+		format := strings.ToLower(viper.GetString("format"))
+		reconcile := strings.ToLower(viper.GetString("reconcile"))
+
+		if token == "" {
+			fmt.Println("BOOK_CLIENT_TOKEN not set")
+			os.Exit(1)
+		}
+
+		if len(os.Args) < 4 {
+			fmt.Println("usage: book manifest rollback <version>")
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json", "yaml", "yml":
+
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		version, err := strconv.ParseInt(os.Args[3], 10, 64)
+		if err != nil {
+			fmt.Printf("Error: version must be a number, not %s\n", os.Args[3])
+			os.Exit(1)
+		}
+
+		cfg := apiclient.DefaultTransportConfig().WithSchemes([]string{scheme}).WithHost(host).WithBasePath(basePath)
+		auth := httptransport.APIKeyAuth("Authorization", "header", token)
+		bc := apiclient.NewHTTPClientWithConfig(nil, cfg)
+		timeout := 10 * time.Second
+
+		vparams := admin.NewGetManifestVersionParams().WithTimeout(timeout).WithVersion(version)
+		status, err := bc.Admin.GetManifestVersion(vparams, auth)
+		if err != nil {
+			fmt.Printf("Error: failed to get manifest version %d because %s\n", version, err.Error())
+			os.Exit(1)
+		}
+
+		if status.Payload.Manifest == nil {
+			fmt.Printf("Error: manifest version %d has no manifest\n", version)
+			os.Exit(1)
+		}
+
+		if reconcile != "none" {
+			reconcileManifest(bc, auth, timeout, status.Payload.Manifest, reconcile, format)
+		}
+
+		params := admin.NewReplaceManifestParams().WithTimeout(timeout).WithManifest(status.Payload.Manifest)
+		_, err = bc.Admin.ReplaceManifest(params, auth)
+		if err != nil {
+			fmt.Printf("Error: failed to replace manifest with version %d because %s\n", version, err.Error())
+			os.Exit(1)
+		}
+
+		// print nothing so that we can tell successful replacement, as for book manifest replace without reconciling
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	manifestCmd.AddCommand(manifestRollbackCmd)
+}
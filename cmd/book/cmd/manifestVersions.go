@@ -0,0 +1,118 @@
+/*
+Copyright © 2022 Tim Drysdale <timothy.d.drysdale@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/ory/viper"
+	apiclient "github.com/practable/book/internal/client/client"
+	"github.com/practable/book/internal/client/client/admin"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// manifestVersionsCmd represents the manifest versions command
+var manifestVersionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "List the versions of the manifest kept by the booking server",
+	Long: `List the versions of the manifest kept by the booking server, oldest first,
+with when each was applied, and by whom. The last version is the current manifest.
+Every replacement, reconciliation or patch of the manifest adds a version, and the
+oldest versions are discarded once there are more than the server keeps.
+
+example usage:
+
+export BOOK_CLIENT_TOKEN=$SECRET
+export BOOK_CLIENT_SCHEME=https
+export BOOK_CLIENT_HOST=example.org
+export BOOK_CLIENT_BASE_PATH=/book/api/v1
+export BOOK_CLIENT_FORMAT=YAML
+book manifest versions
+
+The versions are printed to stdout, default type is YAML. Use book manifest rollback
+to reapply an earlier version.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		viper.SetEnvPrefix("BOOK_CLIENT")
+		viper.AutomaticEnv()
+		viper.SetDefault("host", "localhost")
+		viper.SetDefault("scheme", "http")
+		viper.SetDefault("format", "yaml")
+		viper.SetDefault("base_path", "/api/v1")
+
+		basePath := viper.GetString("base_path")
+		host := viper.GetString("host")
+		scheme := viper.GetString("scheme")
+		token := viper.GetString("token")
+		format := strings.ToLower(viper.GetString("format"))
+
+		if token == "" {
+			fmt.Println("BOOK_CLIENT_TOKEN not set")
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json", "yaml", "yml":
+
+		default:
+			fmt.Println("format can be json or yaml, but not " + format)
+			os.Exit(1)
+		}
+
+		cfg := apiclient.DefaultTransportConfig().WithSchemes([]string{scheme}).WithHost(host).WithBasePath(basePath)
+		auth := httptransport.APIKeyAuth("Authorization", "header", token)
+		bc := apiclient.NewHTTPClientWithConfig(nil, cfg)
+		timeout := 10 * time.Second
+		params := admin.NewGetManifestVersionsParams().WithTimeout(timeout)
+		status, err := bc.Admin.GetManifestVersions(params, auth)
+		if err != nil {
+			fmt.Printf("Error: failed to get manifest versions because %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		switch format {
+
+		case "json":
+			vj, err := json.Marshal(status.Payload)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal manifest versions because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(vj))
+		default:
+			vy, err := yaml.Marshal(status.Payload)
+			if err != nil {
+				fmt.Printf("Error: failed to marshal manifest versions because %s\n", err.Error())
+				os.Exit(1)
+			}
+			fmt.Println(string(vy))
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	manifestCmd.AddCommand(manifestVersionsCmd)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/ory/viper"
 	"github.com/practable/book/internal/config"
 	"github.com/practable/book/internal/server"
+	"github.com/practable/book/internal/store"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -46,6 +47,7 @@ export BOOK_AUDIENCE=https://book.practable.io
 export BOOK_PORT=4000
 export BOOK_LOG_FILE=/some/logging/location/book.log
 export BOOK_PERSIST_DIR=/var/lib/book/
+export BOOK_PERSIST_EVERY=1m
 
 If the BOOK_LOG_FILE is not set, or the file cannot be opened, then logging goes to stderr. Setting it to stdout sends logging to stdout.
 
@@ -65,6 +67,22 @@ export BOOK_LOG_FORMAT=text
 Note that persisting bookings to /var/lib/book will require write permission to that directory, 
 which can be obtained by running at with elevated permissions e.g. systemd service, or running
 as a user which has write priviledges to that directory. Else, specify a user-space directory.
+The manifest, bookings, old bookings and users are snapshotted to store.yaml in that directory 
+every BOOK_PERSIST_EVERY, and on shutdown, and are reloaded from there on startup.
+Every successful booking and admin action is also appended to journal.jsonl in that
+directory as it happens, and the actions taken since the last snapshot are replayed
+on startup, so that a crash does not lose them.
+Set BOOK_PERSIST_DIR to an empty string to disable persistence.
+
+The most recently applied manifests are kept as numbered versions, so that an earlier
+one can be reapplied with book manifest rollback. The number of versions kept is set by:
+
+export BOOK_MANIFEST_VERSIONS=10
+
+Time freed by a cancellation and offered to a user on the waitlist is held for them,
+so that no-one else can book it, for:
+
+export BOOK_WAITLIST_OFFER_HOLD=15m
 
 ADVANCED SETTINGS:
 You should not need to alter the default values for the following settings, 
@@ -92,13 +110,16 @@ $ book serve
 		viper.SetDefault("log_file", "/var/log/book/book.log")
 		viper.SetDefault("log_level", "warn")
 		viper.SetDefault("log_format", "json")
+		viper.SetDefault("manifest_versions", store.DefaultMaxManifestVersions)
 		viper.SetDefault("min_username_length", 6)
 		viper.SetDefault("persist_dir", "/var/lib/book/")
+		viper.SetDefault("persist_every", "1m")
 		viper.SetDefault("port", 4000)
 		viper.SetDefault("profile", "true")
 		viper.SetDefault("profile_port", 6060)
 		viper.SetDefault("request_timeout", "1m")
 		viper.SetDefault("tidy_every", "1h")
+		viper.SetDefault("waitlist_offer_hold", store.DefaultWaitlistOfferHold.String())
 
 		accessTokenTTL := viper.GetString("access_token_ttl")
 		adminSecret := viper.GetString("admin_secret")
@@ -108,7 +129,9 @@ $ book serve
 		logFile := viper.GetString("log_file")
 		logFormat := viper.GetString("log_format")
 		logLevel := viper.GetString("log_level")
+		manifestVersions := viper.GetInt("manifest_versions")
 		persistDir := viper.GetString("persist_dir")
+		persistEvery := viper.GetString("persist_every")
 		port := viper.GetInt("port")
 		profile := viper.GetBool("profile")
 		profilePort := viper.GetInt("profile_port")
@@ -116,6 +139,7 @@ $ book serve
 		requestTimeout := viper.GetString("request_timeout")
 
 		tidyEvery := viper.GetString("tidy_every")
+		waitlistOfferHold := viper.GetString("waitlist_offer_hold")
 		minUsernameLength := viper.GetInt("min_username_length")
 
 		// Sanity checks
@@ -151,6 +175,13 @@ $ book serve
 			os.Exit(1)
 		}
 
+		persistEveryDuration, err := time.ParseDuration(persistEvery)
+
+		if err != nil {
+			fmt.Println("Specify BOOK_PERSIST_EVERY duration as string, e.g. 30s, 1m etc")
+			os.Exit(1)
+		}
+
 		requestTimeoutDuration, err := time.ParseDuration(requestTimeout)
 
 		if err != nil {
@@ -164,6 +195,13 @@ $ book serve
 			os.Exit(1)
 		}
 
+		waitlistOfferHoldDuration, err := time.ParseDuration(waitlistOfferHold)
+
+		if err != nil {
+			fmt.Println("Specify BOOK_WAITLIST_OFFER_HOLD duration as string, e.g. 15m, 1h etc")
+			os.Exit(1)
+		}
+
 		// Set up logging
 
 		switch strings.ToLower(logLevel) {
@@ -221,12 +259,14 @@ $ book serve
 		log.Infof("Listening port: %d", port)
 		log.Infof("Log file: [%s]", logFile)
 		log.Infof("Log level: [%s]", logLevel)
+		log.Infof("Manifest versions: %d", manifestVersions)
 		log.Infof("Persistance Directory: [%s]", persistDir)
-		log.Infof("Persistance NOT IMPLEMENTED")
+		log.Infof("Persist every: [%s]", persistEvery)
 		log.Infof("Profiling on: [%t]", profile)
 		log.Infof("Profile port: [%d]", profilePort)
 		log.Infof("Request timeout: [%s]", requestTimeout)
 		log.Infof("Tidy every: [%s]", tidyEvery)
+		log.Infof("Waitlist offer hold: [%s]", waitlistOfferHold)
 
 		// Optionally start the profiling server
 		if profile {
@@ -250,13 +290,17 @@ $ book serve
 			CheckEvery:            checkEveryDuration,
 			DisableCancelAfterUse: disableCancelAfterUse,
 			Host:                  audience,
+			ManifestVersions:      manifestVersions,
 			MinUserNameLength:     minUsernameLength,
 			Now:                   func() time.Time { return time.Now() },
+			PersistDir:            persistDir,
+			PersistEvery:          persistEveryDuration,
 			Port:                  port,
 			PruneEvery:            tidyEveryDuration,
 			StoreSecret:           []byte(adminSecret),
 			RelaySecret:           []byte(relaySecret),
 			RequestTimeout:        requestTimeoutDuration,
+			WaitlistOfferHold:     waitlistOfferHoldDuration,
 		}
 
 		s := server.New(cfg)
@@ -82,6 +82,8 @@ func WithContentTypeTextPlain(r *runtime.ClientOperation) {
 type ClientService interface {
 	CheckManifest(params *CheckManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CheckManifestOK, *CheckManifestNoContent, error)
 
+	DiffManifest(params *DiffManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DiffManifestOK, error)
+
 	ExportBookings(params *ExportBookingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportBookingsOK, error)
 
 	ExportManifest(params *ExportManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportManifestOK, error)
@@ -90,12 +92,24 @@ type ClientService interface {
 
 	ExportUsers(params *ExportUsersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportUsersOK, error)
 
+	GetBookingsCalendarForResource(params *GetBookingsCalendarForResourceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetBookingsCalendarForResourceOK, error)
+
+	GetManifestVersion(params *GetManifestVersionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetManifestVersionOK, error)
+
+	GetManifestVersions(params *GetManifestVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetManifestVersionsOK, error)
+
 	GetResourceIsAvailable(params *GetResourceIsAvailableParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetResourceIsAvailableOK, error)
 
 	GetResources(params *GetResourcesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetResourcesOK, error)
 
 	GetSlotIsAvailable(params *GetSlotIsAvailableParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetSlotIsAvailableOK, error)
 
+	MakeRecurringBooking(params *MakeRecurringBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MakeRecurringBookingOK, error)
+
+	PatchManifest(params *PatchManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PatchManifestOK, error)
+
+	ReconcileManifest(params *ReconcileManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReconcileManifestOK, error)
+
 	ReplaceBookings(params *ReplaceBookingsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplaceBookingsOK, error)
 
 	ReplaceManifest(params *ReplaceManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplaceManifestOK, error)
@@ -155,6 +169,47 @@ func (a *Client) CheckManifest(params *CheckManifestParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+DiffManifest compares a manifest to the current manifest
+
+Compare a candidate manifest to the current manifest, without changing anything, listing the entities that would be added, changed or removed, and the existing bookings that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their window, if the candidate replaced the current manifest. The candidate is checked first, and the reasons for any failed checks are returned.
+*/
+func (a *Client) DiffManifest(params *DiffManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DiffManifestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDiffManifestParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DiffManifest",
+		Method:             "POST",
+		PathPattern:        "/admin/manifest/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DiffManifestReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DiffManifestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DiffManifest: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ExportBookings exports a copy of all current bookings
 
@@ -319,6 +374,129 @@ func (a *Client) ExportUsers(params *ExportUsersParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+GetBookingsCalendarForResource exports the bookings for the resource as an iCalendar
+
+Exports the current and old bookings for the resource as an iCalendar (RFC 5545) calendar, so that staff can subscribe to a resource's bookings in their usual calendar app. Each booking is an event with a UID equal to the booking name, so that calendar apps update existing events on each export. Cancelled bookings are included with status CANCELLED.
+*/
+func (a *Client) GetBookingsCalendarForResource(params *GetBookingsCalendarForResourceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetBookingsCalendarForResourceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetBookingsCalendarForResourceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetBookingsCalendarForResource",
+		Method:             "GET",
+		PathPattern:        "/admin/resources/{resource_name}/bookings.ics",
+		ProducesMediaTypes: []string{"text/calendar", "application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetBookingsCalendarForResourceReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetBookingsCalendarForResourceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetBookingsCalendarForResource: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetManifestVersion gets a retained version of the manifest
+
+Gets a retained version of the manifest, including the manifest itself, so that it can be reapplied by replacing the current manifest with it.
+*/
+func (a *Client) GetManifestVersion(params *GetManifestVersionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetManifestVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetManifestVersionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetManifestVersion",
+		Method:             "GET",
+		PathPattern:        "/admin/manifest/versions/{version}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetManifestVersionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetManifestVersionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetManifestVersion: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetManifestVersions lists the retained versions of the manifest
+
+Lists when each of the most recently applied manifests was applied, and by whom, oldest first. The last version is the current manifest. Every successful replacement, reconciliation or patch of the manifest adds a version.
+*/
+func (a *Client) GetManifestVersions(params *GetManifestVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetManifestVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetManifestVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetManifestVersions",
+		Method:             "GET",
+		PathPattern:        "/admin/manifest/versions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetManifestVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetManifestVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetManifestVersions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetResourceIsAvailable gets the availability of the resource
 
@@ -442,6 +620,129 @@ func (a *Client) GetSlotIsAvailable(params *GetSlotIsAvailableParams, authInfo r
 	panic(msg)
 }
 
+/*
+MakeRecurringBooking makes a recurring booking
+
+Makes a booking for each occurrence of the interval under the recurrence rule, e.g. every Tuesday 10:00-12:00 for a term, for the user on the slot. Each occurrence is checked against the slot's policy, as for replacing bookings, but not the user's groups. Either all the occurrences are booked, or none are, in which case the occurrences that could not be booked (e.g. because they clash with existing bookings) are listed in the errors. Occurrences are ordinary bookings once made, so can be cancelled individually.
+*/
+func (a *Client) MakeRecurringBooking(params *MakeRecurringBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MakeRecurringBookingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMakeRecurringBookingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "MakeRecurringBooking",
+		Method:             "POST",
+		PathPattern:        "/admin/bookings/recurring",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MakeRecurringBookingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MakeRecurringBookingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for MakeRecurringBooking: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PatchManifest patches the manifest
+
+Add, update or delete individual entities of the manifest (e.g. slots, resources, windows, policies). The entities in set are added, or replace existing entities of the same kind with the same name, and then the entities named in delete are removed. The resulting manifest is checked before any change is made, and the reasons for any failed checks are returned. The patch is refused if it would affect any existing bookings (e.g. by deleting their slot), and the affected bookings are returned - replace the manifest with a reconcile mode instead. Bookings on resources that are kept are unaffected.
+*/
+func (a *Client) PatchManifest(params *PatchManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PatchManifestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchManifestParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PatchManifest",
+		Method:             "PATCH",
+		PathPattern:        "/admin/manifest",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PatchManifestReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchManifestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PatchManifest: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReconcileManifest replaces the manifest and reconcile the bookings it affects
+
+Replace the manifest, then keep, cancel or move the existing bookings that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their window. Cancelled bookings are only charged for time already used, and have cancelled_by set to manifestChanged. Bookings are moved to the first slot listed in their policy that allows the booking and is free, starting with their own slot, and are cancelled if there is none, or they have started. Bookings that are not affected are kept in place. Returns what was done to each affected booking.
+*/
+func (a *Client) ReconcileManifest(params *ReconcileManifestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReconcileManifestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReconcileManifestParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReconcileManifest",
+		Method:             "PUT",
+		PathPattern:        "/admin/manifest/reconcile",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReconcileManifestReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReconcileManifestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ReconcileManifest: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ReplaceBookings replaces current bookings
 
@@ -62,6 +62,11 @@ GetAvailabilityParams contains all the parameters to send to the API endpoint
 */
 type GetAvailabilityParams struct {
 
+	// From.
+	//
+	// Format: date-time
+	From *strfmt.DateTime
+
 	// Limit.
 	Limit *int64
 
@@ -71,6 +76,11 @@ type GetAvailabilityParams struct {
 	// SlotName.
 	SlotName string
 
+	// To.
+	//
+	// Format: date-time
+	To *strfmt.DateTime
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -124,6 +134,17 @@ func (o *GetAvailabilityParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithFrom adds the from to the get availability params
+func (o *GetAvailabilityParams) WithFrom(from *strfmt.DateTime) *GetAvailabilityParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the get availability params
+func (o *GetAvailabilityParams) SetFrom(from *strfmt.DateTime) {
+	o.From = from
+}
+
 // WithLimit adds the limit to the get availability params
 func (o *GetAvailabilityParams) WithLimit(limit *int64) *GetAvailabilityParams {
 	o.SetLimit(limit)
@@ -157,6 +178,17 @@ func (o *GetAvailabilityParams) SetSlotName(slotName string) {
 	o.SlotName = slotName
 }
 
+// WithTo adds the to to the get availability params
+func (o *GetAvailabilityParams) WithTo(to *strfmt.DateTime) *GetAvailabilityParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the get availability params
+func (o *GetAvailabilityParams) SetTo(to *strfmt.DateTime) {
+	o.To = to
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetAvailabilityParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -165,6 +197,23 @@ func (o *GetAvailabilityParams) WriteToRequest(r runtime.ClientRequest, reg strf
 	}
 	var res []error
 
+	if o.From != nil {
+
+		// query param from
+		var qrFrom strfmt.DateTime
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom.String()
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Limit != nil {
 
 		// query param limit
@@ -204,6 +253,23 @@ func (o *GetAvailabilityParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 
+	if o.To != nil {
+
+		// query param to
+		var qrTo strfmt.DateTime
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo.String()
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
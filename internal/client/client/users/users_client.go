@@ -90,6 +90,12 @@ type ClientService interface {
 
 	GetAvailability(params *GetAvailabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAvailabilityOK, error)
 
+	GetAvailabilityAnySlot(params *GetAvailabilityAnySlotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAvailabilityAnySlotOK, error)
+
+	GetAvailabilityForSlots(params *GetAvailabilityForSlotsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAvailabilityForSlotsOK, error)
+
+	GetBookingsCalendarForUser(params *GetBookingsCalendarForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetBookingsCalendarForUserOK, error)
+
 	GetBookingsForUser(params *GetBookingsForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetBookingsForUserOK, error)
 
 	GetDescription(params *GetDescriptionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetDescriptionOK, error)
@@ -104,8 +110,20 @@ type ClientService interface {
 
 	GetPolicyStatusForUser(params *GetPolicyStatusForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPolicyStatusForUserOK, error)
 
+	GetWaitlistForUser(params *GetWaitlistForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetWaitlistForUserOK, error)
+
+	JoinWaitlist(params *JoinWaitlistParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*JoinWaitlistOK, error)
+
+	LeaveWaitlist(params *LeaveWaitlistParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*LeaveWaitlistNoContent, error)
+
 	MakeBooking(params *MakeBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MakeBookingNoContent, error)
 
+	MakeBookingAnySlot(params *MakeBookingAnySlotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MakeBookingAnySlotOK, error)
+
+	ResizeBooking(params *ResizeBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ResizeBookingOK, error)
+
+	SwapBooking(params *SwapBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SwapBookingOK, error)
+
 	UniqueName(params *UniqueNameParams, opts ...ClientOption) (*UniqueNameOK, error)
 
 	GetStoreStatusUser(params *GetStoreStatusUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetStoreStatusUserOK, error)
@@ -272,7 +290,7 @@ func (a *Client) GetActivity(params *GetActivityParams, authInfo runtime.ClientA
 /*
 GetAvailability gets availability for the slot
 
-Pagination is supported by the limit and offset parameters. For the first query '?limit=20&offset=0', the second '?limit=20&offset=20'. The offset is equal to the zero-indexed value of the first item of the next page to be returned (20 items are indexed from 0 to 19, so 20 is the first item to be returned in the second page). Note that drift can occur if slots are booked during the sending of availability data, potentially preventing a user from seeing some slots that move earlier in the index and cross a pagination boundary. Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.
+Availability is given from now until as far ahead as the policy allows, unless narrowed by the optional from and to parameters, e.g. to the week that a UI is showing. Pagination is supported by the limit and offset parameters. For the first query '?limit=20&offset=0', the second '?limit=20&offset=20'. The offset is equal to the zero-indexed value of the first item of the next page to be returned (20 items are indexed from 0 to 19, so 20 is the first item to be returned in the second page). Note that drift can occur if slots are booked during the sending of availability data, potentially preventing a user from seeing some slots that move earlier in the index and cross a pagination boundary. Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.
 */
 func (a *Client) GetAvailability(params *GetAvailabilityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAvailabilityOK, error) {
 	// TODO: Validate the params before sending
@@ -310,6 +328,129 @@ func (a *Client) GetAvailability(params *GetAvailabilityParams, authInfo runtime
 	panic(msg)
 }
 
+/*
+GetAvailabilityAnySlot gets availability for any slot in the policy
+
+Get the availability of the slots in the policy that use the ui_set (or of all the slots in the policy, if no ui_set is given), merged into a single list of the intervals when at least one of the slots is free. Slots whose resource is offline are left out. A merged interval may span more than one slot, so a booking for the whole of it may not be granted. Pagination is supported by the limit and offset parameters, in the same way as for the availability of a single slot.
+*/
+func (a *Client) GetAvailabilityAnySlot(params *GetAvailabilityAnySlotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAvailabilityAnySlotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAvailabilityAnySlotParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetAvailabilityAnySlot",
+		Method:             "GET",
+		PathPattern:        "/policies/{policy_name}/availability",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAvailabilityAnySlotReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAvailabilityAnySlotOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetAvailabilityAnySlot: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetAvailabilityForSlots gets availability for each slot in the policy
+
+Get the availability of each of the slots in the policy that use the ui_set (or of all the slots in the policy, if no ui_set is given), in one request instead of one per slot. Availability is given from now until as far ahead as the policy allows, unless narrowed by the optional from and to parameters, and limit restricts the number of intervals given for each slot. A slot whose resource is unavailable has no availability.
+*/
+func (a *Client) GetAvailabilityForSlots(params *GetAvailabilityForSlotsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAvailabilityForSlotsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAvailabilityForSlotsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetAvailabilityForSlots",
+		Method:             "GET",
+		PathPattern:        "/policies/{policy_name}/slots/availability",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAvailabilityForSlotsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAvailabilityForSlotsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetAvailabilityForSlots: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetBookingsCalendarForUser exports the user's bookings as an iCalendar
+
+Exports the current and old bookings for the user as an iCalendar (RFC 5545) calendar, so that users can subscribe to their bookings in their usual calendar app, instead of each booking UI preparing .ics files. Each booking is an event with the slot's name and short description, and a UID equal to the booking name, so that calendar apps update existing events on each export. Cancelled bookings are included with status CANCELLED, so that calendar apps remove them.
+*/
+func (a *Client) GetBookingsCalendarForUser(params *GetBookingsCalendarForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetBookingsCalendarForUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetBookingsCalendarForUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetBookingsCalendarForUser",
+		Method:             "GET",
+		PathPattern:        "/users/{user_name}/bookings.ics",
+		ProducesMediaTypes: []string{"text/calendar", "application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetBookingsCalendarForUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetBookingsCalendarForUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetBookingsCalendarForUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetBookingsForUser gets all current bookings for the user
 
@@ -597,6 +738,129 @@ func (a *Client) GetPolicyStatusForUser(params *GetPolicyStatusForUserParams, au
 	panic(msg)
 }
 
+/*
+GetWaitlistForUser gets the waitlist entries for the user
+
+Get the waitlist entries for the user, in the order they were joined, including any offers of freed time.
+*/
+func (a *Client) GetWaitlistForUser(params *GetWaitlistForUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetWaitlistForUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWaitlistForUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetWaitlistForUser",
+		Method:             "GET",
+		PathPattern:        "/users/{user_name}/waitlist",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWaitlistForUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetWaitlistForUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetWaitlistForUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+JoinWaitlist joins the waitlist for the slot
+
+Registers interest in booking the slot for the interval given in the query, e.g. when it is already booked. When time on the same resource that overlaps the interval is freed, by a booking being cancelled (by its user, or automatically for not being started within the grace period), shortened or moved, the freed time is offered to the first user waiting for it, or booked for them automatically if the slot's policy has auto_book_waitlist set. Either way, this only happens if the booking would be within the policy. Offers are shown in the waitlist entry, and are booked in the usual way. The user must belong to a group that includes the slot's policy. Returns the waitlist entry.
+*/
+func (a *Client) JoinWaitlist(params *JoinWaitlistParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*JoinWaitlistOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewJoinWaitlistParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "JoinWaitlist",
+		Method:             "POST",
+		PathPattern:        "/users/{user_name}/waitlist",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &JoinWaitlistReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*JoinWaitlistOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for JoinWaitlist: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+LeaveWaitlist leaves the waitlist
+
+Removes the entry from the waitlist. The user must be the owner of the entry. Entries are also removed automatically when freed time is booked for the user, and once the interval has passed.
+*/
+func (a *Client) LeaveWaitlist(params *LeaveWaitlistParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*LeaveWaitlistNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewLeaveWaitlistParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "LeaveWaitlist",
+		Method:             "DELETE",
+		PathPattern:        "/users/{user_name}/waitlist/{entry_name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &LeaveWaitlistReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*LeaveWaitlistNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for LeaveWaitlist: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 MakeBooking requests a booking
 
@@ -638,6 +902,129 @@ func (a *Client) MakeBooking(params *MakeBookingParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+MakeBookingAnySlot requests a booking on any slot in the policy
+
+A booking is requested on whichever slot in the policy that uses the ui_set (or any slot in the policy, if no ui_set is given) is free for the interval, so that users do not have to check each slot in turn. The booking is returned, so that the user can see which slot was booked. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.
+*/
+func (a *Client) MakeBookingAnySlot(params *MakeBookingAnySlotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*MakeBookingAnySlotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMakeBookingAnySlotParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "MakeBookingAnySlot",
+		Method:             "POST",
+		PathPattern:        "/policies/{policy_name}/bookings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MakeBookingAnySlotReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MakeBookingAnySlotOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for MakeBookingAnySlot: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ResizeBooking resizes the booking
+
+Changes the interval of an existing booking in place, keeping its name, e.g. to extend it. The new interval is checked against the window for the slot, and the policy limits on book ahead, duration and usage, in the same way as a new booking, except that the booking does not count against the maximum number of bookings. A booking that has started can only have its end changed, to any time in the future. A started booking that is shortened is charged as if it had been cancelled at its new end, and access is ended at the new end. The usage charged for the booking is adjusted to match the new interval. Returns the resized booking.
+*/
+func (a *Client) ResizeBooking(params *ResizeBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ResizeBookingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewResizeBookingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ResizeBooking",
+		Method:             "PATCH",
+		PathPattern:        "/users/{user_name}/bookings/{booking_name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ResizeBookingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ResizeBookingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ResizeBooking: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SwapBooking swaps the booking for a new one
+
+Atomically cancels the existing booking and makes a new booking for the slot and interval given in the query, so that the user cannot lose their booking to someone else in between. If the new booking cannot be made, the existing booking is kept unchanged. The new booking may overlap the time of the existing booking, e.g. to extend it. Usage is refunded for the cancelled booking before the new booking is checked against the policy. A booking that has started cannot be swapped. Returns the new booking.
+*/
+func (a *Client) SwapBooking(params *SwapBookingParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SwapBookingOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSwapBookingParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SwapBooking",
+		Method:             "PUT",
+		PathPattern:        "/users/{user_name}/bookings/{booking_name}/swap",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "text/plain"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SwapBookingReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SwapBookingOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for SwapBooking: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 UniqueName requests a new unique username
 
@@ -40,6 +40,9 @@ type Booking struct {
 	// Required: true
 	Policy *string `json:"policy"`
 
+	// resource substituted for the slot's resource because that went offline (empty if not substituted)
+	Resource string `json:"resource,omitempty"`
+
 	// name of the slot that has been booked
 	// Required: true
 	Slot *string `json:"slot"`
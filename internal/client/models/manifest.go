@@ -21,6 +21,9 @@ import (
 // swagger:model Manifest
 type Manifest struct {
 
+	// blackouts
+	Blackouts map[string]Blackout `json:"blackouts,omitempty"`
+
 	// descriptions
 	// Required: true
 	Descriptions map[string]Description `json:"descriptions"`
@@ -35,6 +38,9 @@ type Manifest struct {
 	// Required: true
 	Policies map[string]Policy `json:"policies"`
 
+	// pools
+	Pools map[string]Pool `json:"pools,omitempty"`
+
 	// resources
 	// Required: true
 	Resources map[string]Resource `json:"resources"`
@@ -64,6 +70,10 @@ type Manifest struct {
 func (m *Manifest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBlackouts(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescriptions(formats); err != nil {
 		res = append(res, err)
 	}
@@ -80,6 +90,10 @@ func (m *Manifest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePools(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateResources(formats); err != nil {
 		res = append(res, err)
 	}
@@ -110,6 +124,32 @@ func (m *Manifest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Manifest) validateBlackouts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Blackouts) { // not required
+		return nil
+	}
+
+	for k := range m.Blackouts {
+
+		if err := validate.Required("blackouts"+"."+k, "body", m.Blackouts[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Blackouts[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("blackouts" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("blackouts" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Manifest) validateDescriptions(formats strfmt.Registry) error {
 
 	if err := validate.Required("descriptions", "body", m.Descriptions); err != nil {
@@ -216,6 +256,32 @@ func (m *Manifest) validatePolicies(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Manifest) validatePools(formats strfmt.Registry) error {
+	if swag.IsZero(m.Pools) { // not required
+		return nil
+	}
+
+	for k := range m.Pools {
+
+		if err := validate.Required("pools"+"."+k, "body", m.Pools[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Pools[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pools" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pools" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Manifest) validateResources(formats strfmt.Registry) error {
 
 	if err := validate.Required("resources", "body", m.Resources); err != nil {
@@ -382,6 +448,10 @@ func (m *Manifest) validateWindows(formats strfmt.Registry) error {
 func (m *Manifest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBlackouts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDescriptions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -398,6 +468,10 @@ func (m *Manifest) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidatePools(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateResources(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -428,6 +502,21 @@ func (m *Manifest) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Manifest) contextValidateBlackouts(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.Blackouts {
+
+		if val, ok := m.Blackouts[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Manifest) contextValidateDescriptions(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.Required("descriptions", "body", m.Descriptions); err != nil {
@@ -496,6 +585,21 @@ func (m *Manifest) contextValidatePolicies(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Manifest) contextValidatePools(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.Pools {
+
+		if val, ok := m.Pools[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Manifest) contextValidateResources(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.Required("resources", "body", m.Resources); err != nil {
@@ -14,12 +14,16 @@ type ServerConfig struct {
 	DisableCancelAfterUse bool
 	GraceRebound          time.Duration
 	Host                  string
+	ManifestVersions      int
 	MinUserNameLength     int
 	Now                   func() time.Time
+	PersistDir            string
+	PersistEvery          time.Duration
 	Port                  int
 	PruneEvery            time.Duration
 	RelaySecret           []byte //TODO update to string to suit internal/login.Sign()
 	RequestTimeout        time.Duration
 	StoreSecret           []byte //TODO update to string to suit internal/login.Sign()?
 	Store                 *store.Store
+	WaitlistOfferHold     time.Duration
 }
@@ -9,12 +9,18 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// JSONToManifests converts a manifest from JSON to the client and store formats, after expanding any families
 func JSONToManifests(jb []byte) (models.Manifest, store.Manifest, error) {
 
 	m := models.Manifest{}
 	s := store.Manifest{}
 
-	err := json.Unmarshal(jb, &s)
+	jb, err := ExpandManifest(jb)
+	if err != nil {
+		return m, s, err
+	}
+
+	err = json.Unmarshal(jb, &s)
 	if err != nil {
 		return m, s, errors.New("unable to unmarshal manifest into store format because " + err.Error())
 	}
@@ -28,6 +34,7 @@ func JSONToManifests(jb []byte) (models.Manifest, store.Manifest, error) {
 	return m, s, nil
 }
 
+// YAMLToManifests converts a manifest from YAML to the client and store formats, after expanding any families
 func YAMLToManifests(yb []byte) (models.Manifest, store.Manifest, error) {
 
 	jb, err := yaml.YAMLToJSON(yb)
@@ -38,3 +45,28 @@ func YAMLToManifests(yb []byte) (models.Manifest, store.Manifest, error) {
 	return JSONToManifests(jb)
 
 }
+
+// JSONToManifestPatch converts a manifest patch from JSON to the client format for uploading
+func JSONToManifestPatch(jb []byte) (models.ManifestPatch, error) {
+
+	p := models.ManifestPatch{}
+
+	err := json.Unmarshal(jb, &p)
+
+	if err != nil {
+		return p, errors.New("unable to unmarshal manifest patch into client format because " + err.Error())
+	}
+
+	return p, nil
+}
+
+// YAMLToManifestPatch converts a manifest patch from YAML to the client format for uploading
+func YAMLToManifestPatch(yb []byte) (models.ManifestPatch, error) {
+
+	jb, err := yaml.YAMLToJSON(yb)
+	if err != nil {
+		return models.ManifestPatch{}, errors.New("unable to process manifest patch because " + err.Error())
+	}
+
+	return JSONToManifestPatch(jb)
+}
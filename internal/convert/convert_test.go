@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/practable/book/internal/store"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,6 +16,15 @@ display_guides:
     duration: 1m
     label: 1m
   dg-blank:
+groups:
+  g-limited:
+    description: d-g
+    enforce_max_usage: true
+    max_usage: 10h
+    policies:
+    - p-modes
+  g-blank:
+    description: d-g
 policies:
   p-modes:
     allow_start_in_past_within: 1m0s
@@ -25,6 +35,15 @@ policies:
     next_available: 1m0s
     starts_within: 1m0s
   p-blank:
+resources:
+  r-buffer:
+    buffer: 5m
+    description: d-r
+    streams:
+    - st-data
+    topic_stub: rb
+  r-blank:
+    description: d-r
 windows:
   w-a:
     allowed:
@@ -67,4 +86,232 @@ func TestYAMLToManifests(t *testing.T) {
 	assert.Equal(t, time.Duration(1*time.Minute), s.Policies["p-modes"].StartsWithin)
 	assert.Equal(t, "1m0s", m.Policies["p-modes"].StartsWithin)
 
+	assert.Equal(t, time.Duration(5*time.Minute), s.Resources["r-buffer"].Buffer)
+	assert.Equal(t, "5m", m.Resources["r-buffer"].Buffer)
+	assert.Equal(t, []string{"st-data"}, s.Resources["r-buffer"].Streams)
+	assert.Equal(t, "rb", s.Resources["r-buffer"].TopicStub)
+	assert.Equal(t, time.Duration(0), s.Resources["r-blank"].Buffer)
+
+	assert.Equal(t, time.Duration(10*time.Hour), s.Groups["g-limited"].MaxUsage)
+	assert.Equal(t, "10h", m.Groups["g-limited"].MaxUsage)
+	assert.True(t, s.Groups["g-limited"].EnforceMaxUsage)
+	assert.Equal(t, []string{"p-modes"}, s.Groups["g-limited"].Policies)
+	assert.Equal(t, time.Duration(0), s.Groups["g-blank"].MaxUsage)
+
+}
+
+func TestYAMLToManifestPatch(t *testing.T) {
+
+	p, err := YAMLToManifestPatch([]byte(`
+delete:
+  slots:
+  - sl-a
+set:
+  windows:
+    w-a:
+      allowed:
+      - start: 2022-11-04T00:00:00Z
+        end: 2022-11-06T00:00:00Z
+`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sl-a"}, p.Delete.Slots)
+	assert.Equal(t, 1, len(p.Set.Windows["w-a"].Allowed))
+	assert.Equal(t, "2022-11-04T00:00:00.000Z", p.Set.Windows["w-a"].Allowed[0].Start.String())
+
+}
+
+var familyYAML = []byte(`
+families:
+  pendulums:
+    count: 3
+    digits: 2
+    first: 9
+    add_resources_to_pools:
+    - pl-pend
+    add_slots_to_policies:
+    - p-pend
+    template:
+      resources:
+        r-pend{{n}}:
+          description: d-r-pend
+          streams:
+          - st-data
+          topic_stub: pend{{n}}
+      slots:
+        sl-pend{{n}}:
+          description: d-sl-pend
+          policy: p-pend
+          resource: r-pend{{n}}
+          ui_set: us-pend
+          window: w-pend
+policies:
+  p-pend:
+    description: d-p-pend
+    slots:
+    - sl-other
+pools:
+  pl-pend:
+    description: d-pl-pend
+slots:
+  sl-other:
+    description: d-sl-pend
+    policy: p-pend
+    resource: r-other
+    ui_set: us-pend
+    window: w-pend
+`)
+
+func TestExpandManifest(t *testing.T) {
+
+	_, s, err := YAMLToManifests(familyYAML)
+
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(s.Resources))
+	assert.Equal(t, 4, len(s.Slots))
+
+	for _, n := range []string{"09", "10", "11"} {
+		assert.Equal(t, "pend"+n, s.Resources["r-pend"+n].TopicStub)
+		assert.Equal(t, "r-pend"+n, s.Slots["sl-pend"+n].Resource)
+		assert.Equal(t, "p-pend", s.Slots["sl-pend"+n].Policy)
+	}
+
+	assert.Equal(t, []string{"sl-other", "sl-pend09", "sl-pend10", "sl-pend11"}, s.Policies["p-pend"].Slots)
+	assert.Equal(t, []string{"r-pend09", "r-pend10", "r-pend11"}, s.Pools["pl-pend"].Resources)
+
+	// manifests without families are unchanged
+	jb := []byte(`{"slots":{"sl-a":{"policy":"p-a"}}}`)
+	eb, err := ExpandManifest(jb)
+	assert.NoError(t, err)
+	assert.Equal(t, jb, eb)
+
+	// generated entities must not clash with existing entities
+	_, err = ExpandManifest([]byte(`{"families":{"f":{"count":2,"template":{"slots":{"sl-{{n}}":{}}}}},"slots":{"sl-2":{}}}`))
+	assert.Error(t, err)
+	assert.Equal(t, "family f generates slots sl-2, which already exists", err.Error())
+
+	// nor with each other
+	_, err = ExpandManifest([]byte(`{"families":{"f":{"count":2,"template":{"slots":{"sl-a":{}}}}}}`))
+	assert.Error(t, err)
+	assert.Equal(t, "family f generates slots sl-a, which already exists", err.Error())
+
+	_, err = ExpandManifest([]byte(`{"families":{"f":{"count":0,"template":{"slots":{"sl-{{n}}":{}}}}}}`))
+	assert.Error(t, err)
+	assert.Equal(t, "family f must have a count of at least one", err.Error())
+
+	_, err = ExpandManifest([]byte(`{"families":{"f":{"count":1,"template":{"sloths":{"sl-{{n}}":{}}}}}}`))
+	assert.Error(t, err)
+	assert.Equal(t, "family f has a template with unknown kind sloths", err.Error())
+
+	_, err = ExpandManifest([]byte(`{"families":{"f":{"count":1,"add_slots_to_policies":["p-x"],"template":{"slots":{"sl-{{n}}":{}}}}}}`))
+	assert.Error(t, err)
+	assert.Equal(t, "family f adds slots to policy p-x, which does not exist", err.Error())
+
+}
+
+var checkYAML = []byte(`
+descriptions:
+  d-g:
+    name: group
+    type: group
+    short: group
+  d-p:
+    name: policy
+    type: policy
+    short: policy
+  d-r:
+    name: resource
+    type: resource
+    short: resource
+  d-sl:
+    name: slot
+    type: slot
+    short: slot
+  d-ui:
+    name: ui
+    type: ui
+    short: ui
+families:
+  rigs:
+    count: 2
+    template:
+      resources:
+        r-rig{{n}}:
+          buffer: 5m
+          description: d-r
+          streams:
+          - st-data
+          topic_stub: rig{{n}}
+      slots:
+        sl-rig{{n}}:
+          description: d-sl
+          policy: p-rig
+          resource: r-rig{{n}}
+          ui_set: us-rig
+          window: w-rig
+    add_slots_to_policies:
+    - p-rig
+groups:
+  g-rig:
+    description: d-g
+    enforce_max_usage: true
+    max_usage: 10h
+    policies:
+    - p-rig
+policies:
+  p-rig:
+    description: d-p
+    enforce_grace_period: true
+    grace_penalty: 1m
+    grace_period: 5m
+    slots: []
+streams:
+  st-data:
+    audience: a
+    connection_type: session
+    for: data
+    scopes:
+    - r
+    - w
+    topic: a
+    url: a
+uis:
+  ui-rig:
+    description: d-ui
+    url: a
+    streams_required:
+    - st-data
+ui_sets:
+  us-rig:
+    uis:
+    - ui-rig
+windows:
+  w-rig:
+    allowed:
+    - start: 2022-11-04T00:00:00Z
+      end: 2022-11-06T00:00:00Z
+    denied: []
+`)
+
+// book manifest check and expand load manifests this way, so all
+// the durations must survive expanding the families
+func TestCheckExpandedManifest(t *testing.T) {
+
+	_, s, err := YAMLToManifests(checkYAML)
+
+	assert.NoError(t, err)
+
+	err, msg := store.CheckManifest(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	assert.Equal(t, time.Duration(5*time.Minute), s.Resources["r-rig1"].Buffer)
+	assert.Equal(t, time.Duration(5*time.Minute), s.Resources["r-rig2"].Buffer)
+	assert.Equal(t, time.Duration(10*time.Hour), s.Groups["g-rig"].MaxUsage)
+	assert.True(t, s.Policies["p-rig"].EnforceGracePeriod)
+	assert.Equal(t, time.Duration(5*time.Minute), s.Policies["p-rig"].GracePeriod)
+	assert.Equal(t, time.Duration(time.Minute), s.Policies["p-rig"].GracePenalty)
+	assert.Equal(t, []string{"sl-rig1", "sl-rig2"}, s.Policies["p-rig"].Slots)
+
 }
@@ -0,0 +1,254 @@
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FamilyPlaceholder is replaced by the number of each member of a family, in the names
+// and values of the entities in the family's template
+const FamilyPlaceholder = "{{n}}"
+
+// Family represents a set of identical kit, e.g. a number of pendulums, whose entities are
+// generated from a template rather than being repeated by hand in the manifest. Each member
+// of the family gets a copy of every entity in the template, with FamilyPlaceholder replaced
+// by its number, in both the names and the values of the entities.
+type Family struct {
+
+	// AddResourcesToPools lists the pools that the resources of every member are added to
+	AddResourcesToPools []string `json:"add_resources_to_pools,omitempty"`
+
+	// AddSlotsToPolicies lists the policies that the slots of every member are added to
+	AddSlotsToPolicies []string `json:"add_slots_to_policies,omitempty"`
+
+	// Count is how many members there are in the family
+	Count int `json:"count"`
+
+	// Digits is the minimum number of digits in the number of each member, padded with leading zeros
+	Digits int `json:"digits,omitempty"`
+
+	// First is the number of the first member, with the rest numbered consecutively (default 1)
+	First *int `json:"first,omitempty"`
+
+	// Template holds the entities that are generated for each member, by kind, in the same format as the manifest
+	Template map[string]map[string]json.RawMessage `json:"template"`
+}
+
+// familyKinds are the kinds of entity that a family template can contain, i.e. those in the manifest
+var familyKinds = map[string]bool{
+	"blackouts":      true,
+	"descriptions":   true,
+	"display_guides": true,
+	"groups":         true,
+	"policies":       true,
+	"pools":          true,
+	"resources":      true,
+	"slots":          true,
+	"streams":        true,
+	"uis":            true,
+	"ui_sets":        true,
+	"windows":        true,
+}
+
+// ExpandManifest expands the families in a JSON-formatted manifest into the entities that they
+// generate, returning a JSON-formatted manifest without any families. Generated entities must
+// not have the same name as any other entity of the same kind. Manifests without families are
+// returned unchanged.
+func ExpandManifest(jb []byte) ([]byte, error) {
+
+	doc := make(map[string]json.RawMessage)
+
+	err := json.Unmarshal(jb, &doc)
+
+	if err != nil {
+		return jb, errors.New("unable to unmarshal manifest because " + err.Error())
+	}
+
+	fb, ok := doc["families"]
+
+	if !ok {
+		return jb, nil
+	}
+
+	families := make(map[string]Family)
+
+	err = json.Unmarshal(fb, &families)
+
+	if err != nil {
+		return jb, errors.New("unable to unmarshal families because " + err.Error())
+	}
+
+	delete(doc, "families")
+
+	// entities are kept as raw JSON, so that expansion does not depend on the format of each kind
+	entities := make(map[string]map[string]json.RawMessage)
+
+	for k, v := range doc {
+
+		if !familyKinds[k] || string(v) == "null" {
+			continue
+		}
+
+		em := make(map[string]json.RawMessage)
+
+		err = json.Unmarshal(v, &em)
+
+		if err != nil {
+			return jb, errors.New("unable to unmarshal " + k + " because " + err.Error())
+		}
+
+		entities[k] = em
+	}
+
+	// expand the families in order of name, so that any error is reported consistently
+	names := []string{}
+
+	for k := range families {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	for _, k := range names {
+
+		err = expandFamily(k, families[k], entities)
+
+		if err != nil {
+			return jb, err
+		}
+	}
+
+	for k, v := range entities {
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return jb, errors.New("unable to marshal " + k + " because " + err.Error())
+		}
+
+		doc[k] = b
+	}
+
+	return json.Marshal(doc)
+}
+
+// expandFamily adds the entities generated by each member of the family to the entities, by kind,
+// and adds the slots and resources of each member to the policies and pools listed in the family
+func expandFamily(name string, f Family, entities map[string]map[string]json.RawMessage) error {
+
+	if f.Count < 1 {
+		return errors.New("family " + name + " must have a count of at least one")
+	}
+
+	first := 1
+
+	if f.First != nil {
+		first = *f.First
+	}
+
+	kinds := []string{}
+
+	for k := range f.Template {
+		if !familyKinds[k] {
+			return errors.New("family " + name + " has a template with unknown kind " + k)
+		}
+		kinds = append(kinds, k)
+	}
+
+	sort.Strings(kinds)
+
+	slots := []string{}
+	resources := []string{}
+
+	for i := first; i < first+f.Count; i++ {
+
+		n := strconv.Itoa(i)
+
+		if f.Digits > 0 {
+			n = fmt.Sprintf("%0*d", f.Digits, i)
+		}
+
+		for _, k := range kinds {
+
+			if _, ok := entities[k]; !ok {
+				entities[k] = make(map[string]json.RawMessage)
+			}
+
+			for tk, tv := range f.Template[k] {
+
+				ek := strings.ReplaceAll(tk, FamilyPlaceholder, n)
+
+				if _, ok := entities[k][ek]; ok {
+					return errors.New("family " + name + " generates " + k + " " + ek + ", which already exists")
+				}
+
+				entities[k][ek] = json.RawMessage(strings.ReplaceAll(string(tv), FamilyPlaceholder, n))
+
+				switch k {
+				case "slots":
+					slots = append(slots, ek)
+				case "resources":
+					resources = append(resources, ek)
+				}
+			}
+		}
+	}
+
+	sort.Strings(slots)
+	sort.Strings(resources)
+
+	err := appendToList(name, entities["policies"], f.AddSlotsToPolicies, "policy", "slots", slots)
+
+	if err != nil {
+		return err
+	}
+
+	return appendToList(name, entities["pools"], f.AddResourcesToPools, "pool", "resources", resources)
+}
+
+// appendToList appends the names to the list in the field of each of the named entities
+func appendToList(family string, entities map[string]json.RawMessage, names []string, kind, field string, add []string) error {
+
+	for _, k := range names {
+
+		v, ok := entities[k]
+
+		if !ok {
+			return errors.New("family " + family + " adds " + field + " to " + kind + " " + k + ", which does not exist")
+		}
+
+		e := make(map[string]interface{})
+
+		err := json.Unmarshal(v, &e)
+
+		if err != nil {
+			return errors.New("unable to unmarshal " + kind + " " + k + " because " + err.Error())
+		}
+
+		list := []interface{}{}
+
+		if l, ok := e[field].([]interface{}); ok {
+			list = l
+		}
+
+		for _, a := range add {
+			list = append(list, a)
+		}
+
+		e[field] = list
+
+		b, err := json.Marshal(e)
+
+		if err != nil {
+			return errors.New("unable to marshal " + kind + " " + k + " because " + err.Error())
+		}
+
+		entities[k] = b
+	}
+
+	return nil
+}
@@ -6,8 +6,8 @@ import (
 	"sync"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	avl "github.com/practable/book/internal/trees/avltree"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/practable/book/internal/interval"
 )
@@ -17,8 +17,10 @@ type Diary struct {
 	*sync.RWMutex `json:"-"`
 	Name          string // unique, persistant name
 	bookings      *avl.Tree
-	available     bool   // must be true to be booked - we don't know when it might be available again
-	status        string // optional status message, to explain lack of availability
+	index         map[string]interval.Interval // intervals of the bookings, by name, to avoid searching the tree
+	available     bool                         // must be true to be booked - we don't know when it might be available again
+	status        string                       // optional status message, to explain lack of availability
+	buffer        time.Duration                // turnaround time needed between bookings, e.g. to reset the equipment
 }
 
 // Booking represents a booking. This is not used internally, it's just for
@@ -35,28 +37,68 @@ func New(name string) *Diary {
 		&sync.RWMutex{},
 		name,
 		avl.NewWith(interval.Comparator),
+		make(map[string]interval.Interval),
 		true,
 		"new",
+		0,
+	}
+}
+
+// SetBuffer sets the turnaround time that must be left between bookings, e.g. for kit that needs
+// resetting after each session. It only affects bookings requested after it is set.
+func (d *Diary) SetBuffer(buffer time.Duration) {
+	d.Lock()
+	defer d.Unlock()
+	d.buffer = buffer
+}
+
+// Buffer returns the turnaround time that must be left between bookings
+func (d *Diary) Buffer() time.Duration {
+	d.RLock()
+	defer d.RUnlock()
+	return d.buffer
+}
+
+// Occupied returns the interval that the booking keeps other bookings out of, i.e. including the
+// turnaround time before and after it. Intervals are closed, so the padding is a nanosecond less
+// than the buffer, to let the next booking start exactly one buffer after the last one ends.
+func (d *Diary) Occupied(when interval.Interval) interval.Interval {
+	d.RLock()
+	defer d.RUnlock()
+	return d.occupied(when)
+}
+
+// occupied is the internal version of Occupied, for use when the lock is already held
+func (d *Diary) occupied(when interval.Interval) interval.Interval {
+
+	if d.buffer <= 0 {
+		return when
+	}
+
+	pad := d.buffer - time.Nanosecond
+
+	return interval.Interval{
+		Start: when.Start.Add(-pad),
+		End:   when.End.Add(pad),
 	}
 }
 
 // Delete removes a booking, if it exists
-func (d *Diary) Delete(delete string) error {
+func (d *Diary) Delete(name string) error {
 
 	d.Lock()
 	defer d.Unlock()
 
-	slots := d.bookings.Keys() //these are given in order
-	Names := d.bookings.Values()
+	when, ok := d.index[name]
 
-	for idx, Name := range Names {
-		if delete == Name {
-			d.bookings.Remove(slots[idx])
-			return nil
-		}
+	if !ok {
+		return errors.New("not found")
 	}
 
-	return errors.New("not found")
+	d.bookings.Remove(when)
+	delete(d.index, name)
+
+	return nil
 
 }
 
@@ -78,28 +120,32 @@ func (d *Diary) Request(when interval.Interval, name string) error {
 		return errors.New("must not have empty name")
 	}
 
-	bs, err := d.GetBookings()
-
-	if err != nil {
-		return err
-	}
-
 	d.Lock()
 	defer d.Unlock()
 
-	for _, b := range bs {
-		if b.Name == name {
-			return errors.New("name already in use")
-		}
+	if _, ok := d.index[name]; ok {
+		return errors.New("name already in use")
 	}
 
 	if ok, msg := d.IsAvailable(); !ok {
 		return errors.New(msg)
 	}
 
-	_, err = d.bookings.Put(when, name)
+	// the bookings themselves are stored without the buffer, so that changing the
+	// buffer cannot leave overlapping intervals in the tree
+	if d.buffer > 0 && len(d.getBookingsOverlapping(d.occupied(when))) > 0 {
+		return errors.New("conflict with turnaround time of " + d.buffer.String() + " around existing")
+	}
+
+	_, err := d.bookings.Put(when, name)
+
+	if err != nil {
+		return err
+	}
 
-	return err
+	d.index[name] = when
+
+	return nil
 }
 
 // GetCount returns the number of live bookings
@@ -133,6 +179,39 @@ func (d *Diary) GetBookings() ([]Booking, error) {
 
 }
 
+// GetBookingsOverlapping returns the bookings that overlap the interval (in the sense
+// of interval.Comparator), in order. The first booking is found in the tree, then the
+// others are visited in order, so the cost depends on the number of bookings returned,
+// rather than the total number of bookings in the diary
+func (d *Diary) GetBookingsOverlapping(when interval.Interval) []Booking {
+	d.RLock()
+	defer d.RUnlock()
+	return d.getBookingsOverlapping(when)
+}
+
+// getBookingsOverlapping is the internal version of GetBookingsOverlapping, for use when the lock is already held
+func (d *Diary) getBookingsOverlapping(when interval.Interval) []Booking {
+
+	b := []Booking{}
+
+	// bookings cannot overlap each other, so at most one booking overlaps the start of the
+	// interval, and if there is none, then the next booking is the first that could overlap
+	n, found := d.bookings.Ceiling(interval.Interval{Start: when.Start, End: when.Start})
+
+	if !found {
+		return b
+	}
+
+	for ; n != nil && !n.Key.(interval.Interval).Start.After(when.End); n = n.Next() {
+		b = append(b, Booking{
+			When: n.Key.(interval.Interval),
+			Name: n.Value.(string),
+		})
+	}
+
+	return b
+}
+
 func (d *Diary) IsAvailable() (bool, string) {
 
 	if d.available {
@@ -148,6 +227,11 @@ func (d *Diary) IsAvailable() (bool, string) {
 	return false, msg
 }
 
+// Status returns the status message, without the decoration that IsAvailable adds
+func (d *Diary) Status() string {
+	return d.status
+}
+
 // ValidateBooking checks if a given booking matches an existing booking
 // Returns false if the resource is not available so that it can be
 // used as a check on whether to supply connection info to user
@@ -187,11 +271,18 @@ func (d *Diary) ClearBefore(t time.Time) {
 		return
 	}
 
-	slots := d.bookings.Keys() //these are given in order
+	// bookings cannot overlap each other, so they end in the same order as they start,
+	// and we can stop at the first booking that has not ended
+	for n := d.bookings.Left(); n != nil; n = d.bookings.Left() {
+
+		when := n.Key.(interval.Interval)
+		name := n.Value.(string)
 
-	for _, when := range slots {
-		if when.(interval.Interval).End.Before(t) {
-			d.bookings.Remove(when)
+		if !when.End.Before(t) {
+			return
 		}
+
+		d.bookings.Remove(when)
+		delete(d.index, name)
 	}
 }
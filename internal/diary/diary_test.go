@@ -1,6 +1,7 @@
 package diary
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
@@ -208,3 +209,101 @@ func TestDeleteBooking(t *testing.T) {
 	assert.NoError(t, err)
 
 }
+
+func TestGetBookingsOverlapping(t *testing.T) {
+
+	d := New("test")
+
+	// a day of ten minute bookings, on the hour
+	for h := 0; h < 24; h++ {
+		start := w.Add(time.Duration(h) * time.Hour)
+		err := d.Request(interval.Interval{Start: start, End: start.Add(10 * time.Minute)}, "h"+strconv.Itoa(h))
+		assert.NoError(t, err)
+	}
+
+	// within a single booking
+	bs := d.GetBookingsOverlapping(interval.Interval{Start: w.Add(time.Minute), End: w.Add(2 * time.Minute)})
+	assert.Equal(t, 1, len(bs))
+	assert.Equal(t, "h0", bs[0].Name)
+
+	// in a gap between bookings
+	bs = d.GetBookingsOverlapping(interval.Interval{Start: w.Add(20 * time.Minute), End: w.Add(30 * time.Minute)})
+	assert.Equal(t, 0, len(bs))
+
+	// starting in a gap, ending part way through a booking
+	bs = d.GetBookingsOverlapping(interval.Interval{Start: w.Add(20 * time.Minute), End: w.Add(3*time.Hour + 5*time.Minute)})
+	assert.Equal(t, 3, len(bs))
+	assert.Equal(t, "h1", bs[0].Name)
+	assert.Equal(t, "h3", bs[2].Name)
+
+	// starting part way through a booking, running past the end of the diary
+	bs = d.GetBookingsOverlapping(interval.Interval{Start: w.Add(22*time.Hour + 5*time.Minute), End: w.Add(48 * time.Hour)})
+	assert.Equal(t, 2, len(bs))
+	assert.Equal(t, "h22", bs[0].Name)
+	assert.Equal(t, "h23", bs[1].Name)
+
+	// after all the bookings
+	bs = d.GetBookingsOverlapping(interval.Interval{Start: w.Add(24 * time.Hour), End: w.Add(48 * time.Hour)})
+	assert.Equal(t, 0, len(bs))
+
+	// deleting by name keeps the tree and index consistent
+	err := d.Delete("h2")
+	assert.NoError(t, err)
+	err = d.Delete("h2")
+	assert.Error(t, err)
+	bs = d.GetBookingsOverlapping(interval.Interval{Start: w, End: w.Add(3 * time.Hour)})
+	assert.Equal(t, 3, len(bs))
+
+	// the name can be reused once the booking is cleared
+	d.ClearBefore(w.Add(12 * time.Hour))
+	assert.Equal(t, 12, d.GetCount())
+	err = d.Request(interval.Interval{Start: w.Add(48 * time.Hour), End: w.Add(49 * time.Hour)}, "h0")
+	assert.NoError(t, err)
+	err = d.Request(interval.Interval{Start: w.Add(50 * time.Hour), End: w.Add(51 * time.Hour)}, "h12")
+	assert.Error(t, err)
+}
+
+func TestBuffer(t *testing.T) {
+
+	d := New("test")
+	d.SetBuffer(5 * time.Minute)
+	assert.Equal(t, 5*time.Minute, d.Buffer())
+
+	at := func(m int) time.Time {
+		return time.Date(2022, 11, 5, 10, 0, 0, 0, time.UTC).Add(time.Duration(m) * time.Minute)
+	}
+
+	err := d.Request(interval.Interval{Start: at(0), End: at(10)}, "bk-0")
+	assert.NoError(t, err)
+
+	// too soon after
+	err = d.Request(interval.Interval{Start: at(12), End: at(20)}, "bk-1")
+	assert.Error(t, err)
+	assert.Equal(t, "conflict with turnaround time of 5m0s around existing", err.Error())
+
+	// ends too close before
+	err = d.Request(interval.Interval{Start: at(-9), End: at(-4)}, "bk-2")
+	assert.Error(t, err)
+
+	// exactly one buffer either side is ok
+	err = d.Request(interval.Interval{Start: at(15), End: at(20)}, "bk-3")
+	assert.NoError(t, err)
+
+	err = d.Request(interval.Interval{Start: at(-10), End: at(-5)}, "bk-4")
+	assert.NoError(t, err)
+
+	// the bookings are stored without the buffer
+	b := d.GetBookingsOverlapping(interval.Interval{Start: at(0), End: at(10)})
+	assert.Equal(t, 1, len(b))
+	assert.Equal(t, interval.Interval{Start: at(0), End: at(10)}, b[0].When)
+
+	o := d.Occupied(b[0].When)
+	assert.Equal(t, at(-5).Add(time.Nanosecond), o.Start)
+	assert.Equal(t, at(15).Add(-time.Nanosecond), o.End)
+
+	// removing the buffer only affects later requests
+	d.SetBuffer(0)
+	err = d.Request(interval.Interval{Start: at(21), End: at(22)}, "bk-5")
+	assert.NoError(t, err)
+	assert.Equal(t, interval.Interval{Start: at(21), End: at(22)}, d.Occupied(interval.Interval{Start: at(21), End: at(22)}))
+}
@@ -6,34 +6,84 @@ import (
 	"github.com/practable/book/internal/interval"
 )
 
-//UserCommands represents commands that users will send to the system
-var UserCommands = []string{
-	"cancelBooking",  //cancel an existing booking. Fails if booking has been collected already.
-	"collectBooking", //get access to the experiment (may trigger some checks on video/data)
-	"requestBooking", // make a new booking, only completes if within allowable number of booked slots, and slot is free
-	"swapBooking",    // atomic action to cancel an existing booking and replace with a new one, only completes if new booking succesful
-	"getBookings",    // return my current bookings
-	"listBookings",   // return bookings for a given slot, in a particular interval.
+// Names of the actions that are recorded in the history
+// These are the mutating operations on the store, so that
+// replaying them in order rebuilds the state of the store
+const (
+	AddGroupForUser        = "addGroupForUser"        // user adds a group so they can book with its policies
+	CancelBooking          = "cancelBooking"          // cancel an existing booking (by user, admin or auto-grace-check)
+	CollectBooking         = "collectBooking"         // get access to the experiment, marking the booking as started
+	DeleteGroupForUser     = "deleteGroupForUser"     // remove a group from a user, cancelling bookings that relied on it
+	JoinWaitlist           = "joinWaitlist"           // register interest in time on a slot, in case it is freed by a cancellation
+	LeaveWaitlist          = "leaveWaitlist"          // remove an entry from the waitlist (by the user, or because freed time was booked for them)
+	OfferWaitlist          = "offerWaitlist"          // offer freed time (e.g. from a cancellation) to the first user waiting for it
+	PatchManifest          = "patchManifest"          // add, update or delete individual entities of the manifest
+	ReconcileManifest      = "reconcileManifest"      // replace the manifest, and keep, cancel or move the bookings it affects
+	ReplaceBookings        = "replaceBookings"        // replace all current bookings
+	ReplaceManifest        = "replaceManifest"        // replace the whole manifest
+	ReplaceOldBookings     = "replaceOldBookings"     // replace all old bookings (and thus users)
+	ReplaceUserGroups      = "replaceUserGroups"      // replace the groups of a set of users
+	RequestBooking         = "requestBooking"         // make a new booking, only completes if within policy and slot is free
+	ResizeBooking          = "resizeBooking"          // change the interval of an existing booking, only completes if within policy and slot is free
+	SetLock                = "setLock"                // lock or unlock the store
+	SetMessage             = "setMessage"             // set the message of the day
+	SetResourceIsAvailable = "setResourceIsAvailable" // set whether a resource can be used
+	SubstituteBooking      = "substituteBooking"      // move a booking onto another resource in the same pool, because its resource went offline
+	SwapBooking            = "swapBooking"            // atomic action to cancel an existing booking and replace with a new one, only completes if new booking successful
+)
 
+// UserCommands represents commands that users will send to the system
+var UserCommands = []string{
+	AddGroupForUser,
+	CancelBooking,
+	CollectBooking,
+	JoinWaitlist,
+	LeaveWaitlist,
+	RequestBooking,
+	ResizeBooking,
+	SwapBooking,
 }
 
 // AdminCommands represents commands used by the administrator
-// Notes ... do we separate configuration from user commands in the transaction history (probably no, for testing reasons)
-//
+// These are kept in the same history as the user commands, so
+// that a replay reflects the order in which they were applied
 var AdminCommands = []string{
-	"importTransactions",
-	"addSlot",
-	"deleteSlot",
+	DeleteGroupForUser,
+	PatchManifest,
+	ReconcileManifest,
+	ReplaceBookings,
+	ReplaceManifest,
+	ReplaceOldBookings,
+	ReplaceUserGroups,
+	SetLock,
+	SetMessage,
+	OfferWaitlist,
+	SetResourceIsAvailable,
+	SubstituteBooking,
 }
 
 // Action represents a booking action, including the time it was taken
 // so as to allow history-replay to rebuild the booking status based on
-// a record of past actions. This may also help with testing?
+// a record of past actions. Only the fields relevant to the action are set.
 type Action struct {
-	IssuedAt time.Time
-	Do       string
-	When     interval.Interval
-	Slot     string
-	Booking  string
-	User     string
+	IssuedAt time.Time         `json:"issued_at"`
+	Do       string            `json:"do"`
+	When     interval.Interval `json:"when"`
+	Slot     string            `json:"slot,omitempty"`
+	Booking  string            `json:"booking,omitempty"`
+	// NewBooking is the name of the booking that replaces Booking in a swap
+	NewBooking string `json:"new_booking,omitempty"`
+	// Entry is the name of a waitlist entry
+	Entry    string `json:"entry,omitempty"`
+	User     string `json:"user,omitempty"`
+	Group    string `json:"group,omitempty"`
+	Resource string `json:"resource,omitempty"`
+	// Flag holds the boolean argument of the action, e.g. whether to check groups when
+	// requesting a booking, whether a resource is available, or whether the store is locked
+	Flag bool `json:"flag,omitempty"`
+	// Reason holds the string argument of the action, e.g. who cancelled a booking,
+	// why a resource is unavailable, or the message of the day
+	Reason string `json:"reason,omitempty"`
+	// Payload holds the YAML-encoded argument of bulk actions such as replacing the manifest
+	Payload string `json:"payload,omitempty"`
 }
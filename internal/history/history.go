@@ -1,26 +1,239 @@
+// package history holds an append-only journal of the actions taken on the store
 package history
 
 import (
+	"bufio"
+	"encoding/json"
 	"errors"
+	"os"
+	"sync"
 	"time"
+
+	log "github.com/sirupsen/logrus"
 )
 
+// ErrNoMoreActions is returned by GetNext when the replay has finished
+var ErrNoMoreActions = errors.New("no more actions")
+
+// History represents the actions taken on the store, in the order they were taken.
+// If a journal file is open, every action added is also appended to that file.
 type History struct {
-	Name    string
-	Actions map[string]Action
+	*sync.RWMutex `json:"-"`
+	Name          string
+	actions       []Action
+	file          *os.File
+	now           func() time.Time
 }
 
+// Replay represents a position in the history, and the
+// conditions on which actions are to be replayed
 type Replay struct {
 	Name string
+	h    *History
+	next int
+	// actions must be issued after from (if set), and before to (if set)
+	from *time.Time
+	to   *time.Time
 }
 
-func (h *History) Add(action Action) {
+// New returns an empty history that is held only in memory
+func New(name string) *History {
+	return &History{
+		&sync.RWMutex{},
+		name,
+		[]Action{},
+		nil,
+		func() time.Time { return time.Now() },
+	}
+}
+
+// Open returns a history that is loaded from the journal file, if it exists,
+// and that appends every subsequent action to the journal file.
+// A partially written final line, e.g. due to a crash, is ignored, and
+// terminated so that the next action is appended on a line of its own.
+func Open(filename string) (*History, error) {
+
+	h := New(filename)
+
+	actions, err := Load(filename)
+
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	h.actions = actions
+
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
 
+	if err != nil {
+		return nil, err
+	}
+
+	err = terminateLastLine(f)
+
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	h.file = f
+
+	return h, nil
 }
 
-// how many replay pointers do we need?
-func (h *History) Replay() {
-	// reset replay pointer to start of history
+// terminateLastLine writes a newline to the end of the journal file if it does
+// not already end with one, so that a partially written final line is not
+// joined onto the next action that is appended
+func terminateLastLine(f *os.File) error {
+
+	fi, err := f.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	if fi.Size() == 0 {
+		return nil
+	}
+
+	r, err := os.Open(f.Name())
+
+	if err != nil {
+		return err
+	}
+
+	defer r.Close()
+
+	last := make([]byte, 1)
+
+	_, err = r.ReadAt(last, fi.Size()-1)
+
+	if err != nil {
+		return err
+	}
+
+	if last[0] == '\n' {
+		return nil
+	}
+
+	_, err = f.Write([]byte{'\n'})
+
+	if err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// Load returns the actions in a journal file, without opening it for writing
+// so that it can be used to inspect the history of a running store
+func Load(filename string) ([]Action, error) {
+
+	actions := []Action{}
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return actions, err
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// payloads hold whole manifests, so allow for long lines
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var a Action
+		err := json.Unmarshal(scanner.Bytes(), &a)
+		if err != nil {
+			log.Errorf("history: ignoring line %d of %s because %s", line, filename, err.Error())
+			continue
+		}
+		actions = append(actions, a)
+	}
+
+	return actions, scanner.Err()
+}
+
+// WithNow sets the time function (useful for mocking in tests)
+func (h *History) WithNow(now func() time.Time) *History {
+	h.Lock()
+	defer h.Unlock()
+	h.now = now
+	return h
+}
+
+// Add appends an action to the history, and to the journal file if there is one
+// The journal file is synced before returning, so that the action survives a crash.
+// If the action cannot be written to the journal file, it is not added to the history
+// either, so that positions in the history still match lines in the journal file.
+func (h *History) Add(action Action) error {
+
+	h.Lock()
+	defer h.Unlock()
+
+	if h.file == nil {
+		h.actions = append(h.actions, action)
+		return nil
+	}
+
+	d, err := json.Marshal(action)
+
+	if err != nil {
+		return err
+	}
+
+	fi, err := h.file.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = h.file.Write(append(d, '\n'))
+
+	if err == nil {
+		err = h.file.Sync()
+	}
+
+	if err != nil {
+		// remove any partial line so that it is not joined onto the next action
+		if terr := h.file.Truncate(fi.Size()); terr != nil {
+			log.Errorf("history: could not remove partial line from %s because %s", h.Name, terr.Error())
+		}
+		return err
+	}
+
+	h.actions = append(h.actions, action)
+
+	return nil
+}
+
+// Close closes the journal file, if there is one
+func (h *History) Close() error {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.file == nil {
+		return nil
+	}
+
+	err := h.file.Close()
+	h.file = nil
+	return err
+}
+
+// Len returns the number of actions in the history
+func (h *History) Len() int {
+	h.RLock()
+	defer h.RUnlock()
+	return len(h.actions)
 }
 
 // NewReplayAll returns a pointer to a Replay object
@@ -31,11 +244,10 @@ func (h *History) Replay() {
 // but before the message preceding them has been replayed
 // will be replayed too.
 func (h *History) NewReplayAll() *Replay {
-	// return a replay object,
-	// pointer to history to save copying it
-	// replay pointer set to beginning of stack
-
-	return &Replay{}
+	return &Replay{
+		Name: h.Name,
+		h:    h,
+	}
 }
 
 // NewReplayAllUntilNow returns a pointer to a Replay object
@@ -44,10 +256,25 @@ func (h *History) NewReplayAll() *Replay {
 // All messages received up until the current time
 // will be replayed
 func (h *History) NewReplayAllUntilNow() *Replay {
-	// return a replay object,
-	// pointer to history to save copying it
-	// replay pointer set to beginning of stack
-	return &Replay{}
+	h.RLock()
+	to := h.now()
+	h.RUnlock()
+	return &Replay{
+		Name: h.Name,
+		h:    h,
+		to:   &to,
+	}
+}
+
+// NewReplayFrom returns a pointer to a Replay object that starts
+// at the given position in the history, e.g. the number of actions
+// that had already been applied when a snapshot was taken
+func (h *History) NewReplayFrom(index int) *Replay {
+	return &Replay{
+		Name: h.Name,
+		h:    h,
+		next: index,
+	}
 }
 
 // NewReplayInterval returns a pointer to a Replay object
@@ -57,14 +284,37 @@ func (h *History) NewReplayAllUntilNow() *Replay {
 // Intervals are exclusive, due to the use of time.Before, and
 // time.After.
 func (h *History) NewReplayInterval(from, to time.Time) *Replay {
-	// return a replay object,
-	// pointer to history to save copying it
-	// replay pointer set to beginning of stack
-	return &Replay{}
+	return &Replay{
+		Name: h.Name,
+		h:    h,
+		from: &from,
+		to:   &to,
+	}
 }
 
-//
+// GetNext returns the next action to be replayed, or ErrNoMoreActions
+// if there are no more actions that meet the conditions of the replay
 func (r *Replay) GetNext() (*Action, error) {
-	return &Action{}, errors.New("not implemented")
 
+	r.h.RLock()
+	defer r.h.RUnlock()
+
+	for r.next < len(r.h.actions) {
+
+		a := r.h.actions[r.next]
+
+		r.next++
+
+		if r.from != nil && !a.IssuedAt.After(*r.from) {
+			continue
+		}
+
+		if r.to != nil && !a.IssuedAt.Before(*r.to) {
+			continue
+		}
+
+		return &a, nil
+	}
+
+	return nil, ErrNoMoreActions
 }
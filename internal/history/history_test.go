@@ -0,0 +1,118 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAddLoad(t *testing.T) {
+
+	fn := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	h, err := Open(fn)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, h.Len())
+
+	t0 := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+
+	err = h.Add(Action{IssuedAt: t0, Do: SetLock, Flag: true})
+	assert.NoError(t, err)
+	err = h.Add(Action{IssuedAt: t0.Add(time.Minute), Do: SetMessage, Reason: "hello"})
+	assert.NoError(t, err)
+
+	err = h.Close()
+	assert.NoError(t, err)
+
+	// simulate a crash part way through writing an action
+	f, err := os.OpenFile(fn, os.O_WRONLY|os.O_APPEND, 0600)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte(`{"issued_at":"2022-11-05T00:02`))
+	assert.NoError(t, err)
+	f.Close()
+
+	actions, err := Load(fn)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(actions))
+	assert.Equal(t, SetLock, actions[0].Do)
+	assert.True(t, actions[0].Flag)
+	assert.Equal(t, "hello", actions[1].Reason)
+	assert.True(t, t0.Add(time.Minute).Equal(actions[1].IssuedAt))
+
+	// reopening keeps the existing actions
+	h, err = Open(fn)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, h.Len())
+
+	// the next action is not joined onto the partial line
+	err = h.Add(Action{IssuedAt: t0.Add(3 * time.Minute), Do: SetMessage, Reason: "again"})
+	assert.NoError(t, err)
+	h.Close()
+
+	actions, err = Load(fn)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(actions))
+	assert.Equal(t, "again", actions[2].Reason)
+}
+
+func TestAddFailure(t *testing.T) {
+
+	fn := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	h, err := Open(fn)
+	assert.NoError(t, err)
+
+	t0 := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+
+	err = h.Add(Action{IssuedAt: t0, Do: SetLock, Flag: true})
+	assert.NoError(t, err)
+
+	// an action that cannot be written is not kept in memory either
+	h.file.Close()
+	err = h.Add(Action{IssuedAt: t0.Add(time.Minute), Do: SetMessage, Reason: "lost"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, h.Len())
+
+	actions, err := Load(fn)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(actions))
+}
+
+func TestReplay(t *testing.T) {
+
+	h := New("test")
+
+	t0 := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 4; i++ {
+		h.Add(Action{IssuedAt: t0.Add(time.Duration(i) * time.Minute), Do: SetMessage, Reason: string(rune('a' + i))})
+	}
+
+	got := func(r *Replay) string {
+		s := ""
+		for {
+			a, err := r.GetNext()
+			if err != nil {
+				assert.Equal(t, ErrNoMoreActions, err)
+				return s
+			}
+			s += a.Reason
+		}
+	}
+
+	assert.Equal(t, "abcd", got(h.NewReplayAll()))
+	assert.Equal(t, "cd", got(h.NewReplayFrom(2)))
+	assert.Equal(t, "bc", got(h.NewReplayInterval(t0, t0.Add(3*time.Minute))))
+
+	h.WithNow(func() time.Time { return t0.Add(90 * time.Second) })
+	assert.Equal(t, "ab", got(h.NewReplayAllUntilNow()))
+
+	// actions added during a replay are replayed too
+	r := h.NewReplayAll()
+	assert.Equal(t, "abcd", got(r))
+	h.Add(Action{IssuedAt: t0.Add(time.Hour), Do: SetMessage, Reason: "e"})
+	assert.Equal(t, "e", got(r))
+}
@@ -0,0 +1,123 @@
+// Package ical renders bookings as an iCalendar (RFC 5545) calendar
+// of events, so that users can subscribe to their bookings in their
+// usual calendar app, rather than each booking UI preparing .ics files
+package ical
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// prodID identifies the product that created the calendar
+	prodID = "-//practable//book//EN"
+	// format is the UTC date-time format used for all times
+	format = "20060102T150405Z"
+	// maxLine is the maximum length of a line in octets, excluding the line break
+	maxLine = 75
+)
+
+// Calendar is a named list of events
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Event is a booking, which is cancelled if the booking has been cancelled.
+// The UID must be stable across exports, so that calendar apps update
+// the existing event rather than adding another, e.g. when it is cancelled.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Cancelled   bool
+}
+
+// Render returns the calendar in iCalendar format, with the given time
+// as the time stamp of each event, i.e. the time the calendar was exported
+func (c Calendar) Render(stamp time.Time) string {
+
+	var b strings.Builder
+
+	line := func(s string) {
+		b.WriteString(fold(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:" + prodID)
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+
+	if c.Name != "" {
+		line("X-WR-CALNAME:" + escape(c.Name))
+	}
+
+	for _, e := range c.Events {
+
+		status := "CONFIRMED"
+		sequence := "0"
+
+		// the sequence is increased so that calendar apps apply the cancellation
+		if e.Cancelled {
+			status = "CANCELLED"
+			sequence = "1"
+		}
+
+		line("BEGIN:VEVENT")
+		line("UID:" + escape(e.UID))
+		line("DTSTAMP:" + stamp.UTC().Format(format))
+		line("DTSTART:" + e.Start.UTC().Format(format))
+		line("DTEND:" + e.End.UTC().Format(format))
+		line("SUMMARY:" + escape(e.Summary))
+
+		if e.Description != "" {
+			line("DESCRIPTION:" + escape(e.Description))
+		}
+
+		line("STATUS:" + status)
+		line("SEQUENCE:" + sequence)
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+
+	return b.String()
+}
+
+// escape escapes the characters that have special meaning in TEXT values
+func escape(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return r.Replace(s)
+}
+
+// fold splits lines longer than 75 octets, by inserting a line break followed by a
+// space, without splitting any multi-octet UTF-8 characters
+func fold(s string) string {
+
+	if len(s) <= maxLine {
+		return s
+	}
+
+	var b strings.Builder
+
+	n := 0 // octets on the current line
+
+	for _, r := range s {
+
+		l := len(string(r))
+
+		if n+l > maxLine {
+			b.WriteString("\r\n ")
+			n = 1 // the space counts towards the length of the continuation line
+		}
+
+		b.WriteRune(r)
+		n += l
+	}
+
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\, b\; c\\d\ne`, escape("a, b; c\\d\ne"))
+}
+
+func TestFold(t *testing.T) {
+
+	s := "DESCRIPTION:" + strings.Repeat("x", 100)
+
+	lines := strings.Split(fold(s), "\r\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, 75, len(lines[0]))
+	assert.Equal(t, " "+strings.Repeat("x", 37), lines[1])
+
+	// multi-octet characters are not split
+	s = strings.Repeat("é", 50)
+	lines = strings.Split(fold(s), "\r\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, 74, len(lines[0]))
+	assert.Equal(t, s, lines[0]+strings.TrimPrefix(lines[1], " "))
+
+	assert.Equal(t, "short", fold("short"))
+}
+
+func TestRender(t *testing.T) {
+
+	c := Calendar{
+		Name: "Bookings for user1",
+		Events: []Event{
+			Event{
+				UID:         "bk-0",
+				Start:       time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+				End:         time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+				Summary:     "Pendulum, spinning",
+				Description: "slot sl-a",
+			},
+			Event{
+				UID:       "bk-1",
+				Start:     time.Date(2022, 11, 6, 1, 30, 0, 0, time.UTC),
+				End:       time.Date(2022, 11, 6, 1, 40, 0, 0, time.UTC),
+				Summary:   "sl-b",
+				Cancelled: true,
+			},
+		},
+	}
+
+	exp := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//practable//book//EN\r\n" +
+		"CALSCALE:GREGORIAN\r\n" +
+		"METHOD:PUBLISH\r\n" +
+		"X-WR-CALNAME:Bookings for user1\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:bk-0\r\n" +
+		"DTSTAMP:20221105T010000Z\r\n" +
+		"DTSTART:20221105T013000Z\r\n" +
+		"DTEND:20221105T014000Z\r\n" +
+		"SUMMARY:Pendulum\\, spinning\r\n" +
+		"DESCRIPTION:slot sl-a\r\n" +
+		"STATUS:CONFIRMED\r\n" +
+		"SEQUENCE:0\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:bk-1\r\n" +
+		"DTSTAMP:20221105T010000Z\r\n" +
+		"DTSTART:20221106T013000Z\r\n" +
+		"DTEND:20221106T014000Z\r\n" +
+		"SUMMARY:sl-b\r\n" +
+		"STATUS:CANCELLED\r\n" +
+		"SEQUENCE:1\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	assert.Equal(t, exp, c.Render(time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC)))
+}
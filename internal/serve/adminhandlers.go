@@ -51,6 +51,38 @@ func checkManifestHandler(config config.ServerConfig) func(admin.CheckManifestPa
 	}
 }
 
+// convertManifestDiffToModel converts from internal to API type
+func convertManifestDiffToModel(d store.ManifestDiff) (models.ManifestDiff, error) {
+	var m models.ManifestDiff
+
+	y, err := json.Marshal(d)
+
+	if err != nil {
+		return m, err
+	}
+
+	err = json.Unmarshal(y, &m)
+
+	return m, err
+
+}
+
+// convertReconcileReportToModel converts from internal to API type
+func convertReconcileReportToModel(r store.ReconcileReport) (models.ReconcileReport, error) {
+	var m models.ReconcileReport
+
+	y, err := json.Marshal(r)
+
+	if err != nil {
+		return m, err
+	}
+
+	err = json.Unmarshal(y, &m)
+
+	return m, err
+
+}
+
 // convertStoreStatusAdminToModel converts from internal to API type
 func convertStoreStatusAdminToModel(s store.StoreStatusAdmin) (models.StoreStatusAdmin, error) {
 	var m models.StoreStatusAdmin
@@ -94,6 +126,7 @@ func convertBookingsToStore(m models.Bookings) (map[string]store.Booking, error)
 		b := store.Booking{
 			Name:        *v.Name,
 			Policy:      *v.Policy,
+			Resource:    v.Resource,
 			Slot:        *v.Slot,
 			User:        *v.User,
 			Cancelled:   v.Cancelled,
@@ -110,6 +143,138 @@ func convertBookingsToStore(m models.Bookings) (map[string]store.Booking, error)
 	return sm, nil
 }
 
+// convertRecurringBookingToStore converts the interval and recurrence of a recurring booking from API to internal types
+func convertRecurringBookingToStore(m models.RecurringBooking) (interval.Interval, store.Recurrence, error) {
+
+	start, err := dt.Parse(m.When.Start.String())
+	if err != nil {
+		return interval.Interval{}, store.Recurrence{}, err
+	}
+	end, err := dt.Parse(m.When.End.String())
+	if err != nil {
+		return interval.Interval{}, store.Recurrence{}, err
+	}
+
+	r := store.Recurrence{
+		Frequency: *m.Recurrence.Frequency,
+		Interval:  int(m.Recurrence.Interval),
+		Count:     int(m.Recurrence.Count),
+		Except:    []time.Time{},
+		TimeZone:  m.Recurrence.TimeZone,
+	}
+
+	if !time.Time(m.Recurrence.Until).IsZero() {
+		r.Until, err = dt.Parse(m.Recurrence.Until.String())
+		if err != nil {
+			return interval.Interval{}, store.Recurrence{}, err
+		}
+	}
+
+	for _, v := range m.Recurrence.Except {
+		e, err := dt.Parse(v.String())
+		if err != nil {
+			return interval.Interval{}, store.Recurrence{}, err
+		}
+		r.Except = append(r.Except, e)
+	}
+
+	return interval.Interval{Start: start, End: end}, r, nil
+}
+
+// convertWindowRulesToStore converts the recurring periods of a window from API to internal types
+func convertWindowRulesToStore(mm []*models.WindowRule) ([]store.WindowRule, error) {
+
+	var rr []store.WindowRule
+
+	for _, m := range mm {
+
+		from, err := dt.Parse(m.From.String())
+		if err != nil {
+			return []store.WindowRule{}, err
+		}
+		until, err := dt.Parse(m.Until.String())
+		if err != nil {
+			return []store.WindowRule{}, err
+		}
+
+		rr = append(rr, store.WindowRule{
+			Days:     m.Days,
+			End:      *m.End,
+			From:     from,
+			Start:    *m.Start,
+			TimeZone: m.TimeZone,
+			Until:    until,
+		})
+	}
+
+	return rr, nil
+}
+
+// convertWindowRulesToModel converts the recurring periods of a window from internal to API types
+func convertWindowRulesToModel(ss []store.WindowRule) []*models.WindowRule {
+
+	var rr []*models.WindowRule
+
+	for _, s := range ss {
+		rr = append(rr, &models.WindowRule{
+			Days:     s.Days,
+			End:      gog.Ptr(s.End),
+			From:     gog.Ptr(strfmt.DateTime(s.From)),
+			Start:    gog.Ptr(s.Start),
+			TimeZone: s.TimeZone,
+			Until:    gog.Ptr(strfmt.DateTime(s.Until)),
+		})
+	}
+
+	return rr
+}
+
+// convertManifestPatchToStore converts a manifest patch from API to internal type
+func convertManifestPatchToStore(mp models.ManifestPatch) (store.ManifestPatch, error) {
+
+	p := store.ManifestPatch{}
+
+	if mp.Set != nil {
+		sm, err := convertModelsManifestToStore(models.Manifest{
+			Blackouts:     mp.Set.Blackouts,
+			Descriptions:  mp.Set.Descriptions,
+			DisplayGuides: mp.Set.DisplayGuides,
+			Groups:        mp.Set.Groups,
+			Policies:      mp.Set.Policies,
+			Pools:         mp.Set.Pools,
+			Resources:     mp.Set.Resources,
+			Slots:         mp.Set.Slots,
+			Streams:       mp.Set.Streams,
+			Uis:           mp.Set.Uis,
+			UISets:        mp.Set.UISets,
+			Windows:       mp.Set.Windows,
+		})
+		if err != nil {
+			return store.ManifestPatch{}, err
+		}
+		p.Set = sm
+	}
+
+	if mp.Delete != nil {
+		p.Delete = store.ManifestDeletions{
+			Blackouts:     mp.Delete.Blackouts,
+			Descriptions:  mp.Delete.Descriptions,
+			DisplayGuides: mp.Delete.DisplayGuides,
+			Groups:        mp.Delete.Groups,
+			Policies:      mp.Delete.Policies,
+			Pools:         mp.Delete.Pools,
+			Resources:     mp.Delete.Resources,
+			Slots:         mp.Delete.Slots,
+			Streams:       mp.Delete.Streams,
+			UIs:           mp.Delete.Uis,
+			UISets:        mp.Delete.UISets,
+			Windows:       mp.Delete.Windows,
+		}
+	}
+
+	return p, nil
+}
+
 // convertManifestToStore converts from YAML string to internal type
 func convertManifestToStore(m string) (store.Manifest, error) {
 
@@ -161,9 +326,24 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 
 	for k, v := range mm.Groups {
 		m := v
+
+		var mu time.Duration
+		var err error
+
+		if m.EnforceMaxUsage {
+			mu, err = time.ParseDuration(m.MaxUsage)
+			if err != nil {
+				return store.Manifest{}, errors.New("error parsing duration max_usage in group " + k + " is " + err.Error())
+			}
+		}
+
 		gm[k] = store.Group{
-			Description: *(m.Description),
-			Policies:    m.Policies,
+			Description:        *(m.Description),
+			EnforceMaxBookings: m.EnforceMaxBookings,
+			EnforceMaxUsage:    m.EnforceMaxUsage,
+			MaxBookings:        m.MaxBookings,
+			MaxUsage:           mu,
+			Policies:           m.Policies,
 		}
 	}
 
@@ -176,7 +356,7 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 	for k, v := range mm.Policies {
 		m := v
 
-		var ba, gpd, gpy, nd, xd, mu, na, sp, sw time.Duration
+		var ba, ds, gpd, gpy, nd, xd, mu, mp, na, sa, sp, sw time.Duration
 		var err error
 
 		if m.EnforceBookAhead { //&& m.BookAhead != "" {
@@ -206,6 +386,31 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 			}
 		}
 
+		if m.EnforceMaxUsagePerPeriod {
+			mp, err = time.ParseDuration(m.MaxUsagePerPeriod)
+			if err != nil {
+				return store.Manifest{}, errors.New("error parsing duration max_usage_per_period in policy " + k + " is " + err.Error())
+			}
+		}
+
+		var ut []interval.Interval
+
+		for _, mi := range m.UsageTerms {
+
+			st, err := dt.Parse(mi.Start.String())
+			if err != nil {
+				return store.Manifest{}, err
+			}
+			et, err := dt.Parse(mi.End.String())
+			if err != nil {
+				return store.Manifest{}, err
+			}
+			ut = append(ut, interval.Interval{
+				Start: st,
+				End:   et,
+			})
+		}
+
 		if m.EnforceNextAvailable { // && m.NextAvailable != "" {
 			na, err = time.ParseDuration(m.NextAvailable)
 			if err != nil {
@@ -227,6 +432,20 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 			}
 		}
 
+		if m.EnforceStartAlignment {
+			sa, err = time.ParseDuration(m.StartAlignment)
+			if err != nil {
+				return store.Manifest{}, errors.New("error parsing duration start_alignment in policy " + k + " is " + err.Error())
+			}
+		}
+
+		if m.EnforceDurationStep {
+			ds, err = time.ParseDuration(m.DurationStep)
+			if err != nil {
+				return store.Manifest{}, errors.New("error parsing duration duration_step in policy " + k + " is " + err.Error())
+			}
+		}
+
 		if m.EnforceGracePeriod {
 
 			//if m.GracePeriod != "" {
@@ -244,29 +463,80 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 			//}
 		}
 		pm[k] = store.Policy{
-			AllowStartInPastWithin:  sp,
-			BookAhead:               ba,
-			Description:             *(m.Description),
-			DisplayGuides:           m.DisplayGuides,
-			EnforceAllowStartInPast: m.EnforceAllowStartInPast,
-			EnforceBookAhead:        m.EnforceBookAhead,
-			EnforceGracePeriod:      m.EnforceGracePeriod,
-			EnforceMaxBookings:      m.EnforceMaxBookings,
-			EnforceMaxDuration:      m.EnforceMaxDuration,
-			EnforceMinDuration:      m.EnforceMinDuration,
-			EnforceMaxUsage:         m.EnforceMaxUsage,
-			EnforceNextAvailable:    m.EnforceNextAvailable,
-			EnforceStartsWithin:     m.EnforceStartsWithin,
-			EnforceUnlimitedUsers:   m.EnforceUnlimitedUsers,
-			GracePenalty:            gpy,
-			GracePeriod:             gpd,
-			MaxBookings:             m.MaxBookings,
-			MaxDuration:             xd,
-			MinDuration:             nd,
-			MaxUsage:                mu,
-			NextAvailable:           na,
-			Slots:                   m.Slots,
-			StartsWithin:            sw,
+			AllowStartInPastWithin:   sp,
+			AutoBookWaitlist:         m.AutoBookWaitlist,
+			BookAhead:                ba,
+			Description:              *(m.Description),
+			DisplayGuides:            m.DisplayGuides,
+			DurationStep:             ds,
+			EnforceAllowStartInPast:  m.EnforceAllowStartInPast,
+			EnforceBookAhead:         m.EnforceBookAhead,
+			EnforceDurationStep:      m.EnforceDurationStep,
+			EnforceGracePeriod:       m.EnforceGracePeriod,
+			EnforceMaxBookings:       m.EnforceMaxBookings,
+			EnforceMaxDuration:       m.EnforceMaxDuration,
+			EnforceMinDuration:       m.EnforceMinDuration,
+			EnforceMaxUsage:          m.EnforceMaxUsage,
+			EnforceMaxUsagePerPeriod: m.EnforceMaxUsagePerPeriod,
+			EnforceNextAvailable:     m.EnforceNextAvailable,
+			EnforceStartAlignment:    m.EnforceStartAlignment,
+			EnforceStartsWithin:      m.EnforceStartsWithin,
+			EnforceUnlimitedUsers:    m.EnforceUnlimitedUsers,
+			GracePenalty:             gpy,
+			GracePeriod:              gpd,
+			MaxBookings:              m.MaxBookings,
+			MaxDuration:              xd,
+			MinDuration:              nd,
+			MaxUsage:                 mu,
+			MaxUsagePerPeriod:        mp,
+			NextAvailable:            na,
+			Priority:                 m.Priority,
+			Slots:                    m.Slots,
+			StartAlignment:           sa,
+			StartsWithin:             sw,
+			UsagePeriod:              m.UsagePeriod,
+			UsageTerms:               ut,
+		}
+	}
+
+	bom := make(map[string]store.Blackout)
+
+	for k, v := range mm.Blackouts {
+		m := v
+
+		dd := []interval.Interval{}
+
+		for _, mi := range m.Denied {
+
+			st, err := dt.Parse(mi.Start.String())
+			if err != nil {
+				return store.Manifest{}, err
+			}
+			et, err := dt.Parse(mi.End.String())
+			if err != nil {
+				return store.Manifest{}, err
+			}
+			mi := interval.Interval{
+				Start: st,
+				End:   et,
+			}
+
+			dd = append(dd, mi)
+		}
+
+		bom[k] = store.Blackout{
+			Denied:      dd,
+			Description: *(m.Description),
+		}
+	}
+
+	plm := make(map[string]store.Pool)
+
+	for k, v := range mm.Pools {
+		m := v
+		plm[k] = store.Pool{
+			Description: *(m.Description),
+			Resources:   m.Resources,
 		}
 	}
 
@@ -274,7 +544,19 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 
 	for k, v := range mm.Resources {
 		m := v
+
+		var bf time.Duration
+		var err error
+
+		if m.Buffer != "" {
+			bf, err = time.ParseDuration(m.Buffer)
+			if err != nil {
+				return store.Manifest{}, errors.New("error parsing duration buffer in resource " + k + " is " + err.Error())
+			}
+		}
+
 		rm[k] = store.Resource{
+			Buffer:      bf,
 			ConfigURL:   m.ConfigURL,
 			Description: *(m.Description),
 			Streams:     m.Streams,
@@ -371,17 +653,32 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 			dd = append(dd, mi)
 		}
 
+		ar, err := convertWindowRulesToStore(m.AllowedRules)
+		if err != nil {
+			return store.Manifest{}, err
+		}
+
+		dr, err := convertWindowRulesToStore(m.DeniedRules)
+		if err != nil {
+			return store.Manifest{}, err
+		}
+
 		wm[k] = store.Window{
-			Allowed: aa,
-			Denied:  dd,
+			Allowed:      aa,
+			AllowedRules: ar,
+			Blackouts:    m.Blackouts,
+			Denied:       dd,
+			DeniedRules:  dr,
 		}
 	}
 
 	sm := store.Manifest{
+		Blackouts:     bom,
 		Descriptions:  dm,
 		DisplayGuides: dgm,
 		Groups:        gm,
 		Policies:      pm,
+		Pools:         plm,
 		Resources:     rm,
 		Slots:         slm,
 		Streams:       stm,
@@ -394,6 +691,51 @@ func convertModelsManifestToStore(mm models.Manifest) (store.Manifest, error) {
 
 }
 
+// diffManifestHandler
+func diffManifestHandler(config config.ServerConfig) func(admin.DiffManifestParams, interface{}) middleware.Responder {
+	return func(params admin.DiffManifestParams, principal interface{}) middleware.Responder {
+
+		_, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewDiffManifestUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		sm, err := convertModelsManifestToStore(*params.Manifest)
+		if err != nil {
+			c := "500"
+			m := err.Error()
+			return admin.NewDiffManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		d, err, msgs := config.Store.DiffManifest(sm)
+
+		if err != nil && len(msgs) > 0 {
+			c := "409"
+			m := err.Error()
+			return admin.NewDiffManifestConflict().WithPayload(&models.ErrorList{Code: &c, Message: &m, Errors: msgs})
+		}
+
+		if err != nil {
+			c := "500"
+			m := err.Error()
+			return admin.NewDiffManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		md, err := convertManifestDiffToModel(d)
+
+		if err != nil {
+			c := "500"
+			m := "could not convert manifest diff to model format because " + err.Error()
+			return admin.NewDiffManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		return admin.NewDiffManifestOK().WithPayload(&md)
+	}
+}
+
 // exportBookingsHandler
 // https://github.com/go-swagger/go-swagger/issues/2275
 func exportBookingsHandler(config config.ServerConfig) func(admin.ExportBookingsParams, interface{}) middleware.Responder {
@@ -425,6 +767,7 @@ func exportBookingsHandler(config config.ServerConfig) func(admin.ExportBookings
 
 				Name:      gog.Ptr(v.Name),
 				Policy:    gog.Ptr(v.Policy),
+				Resource:  v.Resource,
 				Slot:      gog.Ptr(v.Slot),
 				User:      gog.Ptr(v.User),
 				Cancelled: v.Cancelled,
@@ -447,190 +790,270 @@ func exportBookingsHandler(config config.ServerConfig) func(admin.ExportBookings
 	}
 }
 
-// exportManifestHandler
-func exportManifestHandler(config config.ServerConfig) func(admin.ExportManifestParams, interface{}) middleware.Responder {
-	return func(params admin.ExportManifestParams, principal interface{}) middleware.Responder {
+// convertManifestToModel converts from internal to API type
+func convertManifestToModel(sm store.Manifest) models.Manifest {
 
-		_, err := isAdmin(principal)
+	dm := make(map[string]models.Description)
 
-		if err != nil {
-			c := "401"
-			m := "no scope booking:admin"
-			return admin.NewExportManifestUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+	for k, v := range sm.Descriptions {
+		s := v
+		dm[k] = models.Description{
+			Name:    gog.Ptr(s.Name),
+			Short:   s.Short,
+			Type:    gog.Ptr(s.Type),
+			Long:    s.Long,
+			Further: s.Further,
+			Thumb:   s.Thumb,
+			Image:   s.Image,
 		}
+	}
 
-		sm := config.Store.ExportManifest()
+	dgm := make(map[string]models.DisplayGuide)
 
-		dm := make(map[string]models.Description)
+	for k, v := range sm.DisplayGuides {
+		s := v
+		dgm[k] = models.DisplayGuide{
+			BookAhead: gog.Ptr(s.BookAhead.String()),
+			Duration:  gog.Ptr(s.Duration.String()),
+			MaxSlots:  gog.Ptr(int64(s.MaxSlots)),
+			Label:     gog.Ptr(s.Label),
+		}
+	}
 
-		for k, v := range sm.Descriptions {
-			s := v
-			dm[k] = models.Description{
-				Name:    gog.Ptr(s.Name),
-				Short:   s.Short,
-				Type:    gog.Ptr(s.Type),
-				Long:    s.Long,
-				Further: s.Further,
-				Thumb:   s.Thumb,
-				Image:   s.Image,
-			}
+	gm := make(map[string]models.Group)
+
+	for k, v := range sm.Groups {
+		s := v
+
+		mu := ""
+
+		if s.MaxUsage > 0 {
+			mu = s.MaxUsage.String()
 		}
 
-		dgm := make(map[string]models.DisplayGuide)
+		gm[k] = models.Group{
+			Description:        gog.Ptr(s.Description),
+			EnforceMaxBookings: s.EnforceMaxBookings,
+			EnforceMaxUsage:    s.EnforceMaxUsage,
+			MaxBookings:        s.MaxBookings,
+			MaxUsage:           mu,
+			Policies:           s.Policies,
+		}
+	}
 
-		for k, v := range sm.DisplayGuides {
-			s := v
-			dgm[k] = models.DisplayGuide{
-				BookAhead: gog.Ptr(s.BookAhead.String()),
-				Duration:  gog.Ptr(s.Duration.String()),
-				MaxSlots:  gog.Ptr(int64(s.MaxSlots)),
-				Label:     gog.Ptr(s.Label),
+	pm := make(map[string]models.Policy)
+
+	for k, v := range sm.Policies {
+		s := v
+
+		var ut []*models.Interval
+
+		for _, si := range s.UsageTerms {
+			mi := models.Interval{
+				Start: strfmt.DateTime(si.Start),
+				End:   strfmt.DateTime(si.End),
 			}
+			ut = append(ut, &mi)
+		}
+
+		pm[k] = models.Policy{
+			AllowStartInPastWithin:   s.AllowStartInPastWithin.String(),
+			AutoBookWaitlist:         s.AutoBookWaitlist,
+			BookAhead:                s.BookAhead.String(),
+			Description:              gog.Ptr(s.Description),
+			DisplayGuides:            s.DisplayGuides,
+			DurationStep:             s.DurationStep.String(),
+			EnforceAllowStartInPast:  s.EnforceAllowStartInPast,
+			EnforceBookAhead:         s.EnforceBookAhead,
+			EnforceDurationStep:      s.EnforceDurationStep,
+			EnforceGracePeriod:       s.EnforceGracePeriod,
+			EnforceMaxBookings:       s.EnforceMaxBookings,
+			EnforceMaxDuration:       s.EnforceMaxDuration,
+			EnforceMinDuration:       s.EnforceMinDuration,
+			EnforceMaxUsage:          s.EnforceMaxUsage,
+			EnforceMaxUsagePerPeriod: s.EnforceMaxUsagePerPeriod,
+			EnforceNextAvailable:     s.EnforceNextAvailable,
+			EnforceStartAlignment:    s.EnforceStartAlignment,
+			EnforceStartsWithin:      s.EnforceStartsWithin,
+			EnforceUnlimitedUsers:    s.EnforceUnlimitedUsers,
+			GracePenalty:             s.GracePenalty.String(),
+			GracePeriod:              s.GracePeriod.String(),
+			MaxBookings:              s.MaxBookings,
+			MaxDuration:              s.MaxDuration.String(),
+			MinDuration:              s.MinDuration.String(),
+			MaxUsage:                 s.MaxUsage.String(),
+			MaxUsagePerPeriod:        s.MaxUsagePerPeriod.String(),
+			NextAvailable:            s.NextAvailable.String(),
+			Priority:                 s.Priority,
+			Slots:                    s.Slots,
+			StartAlignment:           s.StartAlignment.String(),
+			StartsWithin:             s.StartsWithin.String(),
+			UsagePeriod:              s.UsagePeriod,
+			UsageTerms:               ut,
 		}
+	}
 
-		gm := make(map[string]models.Group)
+	bom := make(map[string]models.Blackout)
 
-		for k, v := range sm.Groups {
-			s := v
-			gm[k] = models.Group{
-				Description: gog.Ptr(s.Description),
-				Policies:    s.Policies,
+	for k, v := range sm.Blackouts {
+		s := v
+
+		dd := []*models.Interval{}
+
+		for _, si := range s.Denied {
+			mi := models.Interval{
+				Start: strfmt.DateTime(si.Start),
+				End:   strfmt.DateTime(si.End),
 			}
+			dd = append(dd, &mi)
 		}
 
-		pm := make(map[string]models.Policy)
+		bom[k] = models.Blackout{
+			Denied:      dd,
+			Description: gog.Ptr(s.Description),
+		}
+	}
 
-		for k, v := range sm.Policies {
-			s := v
+	plm := make(map[string]models.Pool)
 
-			pm[k] = models.Policy{
-				AllowStartInPastWithin:  s.AllowStartInPastWithin.String(),
-				BookAhead:               s.BookAhead.String(),
-				Description:             gog.Ptr(s.Description),
-				DisplayGuides:           s.DisplayGuides,
-				EnforceAllowStartInPast: s.EnforceAllowStartInPast,
-				EnforceBookAhead:        s.EnforceBookAhead,
-				EnforceGracePeriod:      s.EnforceGracePeriod,
-				EnforceMaxBookings:      s.EnforceMaxBookings,
-				EnforceMaxDuration:      s.EnforceMaxDuration,
-				EnforceMinDuration:      s.EnforceMinDuration,
-				EnforceMaxUsage:         s.EnforceMaxUsage,
-				EnforceNextAvailable:    s.EnforceNextAvailable,
-				EnforceStartsWithin:     s.EnforceStartsWithin,
-				EnforceUnlimitedUsers:   s.EnforceUnlimitedUsers,
-				GracePenalty:            s.GracePenalty.String(),
-				GracePeriod:             s.GracePeriod.String(),
-				MaxBookings:             s.MaxBookings,
-				MaxDuration:             s.MaxDuration.String(),
-				MinDuration:             s.MinDuration.String(),
-				MaxUsage:                s.MaxUsage.String(),
-				NextAvailable:           s.NextAvailable.String(),
-				Slots:                   s.Slots,
-				StartsWithin:            s.StartsWithin.String(),
-			}
+	for k, v := range sm.Pools {
+		s := v
+		plm[k] = models.Pool{
+			Description: gog.Ptr(s.Description),
+			Resources:   s.Resources,
 		}
+	}
 
-		rm := make(map[string]models.Resource)
+	rm := make(map[string]models.Resource)
 
-		for k, v := range sm.Resources {
-			s := v
-			rm[k] = models.Resource{
-				ConfigURL:   s.ConfigURL,
-				Description: gog.Ptr(s.Description),
-				Streams:     s.Streams,
-				Tests:       s.Tests,
-				TopicStub:   gog.Ptr(s.TopicStub),
-			}
+	for k, v := range sm.Resources {
+		s := v
+		var bf string
+
+		if s.Buffer > 0 {
+			bf = s.Buffer.String()
 		}
 
-		slm := make(map[string]models.Slot)
+		rm[k] = models.Resource{
+			Buffer:      bf,
+			ConfigURL:   s.ConfigURL,
+			Description: gog.Ptr(s.Description),
+			Streams:     s.Streams,
+			Tests:       s.Tests,
+			TopicStub:   gog.Ptr(s.TopicStub),
+		}
+	}
 
-		for k, v := range sm.Slots {
-			s := v
-			slm[k] = models.Slot{
-				Description: gog.Ptr(s.Description),
-				Policy:      gog.Ptr(s.Policy),
-				Resource:    gog.Ptr(s.Resource),
-				UISet:       gog.Ptr(s.UISet),
-				Window:      gog.Ptr(s.Window),
-			}
+	slm := make(map[string]models.Slot)
+
+	for k, v := range sm.Slots {
+		s := v
+		slm[k] = models.Slot{
+			Description: gog.Ptr(s.Description),
+			Policy:      gog.Ptr(s.Policy),
+			Resource:    gog.Ptr(s.Resource),
+			UISet:       gog.Ptr(s.UISet),
+			Window:      gog.Ptr(s.Window),
 		}
+	}
 
-		stm := make(map[string]models.ManifestStream)
+	stm := make(map[string]models.ManifestStream)
 
-		for k, v := range sm.Streams {
-			s := v
-			stm[k] = models.ManifestStream{
-				ConnectionType: gog.Ptr(s.ConnectionType),
-				For:            gog.Ptr(s.For),
-				Scopes:         s.Scopes,
-				Topic:          gog.Ptr(s.Topic),
-				URL:            gog.Ptr(s.URL),
-			}
+	for k, v := range sm.Streams {
+		s := v
+		stm[k] = models.ManifestStream{
+			ConnectionType: gog.Ptr(s.ConnectionType),
+			For:            gog.Ptr(s.For),
+			Scopes:         s.Scopes,
+			Topic:          gog.Ptr(s.Topic),
+			URL:            gog.Ptr(s.URL),
 		}
+	}
 
-		uim := make(map[string]models.UI)
+	uim := make(map[string]models.UI)
 
-		for k, v := range sm.UIs {
-			s := v
-			uim[k] = models.UI{
-				Description:     gog.Ptr(s.Description),
-				StreamsRequired: s.StreamsRequired,
-				URL:             gog.Ptr(s.URL),
-			}
+	for k, v := range sm.UIs {
+		s := v
+		uim[k] = models.UI{
+			Description:     gog.Ptr(s.Description),
+			StreamsRequired: s.StreamsRequired,
+			URL:             gog.Ptr(s.URL),
 		}
+	}
 
-		usm := make(map[string]models.UISet)
+	usm := make(map[string]models.UISet)
 
-		for k, v := range sm.UISets {
-			s := v
-			usm[k] = models.UISet{
-				UIs: s.UIs,
-			}
+	for k, v := range sm.UISets {
+		s := v
+		usm[k] = models.UISet{
+			UIs: s.UIs,
 		}
+	}
 
-		wm := make(map[string]models.Window)
+	wm := make(map[string]models.Window)
 
-		for k, v := range sm.Windows {
-			s := v
+	for k, v := range sm.Windows {
+		s := v
 
-			aa := []*models.Interval{}
-			dd := []*models.Interval{}
+		aa := []*models.Interval{}
+		dd := []*models.Interval{}
 
-			for _, si := range s.Allowed {
-				mi := models.Interval{
-					Start: strfmt.DateTime(si.Start),
-					End:   strfmt.DateTime(si.End),
-				}
-				aa = append(aa, &mi)
+		for _, si := range s.Allowed {
+			mi := models.Interval{
+				Start: strfmt.DateTime(si.Start),
+				End:   strfmt.DateTime(si.End),
 			}
-			for _, si := range s.Denied {
-				mi := models.Interval{
-					Start: strfmt.DateTime(si.Start),
-					End:   strfmt.DateTime(si.End),
-				}
-				dd = append(dd, &mi)
+			aa = append(aa, &mi)
+		}
+		for _, si := range s.Denied {
+			mi := models.Interval{
+				Start: strfmt.DateTime(si.Start),
+				End:   strfmt.DateTime(si.End),
 			}
+			dd = append(dd, &mi)
+		}
 
-			wm[k] = models.Window{
-				Allowed: aa,
-				Denied:  dd,
-			}
+		wm[k] = models.Window{
+			Allowed:      aa,
+			AllowedRules: convertWindowRulesToModel(s.AllowedRules),
+			Blackouts:    s.Blackouts,
+			Denied:       dd,
+			DeniedRules:  convertWindowRulesToModel(s.DeniedRules),
 		}
+	}
+
+	return models.Manifest{
+		Blackouts:     bom,
+		Descriptions:  dm,
+		DisplayGuides: dgm,
+		Groups:        gm,
+		Policies:      pm,
+		Pools:         plm,
+		Resources:     rm,
+		Slots:         slm,
+		Streams:       stm,
+		Uis:           uim,
+		UISets:        usm,
+		Windows:       wm,
+	}
+}
 
-		mm := models.Manifest{
-			Descriptions:  dm,
-			DisplayGuides: dgm,
-			Groups:        gm,
-			Policies:      pm,
-			Resources:     rm,
-			Slots:         slm,
-			Streams:       stm,
-			Uis:           uim,
-			UISets:        usm,
-			Windows:       wm,
+// exportManifestHandler
+func exportManifestHandler(config config.ServerConfig) func(admin.ExportManifestParams, interface{}) middleware.Responder {
+	return func(params admin.ExportManifestParams, principal interface{}) middleware.Responder {
+
+		_, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewExportManifestUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
 		}
 
+		sm := config.Store.ExportManifest()
+
+		mm := convertManifestToModel(sm)
+
 		return admin.NewExportManifestOK().WithPayload(&mm)
 	}
 }
@@ -665,6 +1088,7 @@ func exportOldBookingsHandler(config config.ServerConfig) func(admin.ExportOldBo
 
 				Name:      gog.Ptr(v.Name),
 				Policy:    gog.Ptr(v.Policy),
+				Resource:  v.Resource,
 				Slot:      gog.Ptr(v.Slot),
 				User:      gog.Ptr(v.User),
 				Cancelled: v.Cancelled,
@@ -706,7 +1130,14 @@ func getResourcesHandler(config config.ServerConfig) func(admin.GetResourcesPara
 
 		for k, v := range rs {
 			s := v
+			var bf string
+
+			if s.Buffer > 0 {
+				bf = s.Buffer.String()
+			}
+
 			rm[k] = models.Resource{
+				Buffer:      bf,
 				ConfigURL:   s.ConfigURL,
 				Description: gog.Ptr(s.Description),
 				Streams:     s.Streams,
@@ -798,6 +1229,89 @@ func getStoreStatusAdminHandler(config config.ServerConfig) func(admin.GetStoreS
 	}
 }
 
+// getBookingsCalendarForResourceHandler
+func getBookingsCalendarForResourceHandler(config config.ServerConfig) func(admin.GetBookingsCalendarForResourceParams, interface{}) middleware.Responder {
+	return func(params admin.GetBookingsCalendarForResourceParams, principal interface{}) middleware.Responder {
+
+		_, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewGetBookingsCalendarForResourceUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		cal, err := config.Store.GetCalendarForResource(params.ResourceName)
+
+		if err != nil {
+			c := "404"
+			m := err.Error()
+			return admin.NewGetBookingsCalendarForResourceNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		return admin.NewGetBookingsCalendarForResourceOK().WithPayload(cal.Render(config.Store.Now()))
+	}
+}
+
+// getManifestVersionHandler
+func getManifestVersionHandler(config config.ServerConfig) func(admin.GetManifestVersionParams, interface{}) middleware.Responder {
+	return func(params admin.GetManifestVersionParams, principal interface{}) middleware.Responder {
+
+		_, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewGetManifestVersionUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		v, err := config.Store.GetManifestVersion(int(params.Version))
+
+		if err != nil {
+			c := "404"
+			m := err.Error()
+			return admin.NewGetManifestVersionNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		mm := convertManifestToModel(v.Manifest)
+
+		mv := models.ManifestVersion{
+			Applied:  strfmt.DateTime(v.Applied),
+			Author:   v.Author,
+			Manifest: &mm,
+			Version:  int64(v.Version),
+		}
+
+		return admin.NewGetManifestVersionOK().WithPayload(&mv)
+	}
+}
+
+// getManifestVersionsHandler
+func getManifestVersionsHandler(config config.ServerConfig) func(admin.GetManifestVersionsParams, interface{}) middleware.Responder {
+	return func(params admin.GetManifestVersionsParams, principal interface{}) middleware.Responder {
+
+		_, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewGetManifestVersionsUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		vs := models.ManifestVersions{}
+
+		for _, v := range config.Store.GetManifestVersions() {
+			vs = append(vs, &models.ManifestVersionInfo{
+				Applied: strfmt.DateTime(v.Applied),
+				Author:  v.Author,
+				Version: int64(v.Version),
+			})
+		}
+
+		return admin.NewGetManifestVersionsOK().WithPayload(vs)
+	}
+}
+
 // getResourceIsAvailableHandlerFunc
 func getResourceIsAvailableHandler(config config.ServerConfig) func(admin.GetResourceIsAvailableParams, interface{}) middleware.Responder {
 	return func(params admin.GetResourceIsAvailableParams, principal interface{}) middleware.Responder {
@@ -854,6 +1368,141 @@ func getSlotIsAvailableHandler(config config.ServerConfig) func(admin.GetSlotIsA
 	}
 }
 
+// makeRecurringBookingHandler
+func makeRecurringBookingHandler(config config.ServerConfig) func(admin.MakeRecurringBookingParams, interface{}) middleware.Responder {
+	return func(params admin.MakeRecurringBookingParams, principal interface{}) middleware.Responder {
+
+		_, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewMakeRecurringBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		rb := params.RecurringBooking
+
+		when, r, err := convertRecurringBookingToStore(*rb)
+
+		if err != nil {
+			c := "404"
+			m := "could not parse recurring booking because " + err.Error()
+			return admin.NewMakeRecurringBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		bs, err, msgs := config.Store.MakeRecurringBooking(*rb.Slot, *rb.User, when, r)
+
+		if err != nil && len(msgs) > 0 {
+			c := "409"
+			m := err.Error()
+			return admin.NewMakeRecurringBookingConflict().WithPayload(&models.ErrorList{Code: &c, Message: &m, Errors: msgs})
+		}
+
+		if err != nil {
+			c := "404"
+			m := "could not make the recurring booking because " + err.Error()
+			return admin.NewMakeRecurringBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		bm := []*models.Booking{}
+
+		for _, v := range bs {
+			b := convertBookingToModel(v)
+			bm = append(bm, &b)
+		}
+
+		return admin.NewMakeRecurringBookingOK().WithPayload(bm)
+	}
+}
+
+// patchManifestHandler
+func patchManifestHandler(config config.ServerConfig) func(admin.PatchManifestParams, interface{}) middleware.Responder {
+	return func(params admin.PatchManifestParams, principal interface{}) middleware.Responder {
+
+		claims, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewPatchManifestUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		sp, err := convertManifestPatchToStore(*params.Patch)
+		if err != nil {
+			c := "500"
+			m := err.Error()
+			return admin.NewPatchManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		err, msgs := config.Store.PatchManifestWithAuthor(sp, subjectOrAdmin(claims))
+
+		if err != nil && len(msgs) > 0 {
+			c := "409"
+			m := err.Error()
+			return admin.NewPatchManifestConflict().WithPayload(&models.ErrorList{Code: &c, Message: &m, Errors: msgs})
+		}
+
+		if err != nil {
+			c := "500"
+			m := err.Error()
+			return admin.NewPatchManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		s, err := convertStoreStatusAdminToModel(config.Store.GetStoreStatusAdmin())
+
+		if err != nil {
+			log.Error("could not convert StoreStatusAdmin to model format")
+		}
+
+		return admin.NewPatchManifestOK().WithPayload(&s)
+	}
+}
+
+// reconcileManifestHandler
+func reconcileManifestHandler(config config.ServerConfig) func(admin.ReconcileManifestParams, interface{}) middleware.Responder {
+	return func(params admin.ReconcileManifestParams, principal interface{}) middleware.Responder {
+
+		claims, err := isAdmin(principal)
+
+		if err != nil {
+			c := "401"
+			m := "no scope booking:admin"
+			return admin.NewReconcileManifestUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		sm, err := convertModelsManifestToStore(*params.Manifest)
+		if err != nil {
+			c := "500"
+			m := err.Error()
+			return admin.NewReconcileManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		r, err, msgs := config.Store.ReconcileManifestWithAuthor(sm, params.Mode, subjectOrAdmin(claims))
+
+		if err != nil && len(msgs) > 0 {
+			c := "409"
+			m := err.Error()
+			return admin.NewReconcileManifestConflict().WithPayload(&models.ErrorList{Code: &c, Message: &m, Errors: msgs})
+		}
+
+		if err != nil {
+			c := "500"
+			m := err.Error()
+			return admin.NewReconcileManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		mr, err := convertReconcileReportToModel(r)
+
+		if err != nil {
+			c := "500"
+			m := "could not convert reconcile report to model format because " + err.Error()
+			return admin.NewReconcileManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		return admin.NewReconcileManifestOK().WithPayload(&mr)
+	}
+}
+
 // replaceBookingsHandler
 func replaceBookingsHandler(config config.ServerConfig) func(admin.ReplaceBookingsParams, interface{}) middleware.Responder {
 	return func(params admin.ReplaceBookingsParams, principal interface{}) middleware.Responder {
@@ -896,7 +1545,7 @@ func replaceBookingsHandler(config config.ServerConfig) func(admin.ReplaceBookin
 func replaceManifestHandler(config config.ServerConfig) func(admin.ReplaceManifestParams, interface{}) middleware.Responder {
 	return func(params admin.ReplaceManifestParams, principal interface{}) middleware.Responder {
 
-		_, err := isAdmin(principal)
+		claims, err := isAdmin(principal)
 
 		if err != nil {
 			c := "401"
@@ -911,7 +1560,7 @@ func replaceManifestHandler(config config.ServerConfig) func(admin.ReplaceManife
 			return admin.NewReplaceManifestInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
 		}
 
-		err = config.Store.ReplaceManifest(sm)
+		err = config.Store.ReplaceManifestWithAuthor(sm, subjectOrAdmin(claims))
 		if err != nil {
 			c := "500"
 			m := err.Error()
@@ -976,10 +1625,10 @@ func setLockHandler(config config.ServerConfig) func(admin.SetLockParams, interf
 			return admin.NewSetLockUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
 		}
 
-		config.Store.Locked = params.Lock
+		config.Store.SetLock(params.Lock)
 
 		if params.Msg != nil {
-			config.Store.Message = *(params.Msg)
+			config.Store.SetMessage(*(params.Msg))
 		}
 
 		s, err := convertStoreStatusAdminToModel(config.Store.GetStoreStatusAdmin())
@@ -56,6 +56,17 @@ func isAdmin(principal interface{}) (*lit.Token, error) {
 	return claims, nil
 }
 
+// subjectOrAdmin returns the subject of the token, or admin if there is none, so that
+// changes made with a token can be attributed, e.g. in the versions of the manifest
+func subjectOrAdmin(claims *lit.Token) string {
+
+	if claims.Subject == "" {
+		return "admin"
+	}
+
+	return claims.Subject
+}
+
 // isUser returns nil if token has booking:user scope, otherwise error
 func isUser(principal interface{}) (*lit.Token, error) {
 
@@ -23,6 +23,18 @@ type Group struct {
 	// Required: true
 	Description *string `json:"description"`
 
+	// Limit the number of current/future bookings held by all members of the group, under the group's policies
+	EnforceMaxBookings bool `json:"enforce_max_bookings,omitempty"`
+
+	// Limit the usage of all members of the group, under the group's policies
+	EnforceMaxUsage bool `json:"enforce_max_usage,omitempty"`
+
+	// max bookings
+	MaxBookings int64 `json:"max_bookings,omitempty"`
+
+	// max usage
+	MaxUsage string `json:"max_usage,omitempty"`
+
 	// policies
 	// Required: true
 	Policies []string `json:"policies"`
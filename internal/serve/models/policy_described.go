@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -22,6 +23,9 @@ type PolicyDescribed struct {
 	// allow start in past within
 	AllowStartInPastWithin string `json:"allow_start_in_past_within,omitempty"`
 
+	// auto book waitlist
+	AutoBookWaitlist bool `json:"auto_book_waitlist,omitempty"`
+
 	// book ahead
 	BookAhead string `json:"book_ahead,omitempty"`
 
@@ -32,12 +36,18 @@ type PolicyDescribed struct {
 	// display guides
 	DisplayGuides map[string]DisplayGuide `json:"display_guides,omitempty"`
 
+	// duration step
+	DurationStep string `json:"duration_step,omitempty"`
+
 	// enforce allow start in past
 	EnforceAllowStartInPast bool `json:"enforce_allow_start_in_past,omitempty"`
 
 	// enforce book ahead
 	EnforceBookAhead bool `json:"enforce_book_ahead,omitempty"`
 
+	// enforce duration step
+	EnforceDurationStep bool `json:"enforce_duration_step,omitempty"`
+
 	// enforce grace period
 	EnforceGracePeriod bool `json:"enforce_grace_period,omitempty"`
 
@@ -50,12 +60,18 @@ type PolicyDescribed struct {
 	// enforce max usage
 	EnforceMaxUsage bool `json:"enforce_max_usage,omitempty"`
 
+	// enforce max usage per period
+	EnforceMaxUsagePerPeriod bool `json:"enforce_max_usage_per_period,omitempty"`
+
 	// enforce min duration
 	EnforceMinDuration bool `json:"enforce_min_duration,omitempty"`
 
 	// enforce next available
 	EnforceNextAvailable bool `json:"enforce_next_available,omitempty"`
 
+	// enforce start alignment
+	EnforceStartAlignment bool `json:"enforce_start_alignment,omitempty"`
+
 	// enforce starts within
 	EnforceStartsWithin bool `json:"enforce_starts_within,omitempty"`
 
@@ -77,18 +93,33 @@ type PolicyDescribed struct {
 	// max usage
 	MaxUsage string `json:"max_usage,omitempty"`
 
+	// Usage allowed in each usage period, e.g. 2h, if enforced
+	MaxUsagePerPeriod string `json:"max_usage_per_period,omitempty"`
+
 	// min duration
 	MinDuration string `json:"min_duration,omitempty"`
 
 	// next available
 	NextAvailable string `json:"next_available,omitempty"`
 
+	// Bookings under this policy displace conflicting bookings under policies of lower priority (default 0)
+	Priority int64 `json:"priority,omitempty"`
+
 	// slots
 	// Required: true
 	Slots map[string]SlotDescribed `json:"slots"`
 
+	// start alignment
+	StartAlignment string `json:"start_alignment,omitempty"`
+
 	// starts within
 	StartsWithin string `json:"starts_within,omitempty"`
+
+	// Period over which max_usage_per_period applies, one of daily, weekly (starting Monday, UTC) or termly
+	UsagePeriod string `json:"usage_period,omitempty"`
+
+	// Terms for a termly usage period. Bookings starting outside all terms are not limited per period
+	UsageTerms []*Interval `json:"usage_terms,omitempty"`
 }
 
 // Validate validates this policy described
@@ -107,6 +138,10 @@ func (m *PolicyDescribed) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateUsageTerms(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -186,6 +221,32 @@ func (m *PolicyDescribed) validateSlots(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PolicyDescribed) validateUsageTerms(formats strfmt.Registry) error {
+	if swag.IsZero(m.UsageTerms) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.UsageTerms); i++ {
+		if swag.IsZero(m.UsageTerms[i]) { // not required
+			continue
+		}
+
+		if m.UsageTerms[i] != nil {
+			if err := m.UsageTerms[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("usage_terms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("usage_terms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this policy described based on the context it is used
 func (m *PolicyDescribed) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -202,6 +263,10 @@ func (m *PolicyDescribed) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateUsageTerms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -258,6 +323,26 @@ func (m *PolicyDescribed) contextValidateSlots(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *PolicyDescribed) contextValidateUsageTerms(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.UsageTerms); i++ {
+
+		if m.UsageTerms[i] != nil {
+			if err := m.UsageTerms[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("usage_terms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("usage_terms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *PolicyDescribed) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -23,10 +23,27 @@ type PolicyStatus struct {
 	// Required: true
 	CurrentBookings *int64 `json:"current_bookings"`
 
+	// Status of each of the user's groups that include the policy and limit bookings or usage
+	Groups map[string]GroupStatus `json:"groups,omitempty"`
+
 	// old bookings
 	// Required: true
 	OldBookings *int64 `json:"old_bookings"`
 
+	// End of the current usage period, if the policy limits usage per period
+	// Format: date-time
+	PeriodEnd *strfmt.DateTime `json:"period_end,omitempty"`
+
+	// Usage remaining in the current usage period
+	PeriodRemaining string `json:"period_remaining,omitempty"`
+
+	// Start of the current usage period, if the policy limits usage per period
+	// Format: date-time
+	PeriodStart *strfmt.DateTime `json:"period_start,omitempty"`
+
+	// Usage in the current usage period
+	PeriodUsage string `json:"period_usage,omitempty"`
+
 	// usage
 	// Required: true
 	Usage *string `json:"usage"`
@@ -40,10 +57,22 @@ func (m *PolicyStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOldBookings(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePeriodEnd(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePeriodStart(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUsage(formats); err != nil {
 		res = append(res, err)
 	}
@@ -63,6 +92,32 @@ func (m *PolicyStatus) validateCurrentBookings(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PolicyStatus) validateGroups(formats strfmt.Registry) error {
+	if swag.IsZero(m.Groups) { // not required
+		return nil
+	}
+
+	for k := range m.Groups {
+
+		if err := validate.Required("groups"+"."+k, "body", m.Groups[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Groups[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groups" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("groups" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *PolicyStatus) validateOldBookings(formats strfmt.Registry) error {
 
 	if err := validate.Required("old_bookings", "body", m.OldBookings); err != nil {
@@ -72,6 +127,30 @@ func (m *PolicyStatus) validateOldBookings(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PolicyStatus) validatePeriodEnd(formats strfmt.Registry) error {
+	if swag.IsZero(m.PeriodEnd) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("period_end", "body", "date-time", m.PeriodEnd.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PolicyStatus) validatePeriodStart(formats strfmt.Registry) error {
+	if swag.IsZero(m.PeriodStart) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("period_start", "body", "date-time", m.PeriodStart.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PolicyStatus) validateUsage(formats strfmt.Registry) error {
 
 	if err := validate.Required("usage", "body", m.Usage); err != nil {
@@ -81,8 +160,32 @@ func (m *PolicyStatus) validateUsage(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this policy status based on context it is used
+// ContextValidate validate this policy status based on the context it is used
 func (m *PolicyStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PolicyStatus) contextValidateGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.Groups {
+
+		if val, ok := m.Groups[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
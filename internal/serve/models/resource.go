@@ -19,6 +19,9 @@ import (
 // swagger:model Resource
 type Resource struct {
 
+	// Turnaround time that must be left free before and after each booking, e.g. 5m
+	Buffer string `json:"buffer,omitempty"`
+
 	// config url
 	ConfigURL string `json:"config_url,omitempty"`
 
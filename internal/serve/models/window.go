@@ -12,7 +12,6 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 )
 
 // Window window
@@ -21,11 +20,19 @@ import (
 type Window struct {
 
 	// allowed
-	// Required: true
 	Allowed []*Interval `json:"allowed"`
 
+	// allowed rules
+	AllowedRules []*WindowRule `json:"allowed_rules,omitempty"`
+
+	// blackouts
+	Blackouts []string `json:"blackouts,omitempty"`
+
 	// denied
 	Denied []*Interval `json:"denied"`
+
+	// denied rules
+	DeniedRules []*WindowRule `json:"denied_rules,omitempty"`
 }
 
 // Validate validates this window
@@ -36,10 +43,18 @@ func (m *Window) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAllowedRules(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDenied(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateDeniedRules(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -47,9 +62,8 @@ func (m *Window) Validate(formats strfmt.Registry) error {
 }
 
 func (m *Window) validateAllowed(formats strfmt.Registry) error {
-
-	if err := validate.Required("allowed", "body", m.Allowed); err != nil {
-		return err
+	if swag.IsZero(m.Allowed) { // not required
+		return nil
 	}
 
 	for i := 0; i < len(m.Allowed); i++ {
@@ -73,6 +87,32 @@ func (m *Window) validateAllowed(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Window) validateAllowedRules(formats strfmt.Registry) error {
+	if swag.IsZero(m.AllowedRules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AllowedRules); i++ {
+		if swag.IsZero(m.AllowedRules[i]) { // not required
+			continue
+		}
+
+		if m.AllowedRules[i] != nil {
+			if err := m.AllowedRules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("allowed_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("allowed_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Window) validateDenied(formats strfmt.Registry) error {
 	if swag.IsZero(m.Denied) { // not required
 		return nil
@@ -99,6 +139,32 @@ func (m *Window) validateDenied(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Window) validateDeniedRules(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeniedRules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DeniedRules); i++ {
+		if swag.IsZero(m.DeniedRules[i]) { // not required
+			continue
+		}
+
+		if m.DeniedRules[i] != nil {
+			if err := m.DeniedRules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("denied_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("denied_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this window based on the context it is used
 func (m *Window) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -107,10 +173,18 @@ func (m *Window) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateAllowedRules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDenied(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDeniedRules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -137,6 +211,26 @@ func (m *Window) contextValidateAllowed(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *Window) contextValidateAllowedRules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.AllowedRules); i++ {
+
+		if m.AllowedRules[i] != nil {
+			if err := m.AllowedRules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("allowed_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("allowed_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Window) contextValidateDenied(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Denied); i++ {
@@ -157,6 +251,26 @@ func (m *Window) contextValidateDenied(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *Window) contextValidateDeniedRules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.DeniedRules); i++ {
+
+		if m.DeniedRules[i] != nil {
+			if err := m.DeniedRules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("denied_rules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("denied_rules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Window) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -104,11 +104,26 @@ func configureAPI(api *operations.ServeAPI) http.Handler {
 			return middleware.NotImplemented("operation users.GetAvailability has not yet been implemented")
 		})
 	}
+	if api.UsersGetAvailabilityAnySlotHandler == nil {
+		api.UsersGetAvailabilityAnySlotHandler = users.GetAvailabilityAnySlotHandlerFunc(func(params users.GetAvailabilityAnySlotParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetAvailabilityAnySlot has not yet been implemented")
+		})
+	}
+	if api.UsersGetAvailabilityForSlotsHandler == nil {
+		api.UsersGetAvailabilityForSlotsHandler = users.GetAvailabilityForSlotsHandlerFunc(func(params users.GetAvailabilityForSlotsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetAvailabilityForSlots has not yet been implemented")
+		})
+	}
 	if api.UsersGetBookingsForUserHandler == nil {
 		api.UsersGetBookingsForUserHandler = users.GetBookingsForUserHandlerFunc(func(params users.GetBookingsForUserParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.GetBookingsForUser has not yet been implemented")
 		})
 	}
+	if api.UsersGetBookingsCalendarForUserHandler == nil {
+		api.UsersGetBookingsCalendarForUserHandler = users.GetBookingsCalendarForUserHandlerFunc(func(params users.GetBookingsCalendarForUserParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetBookingsCalendarForUser has not yet been implemented")
+		})
+	}
 	if api.UsersGetDescriptionHandler == nil {
 		api.UsersGetDescriptionHandler = users.GetDescriptionHandlerFunc(func(params users.GetDescriptionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.GetDescription has not yet been implemented")
@@ -144,6 +159,21 @@ func configureAPI(api *operations.ServeAPI) http.Handler {
 			return middleware.NotImplemented("operation admin.GetResourceIsAvailable has not yet been implemented")
 		})
 	}
+	if api.AdminGetBookingsCalendarForResourceHandler == nil {
+		api.AdminGetBookingsCalendarForResourceHandler = admin.GetBookingsCalendarForResourceHandlerFunc(func(params admin.GetBookingsCalendarForResourceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetBookingsCalendarForResource has not yet been implemented")
+		})
+	}
+	if api.AdminGetManifestVersionHandler == nil {
+		api.AdminGetManifestVersionHandler = admin.GetManifestVersionHandlerFunc(func(params admin.GetManifestVersionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetManifestVersion has not yet been implemented")
+		})
+	}
+	if api.AdminGetManifestVersionsHandler == nil {
+		api.AdminGetManifestVersionsHandler = admin.GetManifestVersionsHandlerFunc(func(params admin.GetManifestVersionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetManifestVersions has not yet been implemented")
+		})
+	}
 	if api.AdminGetResourcesHandler == nil {
 		api.AdminGetResourcesHandler = admin.GetResourcesHandlerFunc(func(params admin.GetResourcesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.GetResources has not yet been implemented")
@@ -154,11 +184,36 @@ func configureAPI(api *operations.ServeAPI) http.Handler {
 			return middleware.NotImplemented("operation admin.GetSlotIsAvailable has not yet been implemented")
 		})
 	}
+	if api.UsersGetWaitlistForUserHandler == nil {
+		api.UsersGetWaitlistForUserHandler = users.GetWaitlistForUserHandlerFunc(func(params users.GetWaitlistForUserParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetWaitlistForUser has not yet been implemented")
+		})
+	}
+	if api.UsersJoinWaitlistHandler == nil {
+		api.UsersJoinWaitlistHandler = users.JoinWaitlistHandlerFunc(func(params users.JoinWaitlistParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.JoinWaitlist has not yet been implemented")
+		})
+	}
+	if api.UsersLeaveWaitlistHandler == nil {
+		api.UsersLeaveWaitlistHandler = users.LeaveWaitlistHandlerFunc(func(params users.LeaveWaitlistParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.LeaveWaitlist has not yet been implemented")
+		})
+	}
 	if api.UsersMakeBookingHandler == nil {
 		api.UsersMakeBookingHandler = users.MakeBookingHandlerFunc(func(params users.MakeBookingParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.MakeBooking has not yet been implemented")
 		})
 	}
+	if api.UsersMakeBookingAnySlotHandler == nil {
+		api.UsersMakeBookingAnySlotHandler = users.MakeBookingAnySlotHandlerFunc(func(params users.MakeBookingAnySlotParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.MakeBookingAnySlot has not yet been implemented")
+		})
+	}
+	if api.AdminMakeRecurringBookingHandler == nil {
+		api.AdminMakeRecurringBookingHandler = admin.MakeRecurringBookingHandlerFunc(func(params admin.MakeRecurringBookingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.MakeRecurringBooking has not yet been implemented")
+		})
+	}
 	if api.AdminReplaceBookingsHandler == nil {
 		api.AdminReplaceBookingsHandler = admin.ReplaceBookingsHandlerFunc(func(params admin.ReplaceBookingsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.ReplaceBookings has not yet been implemented")
@@ -169,6 +224,21 @@ func configureAPI(api *operations.ServeAPI) http.Handler {
 			return middleware.NotImplemented("operation admin.ReplaceManifest has not yet been implemented")
 		})
 	}
+	if api.AdminPatchManifestHandler == nil {
+		api.AdminPatchManifestHandler = admin.PatchManifestHandlerFunc(func(params admin.PatchManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.PatchManifest has not yet been implemented")
+		})
+	}
+	if api.AdminDiffManifestHandler == nil {
+		api.AdminDiffManifestHandler = admin.DiffManifestHandlerFunc(func(params admin.DiffManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.DiffManifest has not yet been implemented")
+		})
+	}
+	if api.AdminReconcileManifestHandler == nil {
+		api.AdminReconcileManifestHandler = admin.ReconcileManifestHandlerFunc(func(params admin.ReconcileManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.ReconcileManifest has not yet been implemented")
+		})
+	}
 	if api.AdminReplaceOldBookingsHandler == nil {
 		api.AdminReplaceOldBookingsHandler = admin.ReplaceOldBookingsHandlerFunc(func(params admin.ReplaceOldBookingsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.ReplaceOldBookings has not yet been implemented")
@@ -184,6 +254,16 @@ func configureAPI(api *operations.ServeAPI) http.Handler {
 			return middleware.NotImplemented("operation admin.SetSlotIsAvailable has not yet been implemented")
 		})
 	}
+	if api.UsersResizeBookingHandler == nil {
+		api.UsersResizeBookingHandler = users.ResizeBookingHandlerFunc(func(params users.ResizeBookingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.ResizeBooking has not yet been implemented")
+		})
+	}
+	if api.UsersSwapBookingHandler == nil {
+		api.UsersSwapBookingHandler = users.SwapBookingHandlerFunc(func(params users.SwapBookingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.SwapBooking has not yet been implemented")
+		})
+	}
 	if api.UsersUniqueNameHandler == nil {
 		api.UsersUniqueNameHandler = users.UniqueNameHandlerFunc(func(params users.UniqueNameParams) middleware.Responder {
 			return middleware.NotImplemented("operation users.UniqueName has not yet been implemented")
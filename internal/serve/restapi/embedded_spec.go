@@ -124,6 +124,57 @@ func init() {
         }
       }
     },
+    "/admin/bookings/recurring": {
+      "post": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Makes a booking for each occurrence of the interval under the recurrence rule, e.g. every Tuesday 10:00-12:00 for a term, for the user on the slot. Each occurrence is checked against the slot's policy, as for replacing bookings, but not the user's groups. Either all the occurrences are booked, or none are, in which case the occurrences that could not be booked (e.g. because they clash with existing bookings) are listed in the errors. Occurrences are ordinary bookings once made, so can be cancelled individually.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Make a recurring booking",
+        "operationId": "MakeRecurringBooking",
+        "parameters": [
+          {
+            "name": "recurring_booking",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RecurringBooking"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "409": {
+            "$ref": "#/responses/ErrorList"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
     "/admin/manifest": {
       "get": {
         "security": [
@@ -203,16 +254,14 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      }
-    },
-    "/admin/manifest/check": {
-      "get": {
+      },
+      "patch": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Check a manifest is valid. Returns 204 if valid or, 200 with a list of error(s).",
+        "description": "Add, update or delete individual entities of the manifest (e.g. slots, resources, windows, policies). The entities in set are added, or replace existing entities of the same kind with the same name, and then the entities named in delete are removed. The resulting manifest is checked before any change is made, and the reasons for any failed checks are returned. The patch is refused if it would affect any existing bookings (e.g. by deleting their slot), and the affected bookings are returned - replace the manifest with a reconcile mode instead. Bookings on resources that are kept are unaffected.",
         "consumes": [
           "application/json"
         ],
@@ -222,24 +271,24 @@ func init() {
         "tags": [
           "admin"
         ],
-        "summary": "Check a manifest",
-        "operationId": "CheckManifest",
+        "summary": "Patch the manifest",
+        "operationId": "PatchManifest",
         "parameters": [
           {
-            "name": "manifest",
+            "name": "patch",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Manifest"
+              "$ref": "#/definitions/ManifestPatch"
             }
           }
         ],
         "responses": {
           "200": {
-            "$ref": "#/responses/ErrorList"
-          },
-          "204": {
-            "description": "OK - manifest is valid"
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusAdmin"
+            }
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -247,34 +296,50 @@ func init() {
           "404": {
             "$ref": "#/responses/NotFound"
           },
+          "409": {
+            "$ref": "#/responses/ErrorList"
+          },
           "500": {
             "$ref": "#/responses/InternalError"
           }
         }
       }
     },
-    "/admin/oldbookings": {
+    "/admin/manifest/check": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Exports a copy of the old bookings, with sufficient information to allow editing and replacement. If successful produces JSON-formatted bookings list.",
+        "description": "Check a manifest is valid. Returns 204 if valid or, 200 with a list of error(s).",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Export a copy of all old bookings",
-        "operationId": "ExportOldBookings",
-        "responses": {
-          "200": {
-            "description": "OK",
+        "summary": "Check a manifest",
+        "operationId": "CheckManifest",
+        "parameters": [
+          {
+            "name": "manifest",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Bookings"
+              "$ref": "#/definitions/Manifest"
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/ErrorList"
+          },
+          "204": {
+            "description": "OK - manifest is valid"
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -286,14 +351,16 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/admin/manifest/diff": {
+      "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Deletes all old bookings, and all users, then replaces both according to the bookings in the request, i.e. users and their usage are created as required to match bookings.",
+        "description": "Compare a candidate manifest to the current manifest, without changing anything, listing the entities that would be added, changed or removed, and the existing bookings that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their window, if the candidate replaced the current manifest. The candidate is checked first, and the reasons for any failed checks are returned.",
         "consumes": [
           "application/json"
         ],
@@ -303,15 +370,15 @@ func init() {
         "tags": [
           "admin"
         ],
-        "summary": "Replace old bookings",
-        "operationId": "ReplaceOldBookings",
+        "summary": "Compare a manifest to the current manifest",
+        "operationId": "DiffManifest",
         "parameters": [
           {
-            "name": "bookings",
+            "name": "manifest",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Bookings"
+              "$ref": "#/definitions/Manifest"
             }
           }
         ],
@@ -319,7 +386,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
+              "$ref": "#/definitions/ManifestDiff"
             }
           },
           "401": {
@@ -328,33 +395,56 @@ func init() {
           "404": {
             "$ref": "#/responses/NotFound"
           },
+          "409": {
+            "$ref": "#/responses/ErrorList"
+          },
           "500": {
             "$ref": "#/responses/InternalError"
           }
         }
       }
     },
-    "/admin/resources": {
-      "get": {
+    "/admin/manifest/reconcile": {
+      "put": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets a list of all resources, including their availability and any tests specified",
+        "description": "Replace the manifest, then keep, cancel or move the existing bookings that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their window. Cancelled bookings are only charged for time already used, and have cancelled_by set to manifestChanged. Bookings are moved to the first slot listed in their policy that allows the booking and is free, starting with their own slot, and are cancelled if there is none, or they have started. Bookings that are not affected are kept in place. Returns what was done to each affected booking.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Get the list of resources in the manifest",
-        "operationId": "GetResources",
+        "summary": "Replace the manifest and reconcile the bookings it affects",
+        "operationId": "ReconcileManifest",
+        "parameters": [
+          {
+            "name": "manifest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Manifest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "what to do with affected bookings, one of keep, cancel or move",
+            "name": "mode",
+            "in": "query",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Resources"
+              "$ref": "#/definitions/ReconcileReport"
             }
           },
           "401": {
@@ -363,41 +453,36 @@ func init() {
           "404": {
             "$ref": "#/responses/NotFound"
           },
+          "409": {
+            "$ref": "#/responses/ErrorList"
+          },
           "500": {
             "$ref": "#/responses/InternalError"
           }
         }
       }
     },
-    "/admin/resources/{resource_name}": {
+    "/admin/manifest/versions": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
+        "description": "Lists when each of the most recently applied manifests was applied, and by whom, oldest first. The last version is the current manifest. Every successful replacement, reconciliation or patch of the manifest adds a version.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Get the availability of the resource",
-        "operationId": "GetResourceIsAvailable",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "resource_name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List the retained versions of the manifest",
+        "operationId": "GetManifestVersions",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ResourceStatus"
+              "$ref": "#/definitions/ManifestVersions"
             }
           },
           "401": {
@@ -410,45 +495,38 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/admin/manifest/versions/{version}": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Sets the availability of a resource, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
+        "description": "Gets a retained version of the manifest, including the manifest itself, so that it can be reapplied by replacing the current manifest with it.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Set the availability of the resource",
-        "operationId": "SetResourceIsAvailable",
+        "summary": "Get a retained version of the manifest",
+        "operationId": "GetManifestVersion",
         "parameters": [
           {
-            "type": "string",
-            "name": "resource_name",
+            "type": "integer",
+            "name": "version",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "available",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "reason",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ManifestVersion"
+            }
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -462,35 +540,27 @@ func init() {
         }
       }
     },
-    "/admin/slots/{slot_name}": {
+    "/admin/oldbookings": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
+        "description": "Exports a copy of the old bookings, with sufficient information to allow editing and replacement. If successful produces JSON-formatted bookings list.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Get the availability of the slot",
-        "operationId": "GetSlotIsAvailable",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "slot_name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Export a copy of all old bookings",
+        "operationId": "ExportOldBookings",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ResourceStatus"
+              "$ref": "#/definitions/Bookings"
             }
           },
           "401": {
@@ -510,38 +580,34 @@ func init() {
             "Bearer": []
           }
         ],
-        "description": "Sets the availability of the underlying resource for the slot, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
+        "description": "Deletes all old bookings, and all users, then replaces both according to the bookings in the request, i.e. users and their usage are created as required to match bookings.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Set the availability of the slot",
-        "operationId": "SetSlotIsAvailable",
+        "summary": "Replace old bookings",
+        "operationId": "ReplaceOldBookings",
         "parameters": [
           {
-            "type": "string",
-            "name": "slot_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "available",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "reason",
-            "in": "query",
-            "required": true
+            "name": "bookings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusAdmin"
+            }
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -555,27 +621,27 @@ func init() {
         }
       }
     },
-    "/admin/status": {
+    "/admin/resources": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets a count of the number of elements in the store, e.g. Bookings, Descriptions etc to facilitate a necessary but not sufficient check that replace manifest and replace bookings have produced the correct results.",
+        "description": "Gets a list of all resources, including their availability and any tests specified",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Get current store status",
-        "operationId": "getStoreStatusAdmin",
+        "summary": "Get the list of resources in the manifest",
+        "operationId": "GetResources",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
+              "$ref": "#/definitions/Resources"
             }
           },
           "401": {
@@ -588,42 +654,37 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/admin/resources/{resource_name}": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Set whether the booking system is locked for users",
+        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "set/release booking lock",
-        "operationId": "setLock",
+        "summary": "Get the availability of the resource",
+        "operationId": "GetResourceIsAvailable",
         "parameters": [
-          {
-            "type": "boolean",
-            "description": "set booking lock",
-            "name": "lock",
-            "in": "query",
-            "required": true
-          },
           {
             "type": "string",
-            "description": "set message of the day (use query so it can be seen in server logs)",
-            "name": "msg",
-            "in": "query"
+            "name": "resource_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
+              "$ref": "#/definitions/ResourceStatus"
             }
           },
           "401": {
@@ -636,30 +697,45 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      }
-    },
-    "/admin/users": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Export bookings and usage data for each user",
+        "description": "Sets the availability of a resource, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Export users",
-        "operationId": "ExportUsers",
+        "summary": "Set the availability of the resource",
+        "operationId": "SetResourceIsAvailable",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "resource_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "available",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "reason",
+            "in": "query",
+            "required": true
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/Users"
-            }
+          "204": {
+            "description": "OK"
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -673,26 +749,27 @@ func init() {
         }
       }
     },
-    "/descriptions/{description_name}": {
+    "/admin/resources/{resource_name}/bookings.ics": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get description",
+        "description": "Exports the current and old bookings for the resource as an iCalendar (RFC 5545) calendar, so that staff can subscribe to a resource's bookings in their usual calendar app. Each booking is an event with a UID equal to the booking name, so that calendar apps update existing events on each export. Cancelled bookings are included with status CANCELLED.",
         "produces": [
+          "text/calendar",
           "application/json"
         ],
         "tags": [
-          "users"
+          "admin"
         ],
-        "summary": "Get description",
-        "operationId": "GetDescription",
+        "summary": "Export the bookings for the resource as an iCalendar",
+        "operationId": "GetBookingsCalendarForResource",
         "parameters": [
           {
             "type": "string",
-            "name": "description_name",
+            "name": "resource_name",
             "in": "path",
             "required": true
           }
@@ -701,7 +778,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Description"
+              "type": "string"
             }
           },
           "401": {
@@ -716,26 +793,26 @@ func init() {
         }
       }
     },
-    "/groups/{group_name}": {
+    "/admin/slots/{slot_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get fully described group, including described policies, and described slots",
+        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "users"
+          "admin"
         ],
-        "summary": "Get group",
-        "operationId": "GetGroup",
+        "summary": "Get the availability of the slot",
+        "operationId": "GetSlotIsAvailable",
         "parameters": [
           {
             "type": "string",
-            "name": "group_name",
+            "name": "slot_name",
             "in": "path",
             "required": true
           }
@@ -744,7 +821,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GroupDescribedWithPolicies"
+              "$ref": "#/definitions/ResourceStatus"
             }
           },
           "401": {
@@ -757,33 +834,45 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      }
-    },
-    "/login/{user_name}": {
-      "post": {
-        "description": "The access token is required to authenticate requests to the rest of the user-facing API. Ideally access to this endpoint should be secured by the identity management system. The access token has a limited lifetime but can be re-requested as needed. Consider rate-limiting this per-connection.",
+      },
+      "put": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Sets the availability of the underlying resource for the slot, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "users"
+          "admin"
         ],
-        "summary": "Request a user access token",
-        "operationId": "GetAccessToken",
+        "summary": "Set the availability of the slot",
+        "operationId": "SetSlotIsAvailable",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
+            "name": "slot_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "available",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "reason",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/AccessToken"
-            }
+          "204": {
+            "description": "OK"
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -797,35 +886,27 @@ func init() {
         }
       }
     },
-    "/policies/{policy_name}": {
+    "/admin/status": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get policy",
+        "description": "Gets a count of the number of elements in the store, e.g. Bookings, Descriptions etc to facilitate a necessary but not sufficient check that replace manifest and replace bookings have produced the correct results.",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "users"
-        ],
-        "summary": "Get policy",
-        "operationId": "GetPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "policy_name",
-            "in": "path",
-            "required": true
-          }
+          "admin"
         ],
+        "summary": "Get current store status",
+        "operationId": "getStoreStatusAdmin",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/PolicyDescribed"
+              "$ref": "#/definitions/StoreStatusAdmin"
             }
           },
           "401": {
@@ -838,42 +919,34 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      }
-    },
-    "/slots/{slot_name}": {
-      "get": {
+      },
+      "put": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Pagination is supported by the limit and offset parameters. For the first query '?limit=20\u0026offset=0', the second '?limit=20\u0026offset=20'. The offset is equal to the zero-indexed value of the first item of the next page to be returned (20 items are indexed from 0 to 19, so 20 is the first item to be returned in the second page). Note that drift can occur if slots are booked during the sending of availability data, potentially preventing a user from seeing some slots that move earlier in the index and cross a pagination boundary. Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Set whether the booking system is locked for users",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "users"
+          "admin"
         ],
-        "summary": "Get availability for the slot",
-        "operationId": "GetAvailability",
+        "summary": "set/release booking lock",
+        "operationId": "setLock",
         "parameters": [
           {
-            "type": "string",
-            "name": "slot_name",
-            "in": "path",
+            "type": "boolean",
+            "description": "set booking lock",
+            "name": "lock",
+            "in": "query",
             "required": true
           },
           {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
+            "type": "string",
+            "description": "set message of the day (use query so it can be seen in server logs)",
+            "name": "msg",
             "in": "query"
           }
         ],
@@ -881,7 +954,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Intervals"
+              "$ref": "#/definitions/StoreStatusAdmin"
             }
           },
           "401": {
@@ -894,56 +967,30 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/admin/users": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "A booking is requested for a specific combination of policy-slot-user-from-to. Users should check availability first, and only make requests that are likely to be granted. If there is no current availability then requests will be denied. If there is availability, there is still a chance another user requests the same slot just before - whichever request is received by the server first will be allowed, the other denied. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Export bookings and usage data for each user",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "users"
-        ],
-        "summary": "Request a booking",
-        "operationId": "MakeBooking",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "slot_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "user_name",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "date-time",
-            "name": "from",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "date-time",
-            "name": "to",
-            "in": "query",
-            "required": true
-          }
+          "admin"
         ],
+        "summary": "Export users",
+        "operationId": "ExportUsers",
         "responses": {
-          "204": {
-            "description": "OK - No Content"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Users"
+            }
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -951,37 +998,41 @@ func init() {
           "404": {
             "$ref": "#/responses/NotFound"
           },
-          "409": {
-            "description": "Conflict - unavailable for the requested interval",
-            "schema": {}
-          },
           "500": {
             "$ref": "#/responses/InternalError"
           }
         }
       }
     },
-    "/users/status": {
+    "/descriptions/{description_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets the current store status from a user perspective (e.g. is it locked? what is the reason?)",
+        "description": "Get description",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get current store status",
-        "operationId": "getStoreStatusUser",
+        "summary": "Get description",
+        "operationId": "GetDescription",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "description_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusUser"
+              "$ref": "#/definitions/Description"
             }
           },
           "401": {
@@ -996,49 +1047,60 @@ func init() {
         }
       }
     },
-    "/users/unique": {
-      "post": {
-        "description": "Generates a unique username that meets the minimum length requirements for the booking system. No security/token needed, because users needs a/this name to login",
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
+    "/groups/{group_name}": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get fully described group, including described policies, and described slots",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
           "users"
         ],
-        "summary": "Request a new, unique username",
-        "operationId": "UniqueName",
+        "summary": "Get group",
+        "operationId": "GetGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "group_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/UserName"
+              "$ref": "#/definitions/GroupDescribedWithPolicies"
             }
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
           },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
           "500": {
             "$ref": "#/responses/InternalError"
           }
         }
       }
     },
-    "/users/{user_name}/bookings": {
-      "get": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "description": "Get all current bookings for the user. It's assumed that no pagination will be required due to likely policy limits being a couple of bookings per policy and users typically having only a couple of policies. No pagination may cause issues for admins that book hundreds or thousands slots on behalf of students, although it is likely such bookings would be automated so no need to support rapid update of a GUI, at least for now.",
+    "/login/{user_name}": {
+      "post": {
+        "description": "The access token is required to authenticate requests to the rest of the user-facing API. Ideally access to this endpoint should be secured by the identity management system. The access token has a limited lifetime but can be re-requested as needed. Consider rate-limiting this per-connection.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get all current bookings for the user",
-        "operationId": "GetBookingsForUser",
+        "summary": "Request a user access token",
+        "operationId": "GetAccessToken",
         "parameters": [
           {
             "type": "string",
@@ -1051,7 +1113,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Bookings"
+              "$ref": "#/definitions/AccessToken"
             }
           },
           "401": {
@@ -1066,32 +1128,26 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/bookings/{booking_name}": {
-      "put": {
+    "/policies/{policy_name}": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Checks if the booking has started or not and if so, returns an activity that can be used to access the booked resource. Since this mutates the state of the booking (from unfulfilled to fulfilled) it is a put not a get.",
+        "description": "Get policy",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Request the activity that goes with the booking",
-        "operationId": "GetActivity",
+        "summary": "Get policy",
+        "operationId": "GetPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "booking_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           }
@@ -1100,7 +1156,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Activity"
+              "$ref": "#/definitions/PolicyDescribed"
             }
           },
           "401": {
@@ -1113,14 +1169,16 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/policies/{policy_name}/availability": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "For users to cancel their booking(s) on at a time. Checks if the booking is unstarted and/or unfulfilled, and cancels if so. A booking cannot be cancelled once an activity has been requested. A booking can be cancelled after it started, so long as it is unfulfilled (no activity requested). The user must be the owner of the booking to cancel it. Admins can cancel bookings by Lock() -\u003e ExportBookings() -\u003e edit -\u003e ReplaceBookings()-\u003e Unlock(). There is no need for an endpoint for admin single booking cancellation because the only visibility they have of bookings is via ExportBookings. Remaing time in the booking at time of cancellation is refunded to the user's usage tracker for that policy. That is intended to encourage early cancellation. Returns 404 on successful cancellation, or if there is no such booking.",
+        "description": "Get the availability of the slots in the policy that use the ui_set (or of all the slots in the policy, if no ui_set is given), merged into a single list of the intervals when at least one of the slots is free. Slots whose resource is offline are left out. A merged interval may span more than one slot, so a booking for the whole of it may not be granted. Pagination is supported by the limit and offset parameters, in the same way as for the availability of a single slot.",
         "consumes": [
           "application/json"
         ],
@@ -1130,23 +1188,38 @@ func init() {
         "tags": [
           "users"
         ],
-        "summary": "Cancel the booking",
-        "operationId": "CancelBooking",
+        "summary": "Get availability for any slot in the policy",
+        "operationId": "GetAvailabilityAnySlot",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "booking_name",
-            "in": "path",
-            "required": true
+            "name": "ui_set",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Intervals"
+            }
+          },
           "401": {
             "$ref": "#/responses/Unauthorized"
           },
@@ -1159,35 +1232,63 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/groups": {
-      "get": {
+    "/policies/{policy_name}/bookings": {
+      "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get all current groups for user. This only returns groups that are currently listed within the user's groups. Group data includes a description, but not policies.",
+        "description": "A booking is requested on whichever slot in the policy that uses the ui_set (or any slot in the policy, if no ui_set is given) is free for the interval, so that users do not have to check each slot in turn. The booking is returned, so that the user can see which slot was booked. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get all current groups for user",
-        "operationId": "GetGroupsForUser",
+        "summary": "Request a booking on any slot in the policy",
+        "operationId": "MakeBookingAnySlot",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "ui_set",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GroupsDescribed"
+              "$ref": "#/definitions/Booking"
             }
           },
           "401": {
@@ -1202,39 +1303,61 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/groups/{group_name}": {
-      "post": {
+    "/policies/{policy_name}/slots/availability": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Add group to the list of groups with which this user is allowed to make bookings",
+        "description": "Get the availability of each of the slots in the policy that use the ui_set (or of all the slots in the policy, if no ui_set is given), in one request instead of one per slot. Availability is given from now until as far ahead as the policy allows, unless narrowed by the optional from and to parameters, and limit restricts the number of intervals given for each slot. A slot whose resource is unavailable has no availability.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Add group to user account",
-        "operationId": "AddGroupForUser",
+        "summary": "Get availability for each slot in the policy",
+        "operationId": "GetAvailabilityForSlots",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "group_name",
-            "in": "path",
-            "required": true
+            "name": "ui_set",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK - No Content"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SlotsAvailability"
+            }
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -1248,35 +1371,60 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/oldbookings": {
+    "/slots/{slot_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get all old bookings for the user. It's assumed that no pagination will be required due to likely policy limits including usage limits and users typically having only a couple of policies, although in practice pagination may be useful for heavy users.",
+        "description": "Availability is given from now until as far ahead as the policy allows, unless narrowed by the optional from and to parameters, e.g. to the week that a UI is showing. Pagination is supported by the limit and offset parameters. For the first query '?limit=20\u0026offset=0', the second '?limit=20\u0026offset=20'. The offset is equal to the zero-indexed value of the first item of the next page to be returned (20 items are indexed from 0 to 19, so 20 is the first item to be returned in the second page). Note that drift can occur if slots are booked during the sending of availability data, potentially preventing a user from seeing some slots that move earlier in the index and cross a pagination boundary. Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get all old bookings for the user",
-        "operationId": "GetOldBookingsForUser",
+        "summary": "Get availability for the slot",
+        "operationId": "GetAvailability",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
+            "name": "slot_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Bookings"
+              "$ref": "#/definitions/Intervals"
             }
           },
           "401": {
@@ -1289,44 +1437,56 @@ func init() {
             "$ref": "#/responses/InternalError"
           }
         }
-      }
-    },
-    "/users/{user_name}/policies/{policy_name}": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get policy status for the user",
+        "description": "A booking is requested for a specific combination of policy-slot-user-from-to. Users should check availability first, and only make requests that are likely to be granted. If there is no current availability then requests will be denied. If there is availability, there is still a chance another user requests the same slot just before - whichever request is received by the server first will be allowed, the other denied. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get policy status",
-        "operationId": "GetPolicyStatusForUser",
+        "summary": "Request a booking",
+        "operationId": "MakeBooking",
         "parameters": [
           {
             "type": "string",
-            "name": "user_name",
+            "name": "slot_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "policy_name",
-            "in": "path",
+            "name": "user_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/PolicyStatus"
-            }
+          "204": {
+            "description": "OK - No Content"
           },
           "401": {
             "$ref": "#/responses/Unauthorized"
@@ -1334,216 +1494,2146 @@ func init() {
           "404": {
             "$ref": "#/responses/NotFound"
           },
+          "409": {
+            "description": "Conflict - unavailable for the requested interval",
+            "schema": {}
+          },
           "500": {
             "$ref": "#/responses/InternalError"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AccessToken": {
-      "description": "intended use is for users to access the API, and is tied to their user_name.",
-      "type": "object",
-      "title": "access token",
-      "required": [
-        "token",
-        "nbf",
-        "exp",
-        "aud",
-        "sub",
-        "scopes"
-      ],
-      "properties": {
-        "aud": {
-          "description": "Audience",
-          "type": "string"
-        },
-        "exp": {
-          "description": "Expires At",
-          "type": "number",
-          "format": "unix-timestamp"
-        },
-        "iat": {
-          "description": "Issued At",
-          "type": "number",
-          "format": "unix-timestamp"
-        },
-        "nbf": {
-          "description": "Not before",
-          "type": "number",
-          "format": "unix-timestamp"
-        },
-        "scopes": {
-          "description": "List of scopes",
-          "type": "array",
-          "items": {
-            "type": "string",
-            "example": "user:booking"
+    },
+    "/users/status": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Gets the current store status from a user perspective (e.g. is it locked? what is the reason?)",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Get current store status",
+        "operationId": "getStoreStatusUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusUser"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
           }
-        },
-        "sub": {
-          "description": "Subject",
-          "type": "string"
-        },
-        "token": {
-          "type": "string"
         }
       }
     },
-    "Activity": {
-      "description": "An activity represents connection details to instances of pre-agreed resource types such as video, data streams and user interfaces.",
-      "type": "object",
-      "title": "activity",
-      "required": [
-        "description",
-        "exp",
-        "nbf",
-        "streams",
-        "uis"
-      ],
-      "properties": {
-        "config": {
-          "description": "URL at which to GET the configuration object",
-          "type": "string",
-          "format": "url",
-          "example": "https://assets.practable.io/config/experiments/pvna/pvna01-0.0.json"
-        },
-        "description": {
-          "$ref": "#/definitions/Description"
-        },
-        "exp": {
-          "description": "Expires At",
-          "type": "number",
-          "format": "unix-timestamp"
-        },
-        "nbf": {
-          "description": "Expires At",
-          "type": "number",
-          "format": "unix-timestamp"
-        },
-        "streams": {
-          "description": "A list of streams",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ActivityStream"
-          }
-        },
-        "uis": {
-          "description": "User interfaces",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/UIDescribed"
+    "/users/unique": {
+      "post": {
+        "description": "Generates a unique username that meets the minimum length requirements for the booking system. No security/token needed, because users needs a/this name to login",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Request a new, unique username",
+        "operationId": "UniqueName",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UserName"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
           }
         }
       }
     },
-    "ActivityStream": {
-      "description": "Represents an assigned, valid booking slot for an individual piece of equipment",
-      "type": "object",
-      "title": "stream",
-      "required": [
-        "audience",
-        "connection_type",
-        "for",
-        "scopes",
-        "topic",
-        "url"
-      ],
-      "properties": {
-        "audience": {
-          "type": "string"
-        },
-        "connection_type": {
-          "type": "string"
-        },
-        "for": {
-          "description": "Describes the stream, and doubles as template key in the URL",
-          "type": "string",
-          "example": "video"
-        },
-        "prefix": {
-          "description": "prefix of the relay routing",
-          "type": "string",
-          "example": "session"
-        },
-        "scopes": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/users/{user_name}/bookings": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get all current bookings for the user. It's assumed that no pagination will be required due to likely policy limits being a couple of bookings per policy and users typically having only a couple of policies. No pagination may cause issues for admins that book hundreds or thousands slots on behalf of students, although it is likely such bookings would be automated so no need to support rapid update of a GUI, at least for now.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Get all current bookings for the user",
+        "operationId": "GetBookingsForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
           }
-        },
-        "token": {
-          "description": "signed jwt token for accessing the stream",
-          "type": "string"
-        },
-        "topic": {
-          "type": "string"
-        },
-        "url": {
-          "description": "URL at which to obtain access to the stream (getting a redirect URL containing a one time code)",
-          "type": "string",
-          "example": "https://relay-access.practable.io/session/abc123"
         }
       }
     },
-    "Booking": {
-      "description": "A booking represents a promise to supply an activity. The booleans are not required because we don't process the booking status when loading old bookings (all old bookings are assumed to have been good bookings)",
-      "type": "object",
-      "title": "booking",
-      "required": [
-        "name",
-        "policy",
-        "slot",
-        "user",
-        "when"
-      ],
-      "properties": {
-        "cancelled": {
-          "description": "Has the booking been cancelled?",
-          "type": "boolean"
-        },
-        "cancelled_at": {
-          "description": "time the booking was cancelled",
-          "type": "string",
-          "format": "date-time"
-        },
-        "cancelled_by": {
-          "description": "who cancelled the booking, e.g. auto-grace-expired, admin or user",
-          "type": "string",
-          "example": "auto-grace-expired"
-        },
-        "name": {
-          "description": "unique name of the booking",
-          "type": "string"
-        },
+    "/users/{user_name}/bookings.ics": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Exports the current and old bookings for the user as an iCalendar (RFC 5545) calendar, so that users can subscribe to their bookings in their usual calendar app, instead of each booking UI preparing .ics files. Each booking is an event with the slot's name and short description, and a UID equal to the booking name, so that calendar apps update existing events on each export. Cancelled bookings are included with status CANCELLED, so that calendar apps remove them.",
+        "produces": [
+          "text/calendar",
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Export the user's bookings as an iCalendar",
+        "operationId": "GetBookingsCalendarForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/bookings/{booking_name}": {
+      "put": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Checks if the booking has started or not and if so, returns an activity that can be used to access the booked resource. Since this mutates the state of the booking (from unfulfilled to fulfilled) it is a put not a get.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Request the activity that goes with the booking",
+        "operationId": "GetActivity",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "booking_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Activity"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "For users to cancel their booking(s) on at a time. Checks if the booking is unstarted and/or unfulfilled, and cancels if so. A booking cannot be cancelled once an activity has been requested. A booking can be cancelled after it started, so long as it is unfulfilled (no activity requested). The user must be the owner of the booking to cancel it. Admins can cancel bookings by Lock() -\u003e ExportBookings() -\u003e edit -\u003e ReplaceBookings()-\u003e Unlock(). There is no need for an endpoint for admin single booking cancellation because the only visibility they have of bookings is via ExportBookings. Remaing time in the booking at time of cancellation is refunded to the user's usage tracker for that policy. That is intended to encourage early cancellation. Returns 404 on successful cancellation, or if there is no such booking.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Cancel the booking",
+        "operationId": "CancelBooking",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "booking_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Changes the interval of an existing booking in place, keeping its name, e.g. to extend it. The new interval is checked against the window for the slot, and the policy limits on book ahead, duration and usage, in the same way as a new booking, except that the booking does not count against the maximum number of bookings. A booking that has started can only have its end changed, to any time in the future. A started booking that is shortened is charged as if it had been cancelled at its new end, and access is ended at the new end. The usage charged for the booking is adjusted to match the new interval. Returns the resized booking.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Resize the booking",
+        "operationId": "ResizeBooking",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "booking_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/bookings/{booking_name}/swap": {
+      "put": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Atomically cancels the existing booking and makes a new booking for the slot and interval given in the query, so that the user cannot lose their booking to someone else in between. If the new booking cannot be made, the existing booking is kept unchanged. The new booking may overlap the time of the existing booking, e.g. to extend it. Usage is refunded for the cancelled booking before the new booking is checked against the policy. A booking that has started cannot be swapped. Returns the new booking.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Swap the booking for a new one",
+        "operationId": "SwapBooking",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "booking_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "slot_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/groups": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get all current groups for user. This only returns groups that are currently listed within the user's groups. Group data includes a description, but not policies.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Get all current groups for user",
+        "operationId": "GetGroupsForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GroupsDescribed"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/groups/{group_name}": {
+      "post": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Add group to the list of groups with which this user is allowed to make bookings",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Add group to user account",
+        "operationId": "AddGroupForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK - No Content"
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/oldbookings": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get all old bookings for the user. It's assumed that no pagination will be required due to likely policy limits including usage limits and users typically having only a couple of policies, although in practice pagination may be useful for heavy users.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Get all old bookings for the user",
+        "operationId": "GetOldBookingsForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/policies/{policy_name}": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get policy status for the user",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Get policy status",
+        "operationId": "GetPolicyStatusForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "policy_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/PolicyStatus"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/waitlist": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get the waitlist entries for the user, in the order they were joined, including any offers of freed time.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Get the waitlist entries for the user",
+        "operationId": "GetWaitlistForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntries"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Registers interest in booking the slot for the interval given in the query, e.g. when it is already booked. When time on the same resource that overlaps the interval is freed, by a booking being cancelled (by its user, or automatically for not being started within the grace period), shortened or moved, the freed time is offered to the first user waiting for it, or booked for them automatically if the slot's policy has auto_book_waitlist set. Either way, this only happens if the booking would be within the policy. Offers are shown in the waitlist entry, and are booked in the usual way. The user must belong to a group that includes the slot's policy. Returns the waitlist entry.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Join the waitlist for the slot",
+        "operationId": "JoinWaitlist",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "slot_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    },
+    "/users/{user_name}/waitlist/{entry_name}": {
+      "delete": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Removes the entry from the waitlist. The user must be the owner of the entry. Entries are also removed automatically when freed time is booked for the user, and once the interval has passed.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Leave the waitlist",
+        "operationId": "LeaveWaitlist",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "entry_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK - No Content"
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "500": {
+            "$ref": "#/responses/InternalError"
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AccessToken": {
+      "description": "intended use is for users to access the API, and is tied to their user_name.",
+      "type": "object",
+      "title": "access token",
+      "required": [
+        "token",
+        "nbf",
+        "exp",
+        "aud",
+        "sub",
+        "scopes"
+      ],
+      "properties": {
+        "aud": {
+          "description": "Audience",
+          "type": "string"
+        },
+        "exp": {
+          "description": "Expires At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "iat": {
+          "description": "Issued At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "nbf": {
+          "description": "Not before",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "scopes": {
+          "description": "List of scopes",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "example": "user:booking"
+          }
+        },
+        "sub": {
+          "description": "Subject",
+          "type": "string"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "Activity": {
+      "description": "An activity represents connection details to instances of pre-agreed resource types such as video, data streams and user interfaces.",
+      "type": "object",
+      "title": "activity",
+      "required": [
+        "description",
+        "exp",
+        "nbf",
+        "streams",
+        "uis"
+      ],
+      "properties": {
+        "config": {
+          "description": "URL at which to GET the configuration object",
+          "type": "string",
+          "format": "url",
+          "example": "https://assets.practable.io/config/experiments/pvna/pvna01-0.0.json"
+        },
+        "description": {
+          "$ref": "#/definitions/Description"
+        },
+        "exp": {
+          "description": "Expires At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "nbf": {
+          "description": "Expires At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "streams": {
+          "description": "A list of streams",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActivityStream"
+          }
+        },
+        "uis": {
+          "description": "User interfaces",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UIDescribed"
+          }
+        }
+      }
+    },
+    "ActivityStream": {
+      "description": "Represents an assigned, valid booking slot for an individual piece of equipment",
+      "type": "object",
+      "title": "stream",
+      "required": [
+        "audience",
+        "connection_type",
+        "for",
+        "scopes",
+        "topic",
+        "url"
+      ],
+      "properties": {
+        "audience": {
+          "type": "string"
+        },
+        "connection_type": {
+          "type": "string"
+        },
+        "for": {
+          "description": "Describes the stream, and doubles as template key in the URL",
+          "type": "string",
+          "example": "video"
+        },
+        "prefix": {
+          "description": "prefix of the relay routing",
+          "type": "string",
+          "example": "session"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "token": {
+          "description": "signed jwt token for accessing the stream",
+          "type": "string"
+        },
+        "topic": {
+          "type": "string"
+        },
+        "url": {
+          "description": "URL at which to obtain access to the stream (getting a redirect URL containing a one time code)",
+          "type": "string",
+          "example": "https://relay-access.practable.io/session/abc123"
+        }
+      }
+    },
+    "Blackout": {
+      "description": "Times when nothing can be booked, such as holidays or maintenance, shared by the windows that reference the blackout",
+      "type": "object",
+      "title": "blackout",
+      "required": [
+        "denied",
+        "description"
+      ],
+      "properties": {
+        "denied": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          }
+        },
+        "description": {
+          "type": "string"
+        }
+      }
+    },
+    "Booking": {
+      "description": "A booking represents a promise to supply an activity. The booleans are not required because we don't process the booking status when loading old bookings (all old bookings are assumed to have been good bookings)",
+      "type": "object",
+      "title": "booking",
+      "required": [
+        "name",
+        "policy",
+        "slot",
+        "user",
+        "when"
+      ],
+      "properties": {
+        "cancelled": {
+          "description": "Has the booking been cancelled?",
+          "type": "boolean"
+        },
+        "cancelled_at": {
+          "description": "time the booking was cancelled",
+          "type": "string",
+          "format": "date-time"
+        },
+        "cancelled_by": {
+          "description": "who cancelled the booking, e.g. auto-grace-expired, admin or user",
+          "type": "string",
+          "example": "auto-grace-expired"
+        },
+        "name": {
+          "description": "unique name of the booking",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy under which the booking was made",
+          "type": "string"
+        },
+        "resource": {
+          "description": "resource substituted for the slot's resource because that went offline (empty if not substituted)",
+          "type": "string"
+        },
+        "slot": {
+          "description": "name of the slot that has been booked",
+          "type": "string"
+        },
+        "started": {
+          "description": "has the booking been started by the user?",
+          "type": "boolean"
+        },
+        "started_at": {
+          "description": "time the booking was first started by the user",
+          "type": "string",
+          "format": "date-time"
+        },
+        "unfulfilled": {
+          "description": "was the resource unavailable",
+          "type": "boolean"
+        },
+        "usage_charged": {
+          "description": "how much usage was actually charged for this booking",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who made the booking",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
+    "BookingImpact": {
+      "description": "An existing booking that a candidate manifest would affect, and the reasons why",
+      "type": "object",
+      "title": "booking impact",
+      "required": [
+        "booking",
+        "reasons",
+        "slot",
+        "when"
+      ],
+      "properties": {
+        "booking": {
+          "description": "name of the booking",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy under which the booking was made",
+          "type": "string"
+        },
+        "reasons": {
+          "description": "why the booking would be affected, e.g. its slot is removed, or it is outside the periods allowed by its window",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "slot": {
+          "description": "name of the slot that has been booked",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who made the booking",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
+    "Bookings": {
+      "description": "list of bookings",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Booking"
+      }
+    },
+    "Description": {
+      "description": "Description of a resource e.g. policy, slot, user interface",
+      "type": "object",
+      "title": "description",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "further": {
+          "description": "URL for further information",
+          "type": "string",
+          "example": "https://static.practable.io/info/penduino/ui.html"
+        },
+        "image": {
+          "description": "URL of main image (500x500)",
+          "type": "string",
+          "example": "https://assets.practable.io/images/penduino-500x500.png"
+        },
+        "long": {
+          "description": "280 char additional description of the interface in plain text",
+          "type": "string"
+        },
+        "name": {
+          "description": "Display name",
+          "type": "string",
+          "example": "Penduino (basic)"
+        },
+        "short": {
+          "description": "140 char max description of the interface in plain text",
+          "type": "string",
+          "example": "Interface to the penduino electromagnetic pendulum with variable drive, active braking, short and open circuit loading."
+        },
+        "thumb": {
+          "description": "URL of thumbnail image (150x150)",
+          "type": "string",
+          "example": "https://assets.practable.io/images/penduino-150x150.png"
+        },
+        "type": {
+          "description": "Type of resource being described, e.g. pool, activity, userinterface",
+          "type": "string",
+          "example": "userinterface"
+        }
+      },
+      "example": {
+        "further": "https://static.practable.io/descriptions/pools/penduino/index.html",
+        "image": "https://assets.practable.io/images/pools/penduino/image.png",
+        "long": "Some longer description",
+        "name": "Penduino (basic)",
+        "short": "Penduino electromagnetic pendulums with pulsed drive and braking",
+        "thumb": "https://assets.practable.io/images/pools/penduino/thumb.png",
+        "type": "pool"
+      }
+    },
+    "DisplayGuide": {
+      "type": "object",
+      "required": [
+        "book_ahead",
+        "duration",
+        "label",
+        "max_slots"
+      ],
+      "properties": {
+        "book_ahead": {
+          "type": "string"
+        },
+        "duration": {
+          "type": "string"
+        },
+        "label": {
+          "description": "what to display in the tab heading for these slots",
+          "type": "string"
+        },
+        "max_slots": {
+          "type": "integer"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ErrorList": {
+      "description": "use when there is one or more errors that may need returning, e.g. manifest check issues",
+      "type": "object",
+      "required": [
+        "code",
+        "message",
+        "errors"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "Group": {
+      "description": "group as represented in manifest replace/export operations",
+      "type": "object",
+      "required": [
+        "description",
+        "policies"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "enforce_max_bookings": {
+          "description": "Limit the number of current/future bookings held by all members of the group, under the group's policies",
+          "type": "boolean"
+        },
+        "enforce_max_usage": {
+          "description": "Limit the usage of all members of the group, under the group's policies",
+          "type": "boolean"
+        },
+        "max_bookings": {
+          "type": "integer"
+        },
+        "max_usage": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GroupDescribed": {
+      "description": "group as reported when returning list of groups to user",
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "object",
+          "$ref": "#/definitions/Description"
+        }
+      }
+    },
+    "GroupDescribedWithPolicies": {
+      "description": "group description with full details of policies included",
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "object",
+          "$ref": "#/definitions/Description"
+        },
+        "policies": {
+          "$ref": "#/definitions/PoliciesDescribed"
+        }
+      }
+    },
+    "GroupStatus": {
+      "description": "bookings and usage of all members of a group, under the group's policies, with the allowance remaining where the group enforces a limit",
+      "type": "object",
+      "required": [
+        "bookings_remaining",
+        "current_bookings",
+        "enforce_max_bookings",
+        "enforce_max_usage",
+        "usage",
+        "usage_remaining"
+      ],
+      "properties": {
+        "bookings_remaining": {
+          "type": "integer"
+        },
+        "current_bookings": {
+          "type": "integer"
+        },
+        "enforce_max_bookings": {
+          "type": "boolean"
+        },
+        "enforce_max_usage": {
+          "type": "boolean"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "usage_remaining": {
+          "type": "string"
+        }
+      }
+    },
+    "GroupsDescribed": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/GroupDescribed"
+      }
+    },
+    "Interval": {
+      "type": "object",
+      "properties": {
+        "end": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "start": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Intervals": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Interval"
+      }
+    },
+    "Manifest": {
+      "description": "Represents resources that can be booked",
+      "type": "object",
+      "title": "manifest",
+      "required": [
+        "descriptions",
+        "policies",
+        "resources",
+        "slots",
+        "streams",
+        "uis",
+        "ui_sets",
+        "windows"
+      ],
+      "properties": {
+        "blackouts": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Blackout"
+          }
+        },
+        "descriptions": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Description"
+          }
+        },
+        "display_guides": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/DisplayGuide"
+          }
+        },
+        "groups": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Group"
+          }
+        },
+        "policies": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Policy"
+          }
+        },
+        "pools": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Pool"
+          }
+        },
+        "resources": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Resource"
+          }
+        },
+        "slots": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Slot"
+          }
+        },
+        "streams": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/ManifestStream"
+          }
+        },
+        "ui_sets": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/UISet"
+          }
+        },
+        "uis": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/UI"
+          }
+        },
+        "windows": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Window"
+          }
+        }
+      }
+    },
+    "ManifestDeletions": {
+      "description": "Names of the entities of each kind to delete from the manifest",
+      "type": "object",
+      "title": "manifest deletions",
+      "properties": {
+        "blackouts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "descriptions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "display_guides": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "streams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "ui_sets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "uis": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "windows": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "ManifestDiff": {
+      "description": "Entities that a candidate manifest adds, changes or removes compared to the current manifest, and the existing bookings that would be affected if it replaced the current manifest",
+      "type": "object",
+      "title": "manifest diff",
+      "properties": {
+        "added": {
+          "$ref": "#/definitions/ManifestEntities"
+        },
+        "bookings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BookingImpact"
+          }
+        },
+        "changed": {
+          "$ref": "#/definitions/ManifestEntities"
+        },
+        "removed": {
+          "$ref": "#/definitions/ManifestEntities"
+        }
+      }
+    },
+    "ManifestEntities": {
+      "description": "Names of the entities of each kind in a manifest",
+      "type": "object",
+      "title": "manifest entities",
+      "properties": {
+        "blackouts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "descriptions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "display_guides": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "streams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "ui_sets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "uis": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "windows": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "ManifestPatch": {
+      "description": "Changes to individual entities of the manifest. The entities in set are added, or replace existing entities of the same kind with the same name, and then the entities named in delete are removed.",
+      "type": "object",
+      "title": "manifest patch",
+      "properties": {
+        "delete": {
+          "$ref": "#/definitions/ManifestDeletions"
+        },
+        "set": {
+          "$ref": "#/definitions/ManifestSet"
+        }
+      }
+    },
+    "ManifestSet": {
+      "description": "Entities to add to the manifest, or to replace existing entities of the same kind with the same name",
+      "type": "object",
+      "title": "manifest set",
+      "properties": {
+        "blackouts": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Blackout"
+          }
+        },
+        "descriptions": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Description"
+          }
+        },
+        "display_guides": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/DisplayGuide"
+          }
+        },
+        "groups": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Group"
+          }
+        },
+        "policies": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Policy"
+          }
+        },
+        "pools": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Pool"
+          }
+        },
+        "resources": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Resource"
+          }
+        },
+        "slots": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Slot"
+          }
+        },
+        "streams": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/ManifestStream"
+          }
+        },
+        "ui_sets": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/UISet"
+          }
+        },
+        "uis": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/UI"
+          }
+        },
+        "windows": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Window"
+          }
+        }
+      }
+    },
+    "ManifestStream": {
+      "description": "represents a prototype stream as described in manifest",
+      "type": "object",
+      "title": "manifest stream",
+      "required": [
+        "connection_type",
+        "for",
+        "scopes",
+        "topic",
+        "url"
+      ],
+      "properties": {
+        "connection_type": {
+          "type": "string"
+        },
+        "for": {
+          "description": "Describes the stream, and doubles as template key in the URL",
+          "type": "string",
+          "example": "video"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "topic": {
+          "type": "string"
+        },
+        "url": {
+          "description": "URL at which to obtain access to the stream (getting a redirect URL containing a one time code)",
+          "type": "string",
+          "example": "https://relay-access.practable.io/session/abc123"
+        }
+      }
+    },
+    "ManifestVersion": {
+      "description": "A manifest that was applied, and when and by whom it was applied",
+      "type": "object",
+      "title": "manifest version",
+      "properties": {
+        "applied": {
+          "description": "when the manifest was applied",
+          "type": "string",
+          "format": "date-time"
+        },
+        "author": {
+          "description": "subject of the token used to apply the manifest",
+          "type": "string"
+        },
+        "manifest": {
+          "$ref": "#/definitions/Manifest"
+        },
+        "version": {
+          "description": "number of the version, starting at one and increasing by one each time a manifest is applied",
+          "type": "integer"
+        }
+      }
+    },
+    "ManifestVersionInfo": {
+      "description": "When a version of the manifest was applied, and by whom",
+      "type": "object",
+      "title": "manifest version info",
+      "properties": {
+        "applied": {
+          "description": "when the manifest was applied",
+          "type": "string",
+          "format": "date-time"
+        },
+        "author": {
+          "description": "subject of the token used to apply the manifest",
+          "type": "string"
+        },
+        "version": {
+          "description": "number of the version, starting at one and increasing by one each time a manifest is applied",
+          "type": "integer"
+        }
+      }
+    },
+    "ManifestVersions": {
+      "description": "list of the retained versions of the manifest, oldest first",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ManifestVersionInfo"
+      }
+    },
+    "PoliciesDescribed": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/PolicyDescribed"
+      }
+    },
+    "Policy": {
+      "description": "used in uploading manifests (only includes its own description by reference)",
+      "type": "object",
+      "required": [
+        "description",
+        "slots"
+      ],
+      "properties": {
+        "allow_start_in_past_within": {
+          "type": "string"
+        },
+        "auto_book_waitlist": {
+          "type": "boolean"
+        },
+        "book_ahead": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "display_guides": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "duration_step": {
+          "type": "string"
+        },
+        "enforce_allow_start_in_past": {
+          "type": "boolean"
+        },
+        "enforce_book_ahead": {
+          "type": "boolean"
+        },
+        "enforce_duration_step": {
+          "type": "boolean"
+        },
+        "enforce_grace_period": {
+          "type": "boolean"
+        },
+        "enforce_max_bookings": {
+          "type": "boolean"
+        },
+        "enforce_max_duration": {
+          "type": "boolean"
+        },
+        "enforce_max_usage": {
+          "type": "boolean"
+        },
+        "enforce_max_usage_per_period": {
+          "type": "boolean"
+        },
+        "enforce_min_duration": {
+          "type": "boolean"
+        },
+        "enforce_next_available": {
+          "type": "boolean"
+        },
+        "enforce_start_alignment": {
+          "type": "boolean"
+        },
+        "enforce_starts_within": {
+          "type": "boolean"
+        },
+        "enforce_unlimited_users": {
+          "type": "boolean"
+        },
+        "grace_penalty": {
+          "type": "string"
+        },
+        "grace_period": {
+          "type": "string"
+        },
+        "max_bookings": {
+          "type": "integer"
+        },
+        "max_duration": {
+          "type": "string"
+        },
+        "max_usage": {
+          "type": "string"
+        },
+        "max_usage_per_period": {
+          "description": "Usage allowed in each usage period, e.g. 2h, if enforced",
+          "type": "string"
+        },
+        "min_duration": {
+          "type": "string"
+        },
+        "next_available": {
+          "type": "string"
+        },
+        "priority": {
+          "description": "Bookings under this policy displace conflicting bookings under policies of lower priority (default 0)",
+          "type": "integer"
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "start_alignment": {
+          "type": "string"
+        },
+        "starts_within": {
+          "type": "string"
+        },
+        "usage_period": {
+          "description": "Period over which max_usage_per_period applies, one of daily, weekly (starting Monday, UTC) or termly",
+          "type": "string"
+        },
+        "usage_terms": {
+          "description": "Terms for a termly usage period. Bookings starting outside all terms are not limited per period",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "PolicyDescribed": {
+      "type": "object",
+      "required": [
+        "description",
+        "slots"
+      ],
+      "properties": {
+        "allow_start_in_past_within": {
+          "type": "string"
+        },
+        "auto_book_waitlist": {
+          "type": "boolean"
+        },
+        "book_ahead": {
+          "type": "string"
+        },
+        "description": {
+          "$ref": "#/definitions/Description"
+        },
+        "display_guides": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/DisplayGuide"
+          }
+        },
+        "duration_step": {
+          "type": "string"
+        },
+        "enforce_allow_start_in_past": {
+          "type": "boolean"
+        },
+        "enforce_book_ahead": {
+          "type": "boolean"
+        },
+        "enforce_duration_step": {
+          "type": "boolean"
+        },
+        "enforce_grace_period": {
+          "type": "boolean"
+        },
+        "enforce_max_bookings": {
+          "type": "boolean"
+        },
+        "enforce_max_duration": {
+          "type": "boolean"
+        },
+        "enforce_max_usage": {
+          "type": "boolean"
+        },
+        "enforce_max_usage_per_period": {
+          "type": "boolean"
+        },
+        "enforce_min_duration": {
+          "type": "boolean"
+        },
+        "enforce_next_available": {
+          "type": "boolean"
+        },
+        "enforce_start_alignment": {
+          "type": "boolean"
+        },
+        "enforce_starts_within": {
+          "type": "boolean"
+        },
+        "enforce_unlimited_users": {
+          "type": "boolean"
+        },
+        "grace_penalty": {
+          "type": "string"
+        },
+        "grace_period": {
+          "type": "string"
+        },
+        "max_bookings": {
+          "type": "integer"
+        },
+        "max_duration": {
+          "type": "string"
+        },
+        "max_usage": {
+          "type": "string"
+        },
+        "max_usage_per_period": {
+          "description": "Usage allowed in each usage period, e.g. 2h, if enforced",
+          "type": "string"
+        },
+        "min_duration": {
+          "type": "string"
+        },
+        "next_available": {
+          "type": "string"
+        },
+        "priority": {
+          "description": "Bookings under this policy displace conflicting bookings under policies of lower priority (default 0)",
+          "type": "integer"
+        },
+        "slots": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/SlotDescribed"
+          }
+        },
+        "start_alignment": {
+          "type": "string"
+        },
+        "starts_within": {
+          "type": "string"
+        },
+        "usage_period": {
+          "description": "Period over which max_usage_per_period applies, one of daily, weekly (starting Monday, UTC) or termly",
+          "type": "string"
+        },
+        "usage_terms": {
+          "description": "Terms for a termly usage period. Bookings starting outside all terms are not limited per period",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "PolicyStatus": {
+      "type": "object",
+      "required": [
+        "current_bookings",
+        "old_bookings",
+        "usage"
+      ],
+      "properties": {
+        "current_bookings": {
+          "type": "integer"
+        },
+        "groups": {
+          "description": "Status of each of the user's groups that include the policy and limit bookings or usage",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/GroupStatus"
+          }
+        },
+        "old_bookings": {
+          "type": "integer"
+        },
+        "period_end": {
+          "description": "End of the current usage period, if the policy limits usage per period",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "period_remaining": {
+          "description": "Usage remaining in the current usage period",
+          "type": "string"
+        },
+        "period_start": {
+          "description": "Start of the current usage period, if the policy limits usage per period",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "period_usage": {
+          "description": "Usage in the current usage period",
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        }
+      }
+    },
+    "Pool": {
+      "description": "Set of interchangeable resources, so that bookings on a resource that goes offline can be moved to another resource in the pool",
+      "type": "object",
+      "title": "pool",
+      "required": [
+        "description",
+        "resources"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ReconcileReport": {
+      "description": "What was done to each existing booking affected by a new manifest",
+      "type": "object",
+      "title": "reconcile report",
+      "properties": {
+        "bookings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReconciledBooking"
+          }
+        },
+        "mode": {
+          "description": "what was done with affected bookings, one of keep, cancel or move",
+          "type": "string"
+        }
+      }
+    },
+    "ReconciledBooking": {
+      "description": "An existing booking affected by a new manifest, and what was done to it",
+      "type": "object",
+      "title": "reconciled booking",
+      "required": [
+        "action",
+        "booking",
+        "reasons",
+        "slot",
+        "when"
+      ],
+      "properties": {
+        "action": {
+          "description": "what was done to the booking, one of kept, cancelled or moved",
+          "type": "string"
+        },
+        "booking": {
+          "description": "name of the booking",
+          "type": "string"
+        },
+        "new_slot": {
+          "description": "name of the slot that the booking was moved to",
+          "type": "string"
+        },
         "policy": {
           "description": "policy under which the booking was made",
           "type": "string"
         },
+        "reasons": {
+          "description": "why the booking was affected, e.g. its slot was removed, or it is outside the periods allowed by its window",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "slot": {
-          "description": "name of the slot that has been booked",
+          "description": "name of the slot that was booked",
           "type": "string"
         },
-        "started": {
-          "description": "has the booking been started by the user?",
-          "type": "boolean"
+        "user": {
+          "description": "name of the user who made the booking",
+          "type": "string"
         },
-        "started_at": {
-          "description": "time the booking was first started by the user",
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
+    "Recurrence": {
+      "description": "A rule for repeating a booking, similar to an iCalendar RRULE. Occurrences start at the same time of day as the first booking, in the time zone (UTC if not given), every interval days or weeks, until count occurrences have been generated, or the next one would start after until. Occurrences on the same date as any of the except times are skipped, but still count towards the count.",
+      "type": "object",
+      "title": "recurrence",
+      "required": [
+        "frequency"
+      ],
+      "properties": {
+        "count": {
+          "description": "number of occurrences, including any that are skipped",
+          "type": "integer"
+        },
+        "except": {
+          "description": "dates on which occurrences are skipped, e.g. for holidays",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "date-time"
+          }
+        },
+        "frequency": {
+          "description": "how often the booking repeats, either daily or weekly",
           "type": "string",
-          "format": "date-time"
+          "example": "weekly"
         },
-        "unfulfilled": {
-          "description": "was the resource unavailable",
-          "type": "boolean"
+        "interval": {
+          "description": "number of days or weeks between occurrences (default 1)",
+          "type": "integer"
         },
-        "usage_charged": {
-          "description": "how much usage was actually charged for this booking",
+        "time_zone": {
+          "description": "IANA time zone in which occurrences keep the time of day of the first booking, across changes to daylight saving time (default UTC)",
+          "type": "string",
+          "example": "Europe/London"
+        },
+        "until": {
+          "description": "latest time that an occurrence may start",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "RecurringBooking": {
+      "description": "A request for a booking of a slot for a user, that is repeated according to the recurrence. Either all the occurrences are booked, or none are.",
+      "type": "object",
+      "title": "recurring booking",
+      "required": [
+        "recurrence",
+        "slot",
+        "user",
+        "when"
+      ],
+      "properties": {
+        "recurrence": {
+          "$ref": "#/definitions/Recurrence"
+        },
+        "slot": {
+          "description": "name of the slot to book",
           "type": "string"
         },
         "user": {
-          "description": "name of the user who made the booking",
+          "description": "name of the user to book for",
           "type": "string"
         },
         "when": {
@@ -1551,916 +3641,1485 @@ func init() {
         }
       }
     },
-    "Bookings": {
-      "description": "list of bookings",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Booking"
-      }
-    },
-    "Description": {
-      "description": "Description of a resource e.g. policy, slot, user interface",
+    "Resource": {
+      "type": "object",
+      "required": [
+        "description",
+        "streams",
+        "topic_stub"
+      ],
+      "properties": {
+        "buffer": {
+          "description": "Turnaround time that must be left free before and after each booking, e.g. 5m",
+          "type": "string"
+        },
+        "config_url": {
+          "type": "string",
+          "format": "url"
+        },
+        "description": {
+          "type": "string"
+        },
+        "streams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tests": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "topic_stub": {
+          "type": "string"
+        }
+      }
+    },
+    "ResourceStatus": {
+      "type": "object",
+      "required": [
+        "available",
+        "reason"
+      ],
+      "properties": {
+        "available": {
+          "type": "boolean"
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "Resources": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/Resource"
+      }
+    },
+    "Slot": {
+      "type": "object",
+      "required": [
+        "description",
+        "policy",
+        "resource",
+        "ui_set",
+        "window"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "resource": {
+          "type": "string"
+        },
+        "ui_set": {
+          "type": "string"
+        },
+        "window": {
+          "type": "string"
+        }
+      }
+    },
+    "SlotAvailability": {
+      "description": "The availability of one of the slots in a policy.",
+      "type": "object",
+      "required": [
+        "slot",
+        "available"
+      ],
+      "properties": {
+        "available": {
+          "description": "the intervals for which the slot is available",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          }
+        },
+        "slot": {
+          "description": "name of the slot",
+          "type": "string"
+        }
+      }
+    },
+    "SlotDescribed": {
+      "type": "object",
+      "required": [
+        "description",
+        "policy"
+      ],
+      "properties": {
+        "description": {
+          "$ref": "#/definitions/Description"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "SlotsAvailability": {
+      "description": "list of the availability of the slots in a policy",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SlotAvailability"
+      }
+    },
+    "StoreStatusAdmin": {
+      "type": "object",
+      "required": [
+        "locked",
+        "message",
+        "now",
+        "bookings",
+        "descriptions",
+        "filters",
+        "old_bookings",
+        "policies",
+        "resources",
+        "slots",
+        "streams",
+        "uis",
+        "ui_sets",
+        "users",
+        "windows"
+      ],
+      "properties": {
+        "bookings": {
+          "type": "integer"
+        },
+        "descriptions": {
+          "type": "integer"
+        },
+        "filters": {
+          "type": "integer"
+        },
+        "locked": {
+          "type": "boolean"
+        },
+        "message": {
+          "type": "string"
+        },
+        "now": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "old_bookings": {
+          "type": "integer"
+        },
+        "policies": {
+          "type": "integer"
+        },
+        "resources": {
+          "type": "integer"
+        },
+        "slots": {
+          "type": "integer"
+        },
+        "streams": {
+          "type": "integer"
+        },
+        "ui_sets": {
+          "type": "integer"
+        },
+        "uis": {
+          "type": "integer"
+        },
+        "users": {
+          "type": "integer"
+        },
+        "windows": {
+          "type": "integer"
+        }
+      }
+    },
+    "StoreStatusUser": {
       "type": "object",
-      "title": "description",
       "required": [
-        "name",
-        "type"
+        "locked",
+        "message",
+        "now"
       ],
       "properties": {
-        "further": {
-          "description": "URL for further information",
-          "type": "string",
-          "example": "https://static.practable.io/info/penduino/ui.html"
-        },
-        "image": {
-          "description": "URL of main image (500x500)",
-          "type": "string",
-          "example": "https://assets.practable.io/images/penduino-500x500.png"
+        "locked": {
+          "type": "boolean"
         },
-        "long": {
-          "description": "280 char additional description of the interface in plain text",
+        "message": {
           "type": "string"
         },
-        "name": {
-          "description": "Display name",
-          "type": "string",
-          "example": "Penduino (basic)"
-        },
-        "short": {
-          "description": "140 char max description of the interface in plain text",
-          "type": "string",
-          "example": "Interface to the penduino electromagnetic pendulum with variable drive, active braking, short and open circuit loading."
-        },
-        "thumb": {
-          "description": "URL of thumbnail image (150x150)",
-          "type": "string",
-          "example": "https://assets.practable.io/images/penduino-150x150.png"
-        },
-        "type": {
-          "description": "Type of resource being described, e.g. pool, activity, userinterface",
+        "now": {
           "type": "string",
-          "example": "userinterface"
+          "format": "date-time"
         }
-      },
-      "example": {
-        "further": "https://static.practable.io/descriptions/pools/penduino/index.html",
-        "image": "https://assets.practable.io/images/pools/penduino/image.png",
-        "long": "Some longer description",
-        "name": "Penduino (basic)",
-        "short": "Penduino electromagnetic pendulums with pulsed drive and braking",
-        "thumb": "https://assets.practable.io/images/pools/penduino/thumb.png",
-        "type": "pool"
       }
     },
-    "DisplayGuide": {
+    "UI": {
       "type": "object",
+      "title": "User Interface",
       "required": [
-        "book_ahead",
-        "duration",
-        "label",
-        "max_slots"
+        "url",
+        "description"
       ],
       "properties": {
-        "book_ahead": {
-          "type": "string"
-        },
-        "duration": {
+        "description": {
           "type": "string"
         },
-        "label": {
-          "description": "what to display in the tab heading for these slots",
-          "type": "string"
+        "streams_required": {
+          "description": "list of names of required streams",
+          "type": "array",
+          "items": {
+            "description": "stream name",
+            "type": "string",
+            "example": "video"
+          },
+          "example": [
+            "data",
+            "video"
+          ]
         },
-        "max_slots": {
-          "type": "integer"
+        "url": {
+          "description": "template for the URL for the user interface",
+          "type": "string",
+          "example": "https://static.practable.io/ui/penduino-basic.html?video={{video}}\u0026data={{data}}"
         }
       }
     },
-    "Error": {
+    "UIDescribed": {
       "type": "object",
+      "title": "User Interface with description including",
       "required": [
-        "code",
-        "message"
+        "url",
+        "description"
       ],
       "properties": {
-        "code": {
-          "type": "string"
+        "description": {
+          "$ref": "#/definitions/Description"
         },
-        "message": {
-          "type": "string"
+        "streams_required": {
+          "description": "list of names of required streams",
+          "type": "array",
+          "items": {
+            "description": "stream name",
+            "type": "string",
+            "example": "video"
+          },
+          "example": [
+            "data",
+            "video"
+          ]
+        },
+        "url": {
+          "description": "template for the URL for the user interface",
+          "type": "string",
+          "example": "https://static.practable.io/ui/penduino-basic.html?video={{video}}\u0026data={{data}}"
         }
       }
     },
-    "ErrorList": {
-      "description": "use when there is one or more errors that may need returning, e.g. manifest check issues",
+    "UISet": {
       "type": "object",
-      "required": [
-        "code",
-        "message",
-        "errors"
-      ],
+      "title": "set of User Interfaces",
       "properties": {
-        "code": {
-          "type": "string"
-        },
-        "errors": {
+        "UIs": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        },
-        "message": {
-          "type": "string"
         }
       }
     },
-    "Group": {
-      "description": "group as represented in manifest replace/export operations",
+    "User": {
       "type": "object",
-      "required": [
-        "description",
-        "policies"
-      ],
       "properties": {
-        "description": {
-          "type": "string"
+        "bookings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "policies": {
+        "groups": {
           "type": "array",
           "items": {
             "type": "string"
           }
+        },
+        "old_bookings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "usage": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
-    "GroupDescribed": {
-      "description": "group as reported when returning list of groups to user",
+    "UserName": {
       "type": "object",
       "properties": {
-        "description": {
-          "type": "object",
-          "$ref": "#/definitions/Description"
+        "user_name": {
+          "type": "string"
         }
       }
     },
-    "GroupDescribedWithPolicies": {
-      "description": "group description with full details of policies included",
+    "Users": {
+      "type": "object",
+      "title": "set of Users (export only)",
+      "additionalProperties": {
+        "$ref": "#/definitions/User"
+      }
+    },
+    "WaitlistEntries": {
+      "description": "list of waitlist entries",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/WaitlistEntry"
+      }
+    },
+    "WaitlistEntry": {
+      "description": "A waitlist entry represents a user's interest in booking a slot for an interval, in case time is freed, e.g. by a cancellation. Freed time that is offered to the user is held for them, so no-one else can book it, until the offer expires.",
       "type": "object",
+      "title": "waitlist entry",
+      "required": [
+        "name",
+        "slot",
+        "user",
+        "when"
+      ],
       "properties": {
-        "description": {
-          "type": "object",
-          "$ref": "#/definitions/Description"
+        "joined_at": {
+          "description": "time the user joined the waitlist",
+          "type": "string",
+          "format": "date-time"
         },
-        "policies": {
-          "$ref": "#/definitions/PoliciesDescribed"
+        "name": {
+          "description": "unique name of the waitlist entry",
+          "type": "string"
+        },
+        "offer_expires_at": {
+          "description": "time the offered time stops being held for the user",
+          "type": "string",
+          "format": "date-time"
+        },
+        "offered": {
+          "$ref": "#/definitions/Interval"
+        },
+        "offered_at": {
+          "description": "time the freed time was offered to the user",
+          "type": "string",
+          "format": "date-time"
+        },
+        "slot": {
+          "description": "name of the slot that the user is waiting for",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who is waiting",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
         }
       }
     },
-    "GroupsDescribed": {
+    "Window": {
       "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/GroupDescribed"
+      "properties": {
+        "allowed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          }
+        },
+        "allowed_rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WindowRule"
+          },
+          "x-omitempty": true
+        },
+        "blackouts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "denied": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          }
+        },
+        "denied_rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WindowRule"
+          },
+          "x-omitempty": true
+        }
       }
     },
-    "Interval": {
+    "WindowRule": {
+      "description": "Recurring period between the start and end times of day (15:04) in the time zone (UTC if not given), on each of the days of the week given (every day if none are given), from the from date until the until date inclusive",
       "type": "object",
+      "title": "window rule",
+      "required": [
+        "end",
+        "from",
+        "start",
+        "until"
+      ],
       "properties": {
+        "days": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "end": {
+          "type": "string"
+        },
+        "from": {
           "type": "string",
           "format": "date-time"
         },
         "start": {
+          "type": "string"
+        },
+        "time_zone": {
+          "type": "string"
+        },
+        "until": {
           "type": "string",
           "format": "date-time"
         }
       }
+    }
+  },
+  "responses": {
+    "ErrorList": {
+      "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+      "schema": {
+        "$ref": "#/definitions/ErrorList"
+      }
     },
-    "Intervals": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Interval"
+    "InternalError": {
+      "description": "Internal Error",
+      "schema": {
+        "$ref": "#/definitions/Error"
       }
     },
-    "Manifest": {
-      "description": "Represents resources that can be booked",
-      "type": "object",
-      "title": "manifest",
-      "required": [
-        "descriptions",
-        "policies",
-        "resources",
-        "slots",
-        "streams",
-        "uis",
-        "ui_sets",
-        "windows"
-      ],
-      "properties": {
-        "descriptions": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/Description"
-          }
-        },
-        "display_guides": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/DisplayGuide"
-          }
-        },
-        "groups": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/Group"
+    "NotFound": {
+      "description": "The specified resource was not found",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
+    },
+    "Unauthorized": {
+      "description": "Unauthorized",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
+    }
+  },
+  "securityDefinitions": {
+    "Bearer": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "tags": [
+    {
+      "description": "for admins only",
+      "name": "admin"
+    },
+    {
+      "description": "for users",
+      "name": "users"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "text/plain"
+  ],
+  "produces": [
+    "application/json",
+    "text/plain"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "User API for booking slots in advance",
+    "title": "AdvanceBooking",
+    "contact": {
+      "name": "Tim Drysdale",
+      "url": "https://practable.io",
+      "email": "timothy.d.drysdale@gmail.com"
+    },
+    "version": "0.5"
+  },
+  "host": "book.practable.io",
+  "basePath": "/api/v1",
+  "paths": {
+    "/admin/bookings": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "policies": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/Policy"
+        ],
+        "description": "Exports a copy of the current bookings, with sufficient information to allow editing and replacement. If successful produces JSON-formatted bookings list.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Export a copy of all current bookings",
+        "operationId": "ExportBookings",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "resources": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/Resource"
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "slots": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/Slot"
+        ],
+        "description": "Deletes all current bookings, refunds usage to users, and then replaces with current bookings. Existing users are retained, new users are created as required to match bookings.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Replace current bookings",
+        "operationId": "ReplaceBookings",
+        "parameters": [
+          {
+            "name": "bookings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
           }
-        },
-        "streams": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/ManifestStream"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusAdmin"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "ui_sets": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/UISet"
+        }
+      }
+    },
+    "/admin/bookings/recurring": {
+      "post": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "uis": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/UI"
+        ],
+        "description": "Makes a booking for each occurrence of the interval under the recurrence rule, e.g. every Tuesday 10:00-12:00 for a term, for the user on the slot. Each occurrence is checked against the slot's policy, as for replacing bookings, but not the user's groups. Either all the occurrences are booked, or none are, in which case the occurrences that could not be booked (e.g. because they clash with existing bookings) are listed in the errors. Occurrences are ordinary bookings once made, so can be cancelled individually.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Make a recurring booking",
+        "operationId": "MakeRecurringBooking",
+        "parameters": [
+          {
+            "name": "recurring_booking",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RecurringBooking"
+            }
           }
-        },
-        "windows": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/Window"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+            "schema": {
+              "$ref": "#/definitions/ErrorList"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "ManifestStream": {
-      "description": "represents a prototype stream as described in manifest",
-      "type": "object",
-      "title": "manifest stream",
-      "required": [
-        "connection_type",
-        "for",
-        "scopes",
-        "topic",
-        "url"
-      ],
-      "properties": {
-        "connection_type": {
-          "type": "string"
-        },
-        "for": {
-          "description": "Describes the stream, and doubles as template key in the URL",
-          "type": "string",
-          "example": "video"
-        },
-        "scopes": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/manifest": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Export the manifest (resources, slots, policies, descriptions etc). Does not include bookings or users",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Export the manifest",
+        "operationId": "ExportManifest",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Manifest"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "topic": {
-          "type": "string"
-        },
-        "url": {
-          "description": "URL at which to obtain access to the stream (getting a redirect URL containing a one time code)",
-          "type": "string",
-          "example": "https://relay-access.practable.io/session/abc123"
         }
-      }
-    },
-    "PoliciesDescribed": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/PolicyDescribed"
-      }
-    },
-    "Policy": {
-      "description": "used in uploading manifests (only includes its own description by reference)",
-      "type": "object",
-      "required": [
-        "description",
-        "slots"
-      ],
-      "properties": {
-        "allow_start_in_past_within": {
-          "type": "string"
-        },
-        "book_ahead": {
-          "type": "string"
-        },
-        "description": {
-          "type": "string"
-        },
-        "display_guides": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "put": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "enforce_allow_start_in_past": {
-          "type": "boolean"
-        },
-        "enforce_book_ahead": {
-          "type": "boolean"
-        },
-        "enforce_grace_period": {
-          "type": "boolean"
-        },
-        "enforce_max_bookings": {
-          "type": "boolean"
-        },
-        "enforce_max_duration": {
-          "type": "boolean"
-        },
-        "enforce_max_usage": {
-          "type": "boolean"
-        },
-        "enforce_min_duration": {
-          "type": "boolean"
-        },
-        "enforce_next_available": {
-          "type": "boolean"
-        },
-        "enforce_starts_within": {
-          "type": "boolean"
-        },
-        "enforce_unlimited_users": {
-          "type": "boolean"
-        },
-        "grace_penalty": {
-          "type": "string"
-        },
-        "grace_period": {
-          "type": "string"
-        },
-        "max_bookings": {
-          "type": "integer"
-        },
-        "max_duration": {
-          "type": "string"
-        },
-        "max_usage": {
-          "type": "string"
-        },
-        "min_duration": {
-          "type": "string"
-        },
-        "next_available": {
-          "type": "string"
-        },
-        "slots": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "description": "Delete the existing manifest and replace it with a new one. All items have specified names so bookings do not need updating (except perhaps you should if booked resources have been removed)",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Replace the manifest",
+        "operationId": "ReplaceManifest",
+        "parameters": [
+          {
+            "name": "manifest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Manifest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusAdmin"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Add, update or delete individual entities of the manifest (e.g. slots, resources, windows, policies). The entities in set are added, or replace existing entities of the same kind with the same name, and then the entities named in delete are removed. The resulting manifest is checked before any change is made, and the reasons for any failed checks are returned. The patch is refused if it would affect any existing bookings (e.g. by deleting their slot), and the affected bookings are returned - replace the manifest with a reconcile mode instead. Bookings on resources that are kept are unaffected.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Patch the manifest",
+        "operationId": "PatchManifest",
+        "parameters": [
+          {
+            "name": "patch",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ManifestPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusAdmin"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+            "schema": {
+              "$ref": "#/definitions/ErrorList"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "starts_within": {
-          "type": "string"
         }
       }
     },
-    "PolicyDescribed": {
-      "type": "object",
-      "required": [
-        "description",
-        "slots"
-      ],
-      "properties": {
-        "allow_start_in_past_within": {
-          "type": "string"
-        },
-        "book_ahead": {
-          "type": "string"
-        },
-        "description": {
-          "$ref": "#/definitions/Description"
-        },
-        "display_guides": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/DisplayGuide"
+    "/admin/manifest/check": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "enforce_allow_start_in_past": {
-          "type": "boolean"
-        },
-        "enforce_book_ahead": {
-          "type": "boolean"
-        },
-        "enforce_grace_period": {
-          "type": "boolean"
-        },
-        "enforce_max_bookings": {
-          "type": "boolean"
-        },
-        "enforce_max_duration": {
-          "type": "boolean"
-        },
-        "enforce_max_usage": {
-          "type": "boolean"
-        },
-        "enforce_min_duration": {
-          "type": "boolean"
-        },
-        "enforce_next_available": {
-          "type": "boolean"
-        },
-        "enforce_starts_within": {
-          "type": "boolean"
-        },
-        "enforce_unlimited_users": {
-          "type": "boolean"
-        },
-        "grace_penalty": {
-          "type": "string"
-        },
-        "grace_period": {
-          "type": "string"
-        },
-        "max_bookings": {
-          "type": "integer"
-        },
-        "max_duration": {
-          "type": "string"
-        },
-        "max_usage": {
-          "type": "string"
-        },
-        "min_duration": {
-          "type": "string"
-        },
-        "next_available": {
-          "type": "string"
-        },
-        "slots": {
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/SlotDescribed"
+        ],
+        "description": "Check a manifest is valid. Returns 204 if valid or, 200 with a list of error(s).",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Check a manifest",
+        "operationId": "CheckManifest",
+        "parameters": [
+          {
+            "name": "manifest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Manifest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+            "schema": {
+              "$ref": "#/definitions/ErrorList"
+            }
+          },
+          "204": {
+            "description": "OK - manifest is valid"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "starts_within": {
-          "type": "string"
         }
       }
     },
-    "PolicyStatus": {
-      "type": "object",
-      "required": [
-        "current_bookings",
-        "old_bookings",
-        "usage"
-      ],
-      "properties": {
-        "current_bookings": {
-          "type": "integer"
-        },
-        "old_bookings": {
-          "type": "integer"
-        },
-        "usage": {
-          "type": "string"
+    "/admin/manifest/diff": {
+      "post": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Compare a candidate manifest to the current manifest, without changing anything, listing the entities that would be added, changed or removed, and the existing bookings that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their window, if the candidate replaced the current manifest. The candidate is checked first, and the reasons for any failed checks are returned.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Compare a manifest to the current manifest",
+        "operationId": "DiffManifest",
+        "parameters": [
+          {
+            "name": "manifest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Manifest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ManifestDiff"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+            "schema": {
+              "$ref": "#/definitions/ErrorList"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Resource": {
-      "type": "object",
-      "required": [
-        "description",
-        "streams",
-        "topic_stub"
-      ],
-      "properties": {
-        "config_url": {
-          "type": "string",
-          "format": "url"
-        },
-        "description": {
-          "type": "string"
-        },
-        "streams": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/manifest/reconcile": {
+      "put": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "tests": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "description": "Replace the manifest, then keep, cancel or move the existing bookings that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their window. Cancelled bookings are only charged for time already used, and have cancelled_by set to manifestChanged. Bookings are moved to the first slot listed in their policy that allows the booking and is free, starting with their own slot, and are cancelled if there is none, or they have started. Bookings that are not affected are kept in place. Returns what was done to each affected booking.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Replace the manifest and reconcile the bookings it affects",
+        "operationId": "ReconcileManifest",
+        "parameters": [
+          {
+            "name": "manifest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Manifest"
+            }
+          },
+          {
+            "type": "string",
+            "description": "what to do with affected bookings, one of keep, cancel or move",
+            "name": "mode",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ReconcileReport"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+            "schema": {
+              "$ref": "#/definitions/ErrorList"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "topic_stub": {
-          "type": "string"
-        }
-      }
-    },
-    "ResourceStatus": {
-      "type": "object",
-      "required": [
-        "available",
-        "reason"
-      ],
-      "properties": {
-        "available": {
-          "type": "boolean"
-        },
-        "reason": {
-          "type": "string"
-        }
-      }
-    },
-    "Resources": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/Resource"
-      }
-    },
-    "Slot": {
-      "type": "object",
-      "required": [
-        "description",
-        "policy",
-        "resource",
-        "ui_set",
-        "window"
-      ],
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "resource": {
-          "type": "string"
-        },
-        "ui_set": {
-          "type": "string"
-        },
-        "window": {
-          "type": "string"
         }
       }
     },
-    "SlotDescribed": {
-      "type": "object",
-      "required": [
-        "description",
-        "policy"
-      ],
-      "properties": {
-        "description": {
-          "$ref": "#/definitions/Description"
-        },
-        "policy": {
-          "type": "string"
+    "/admin/manifest/versions": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Lists when each of the most recently applied manifests was applied, and by whom, oldest first. The last version is the current manifest. Every successful replacement, reconciliation or patch of the manifest adds a version.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "List the retained versions of the manifest",
+        "operationId": "GetManifestVersions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ManifestVersions"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "StoreStatusAdmin": {
-      "type": "object",
-      "required": [
-        "locked",
-        "message",
-        "now",
-        "bookings",
-        "descriptions",
-        "filters",
-        "old_bookings",
-        "policies",
-        "resources",
-        "slots",
-        "streams",
-        "uis",
-        "ui_sets",
-        "users",
-        "windows"
-      ],
-      "properties": {
-        "bookings": {
-          "type": "integer"
-        },
-        "descriptions": {
-          "type": "integer"
-        },
-        "filters": {
-          "type": "integer"
-        },
-        "locked": {
-          "type": "boolean"
-        },
-        "message": {
-          "type": "string"
-        },
-        "now": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "old_bookings": {
-          "type": "integer"
-        },
-        "policies": {
-          "type": "integer"
-        },
-        "resources": {
-          "type": "integer"
-        },
-        "slots": {
-          "type": "integer"
-        },
-        "streams": {
-          "type": "integer"
-        },
-        "ui_sets": {
-          "type": "integer"
-        },
-        "uis": {
-          "type": "integer"
-        },
-        "users": {
-          "type": "integer"
-        },
-        "windows": {
-          "type": "integer"
+    "/admin/manifest/versions/{version}": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Gets a retained version of the manifest, including the manifest itself, so that it can be reapplied by replacing the current manifest with it.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get a retained version of the manifest",
+        "operationId": "GetManifestVersion",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "version",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ManifestVersion"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "StoreStatusUser": {
-      "type": "object",
-      "required": [
-        "locked",
-        "message",
-        "now"
-      ],
-      "properties": {
-        "locked": {
-          "type": "boolean"
-        },
-        "message": {
-          "type": "string"
-        },
-        "now": {
-          "type": "string",
-          "format": "date-time"
+    "/admin/oldbookings": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Exports a copy of the old bookings, with sufficient information to allow editing and replacement. If successful produces JSON-formatted bookings list.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Export a copy of all old bookings",
+        "operationId": "ExportOldBookings",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Deletes all old bookings, and all users, then replaces both according to the bookings in the request, i.e. users and their usage are created as required to match bookings.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Replace old bookings",
+        "operationId": "ReplaceOldBookings",
+        "parameters": [
+          {
+            "name": "bookings",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Bookings"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/StoreStatusAdmin"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "UI": {
-      "type": "object",
-      "title": "User Interface",
-      "required": [
-        "url",
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "streams_required": {
-          "description": "list of names of required streams",
-          "type": "array",
-          "items": {
-            "description": "stream name",
-            "type": "string",
-            "example": "video"
+    "/admin/resources": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Gets a list of all resources, including their availability and any tests specified",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get the list of resources in the manifest",
+        "operationId": "GetResources",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Resources"
+            }
           },
-          "example": [
-            "data",
-            "video"
-          ]
-        },
-        "url": {
-          "description": "template for the URL for the user interface",
-          "type": "string",
-          "example": "https://static.practable.io/ui/penduino-basic.html?video={{video}}\u0026data={{data}}"
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "UIDescribed": {
-      "type": "object",
-      "title": "User Interface with description including",
-      "required": [
-        "url",
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "$ref": "#/definitions/Description"
-        },
-        "streams_required": {
-          "description": "list of names of required streams",
-          "type": "array",
-          "items": {
-            "description": "stream name",
+    "/admin/resources/{resource_name}": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get the availability of the resource",
+        "operationId": "GetResourceIsAvailable",
+        "parameters": [
+          {
             "type": "string",
-            "example": "video"
+            "name": "resource_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ResourceStatus"
+            }
           },
-          "example": [
-            "data",
-            "video"
-          ]
-        },
-        "url": {
-          "description": "template for the URL for the user interface",
-          "type": "string",
-          "example": "https://static.practable.io/ui/penduino-basic.html?video={{video}}\u0026data={{data}}"
-        }
-      }
-    },
-    "UISet": {
-      "type": "object",
-      "title": "set of User Interfaces",
-      "properties": {
-        "UIs": {
-          "type": "array",
-          "items": {
-            "type": "string"
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
-      }
-    },
-    "User": {
-      "type": "object",
-      "properties": {
-        "bookings": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "put": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "old_bookings": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "description": "Sets the availability of a resource, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Set the availability of the resource",
+        "operationId": "SetResourceIsAvailable",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "resource_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "available",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "reason",
+            "in": "query",
+            "required": true
           }
-        },
-        "usage": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "UserName": {
-      "type": "object",
-      "properties": {
-        "user_name": {
-          "type": "string"
-        }
-      }
-    },
-    "Users": {
-      "type": "object",
-      "title": "set of Users (export only)",
-      "additionalProperties": {
-        "$ref": "#/definitions/User"
-      }
-    },
-    "Window": {
-      "type": "object",
-      "required": [
-        "allowed"
-      ],
-      "properties": {
-        "allowed": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Interval"
+    "/admin/resources/{resource_name}/bookings.ics": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
           }
-        },
-        "denied": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Interval"
+        ],
+        "description": "Exports the current and old bookings for the resource as an iCalendar (RFC 5545) calendar, so that staff can subscribe to a resource's bookings in their usual calendar app. Each booking is an event with a UID equal to the booking name, so that calendar apps update existing events on each export. Cancelled bookings are included with status CANCELLED.",
+        "produces": [
+          "text/calendar",
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Export the bookings for the resource as an iCalendar",
+        "operationId": "GetBookingsCalendarForResource",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "resource_name",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    }
-  },
-  "responses": {
-    "ErrorList": {
-      "description": "List of errors (e.g. errors in client-provided data such as manifest)",
-      "schema": {
-        "$ref": "#/definitions/ErrorList"
-      }
-    },
-    "InternalError": {
-      "description": "Internal Error",
-      "schema": {
-        "$ref": "#/definitions/Error"
-      }
-    },
-    "NotFound": {
-      "description": "The specified resource was not found",
-      "schema": {
-        "$ref": "#/definitions/Error"
-      }
-    },
-    "Unauthorized": {
-      "description": "Unauthorized",
-      "schema": {
-        "$ref": "#/definitions/Error"
-      }
-    }
-  },
-  "securityDefinitions": {
-    "Bearer": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "tags": [
-    {
-      "description": "for admins only",
-      "name": "admin"
-    },
-    {
-      "description": "for users",
-      "name": "users"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "text/plain"
-  ],
-  "produces": [
-    "application/json",
-    "text/plain"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "User API for booking slots in advance",
-    "title": "AdvanceBooking",
-    "contact": {
-      "name": "Tim Drysdale",
-      "url": "https://practable.io",
-      "email": "timothy.d.drysdale@gmail.com"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
     },
-    "version": "0.5"
-  },
-  "host": "book.practable.io",
-  "basePath": "/api/v1",
-  "paths": {
-    "/admin/bookings": {
+    "/admin/slots/{slot_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Exports a copy of the current bookings, with sufficient information to allow editing and replacement. If successful produces JSON-formatted bookings list.",
+        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Export a copy of all current bookings",
-        "operationId": "ExportBookings",
+        "summary": "Get the availability of the slot",
+        "operationId": "GetSlotIsAvailable",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "slot_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Bookings"
+              "$ref": "#/definitions/ResourceStatus"
             }
           },
           "401": {
@@ -2489,34 +5148,38 @@ func init() {
             "Bearer": []
           }
         ],
-        "description": "Deletes all current bookings, refunds usage to users, and then replaces with current bookings. Existing users are retained, new users are created as required to match bookings.",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Sets the availability of the underlying resource for the slot, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Replace current bookings",
-        "operationId": "ReplaceBookings",
+        "summary": "Set the availability of the slot",
+        "operationId": "SetSlotIsAvailable",
         "parameters": [
           {
-            "name": "bookings",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Bookings"
-            }
+            "type": "string",
+            "name": "slot_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "available",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "reason",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
-            }
+          "204": {
+            "description": "OK"
           },
           "401": {
             "description": "Unauthorized",
@@ -2539,27 +5202,27 @@ func init() {
         }
       }
     },
-    "/admin/manifest": {
+    "/admin/status": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Export the manifest (resources, slots, policies, descriptions etc). Does not include bookings or users",
+        "description": "Gets a count of the number of elements in the store, e.g. Bookings, Descriptions etc to facilitate a necessary but not sufficient check that replace manifest and replace bookings have produced the correct results.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Export the manifest",
-        "operationId": "ExportManifest",
+        "summary": "Get current store status",
+        "operationId": "getStoreStatusAdmin",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Manifest"
+              "$ref": "#/definitions/StoreStatusAdmin"
             }
           },
           "401": {
@@ -2588,26 +5251,28 @@ func init() {
             "Bearer": []
           }
         ],
-        "description": "Delete the existing manifest and replace it with a new one. All items have specified names so bookings do not need updating (except perhaps you should if booked resources have been removed)",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Set whether the booking system is locked for users",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Replace the manifest",
-        "operationId": "ReplaceManifest",
+        "summary": "set/release booking lock",
+        "operationId": "setLock",
         "parameters": [
           {
-            "name": "manifest",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Manifest"
-            }
+            "type": "boolean",
+            "description": "set booking lock",
+            "name": "lock",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "set message of the day (use query so it can be seen in server logs)",
+            "name": "msg",
+            "in": "query"
           }
         ],
         "responses": {
@@ -2638,45 +5303,29 @@ func init() {
         }
       }
     },
-    "/admin/manifest/check": {
+    "/admin/users": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Check a manifest is valid. Returns 204 if valid or, 200 with a list of error(s).",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Export bookings and usage data for each user",
         "produces": [
           "application/json"
         ],
         "tags": [
           "admin"
         ],
-        "summary": "Check a manifest",
-        "operationId": "CheckManifest",
-        "parameters": [
-          {
-            "name": "manifest",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Manifest"
-            }
-          }
-        ],
+        "summary": "Export users",
+        "operationId": "ExportUsers",
         "responses": {
           "200": {
-            "description": "List of errors (e.g. errors in client-provided data such as manifest)",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ErrorList"
+              "$ref": "#/definitions/Users"
             }
           },
-          "204": {
-            "description": "OK - manifest is valid"
-          },
           "401": {
             "description": "Unauthorized",
             "schema": {
@@ -2698,27 +5347,35 @@ func init() {
         }
       }
     },
-    "/admin/oldbookings": {
+    "/descriptions/{description_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Exports a copy of the old bookings, with sufficient information to allow editing and replacement. If successful produces JSON-formatted bookings list.",
+        "description": "Get description",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
+        ],
+        "summary": "Get description",
+        "operationId": "GetDescription",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "description_name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Export a copy of all old bookings",
-        "operationId": "ExportOldBookings",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Bookings"
+              "$ref": "#/definitions/Description"
             }
           },
           "401": {
@@ -2740,40 +5397,37 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/groups/{group_name}": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Deletes all old bookings, and all users, then replaces both according to the bookings in the request, i.e. users and their usage are created as required to match bookings.",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Get fully described group, including described policies, and described slots",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "Replace old bookings",
-        "operationId": "ReplaceOldBookings",
+        "summary": "Get group",
+        "operationId": "GetGroup",
         "parameters": [
           {
-            "name": "bookings",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Bookings"
-            }
+            "type": "string",
+            "name": "group_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
+              "$ref": "#/definitions/GroupDescribedWithPolicies"
             }
           },
           "401": {
@@ -2797,27 +5451,30 @@ func init() {
         }
       }
     },
-    "/admin/resources": {
-      "get": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "description": "Gets a list of all resources, including their availability and any tests specified",
+    "/login/{user_name}": {
+      "post": {
+        "description": "The access token is required to authenticate requests to the rest of the user-facing API. Ideally access to this endpoint should be secured by the identity management system. The access token has a limited lifetime but can be re-requested as needed. Consider rate-limiting this per-connection.",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
+        ],
+        "summary": "Request a user access token",
+        "operationId": "GetAccessToken",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Get the list of resources in the manifest",
-        "operationId": "GetResources",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Resources"
+              "$ref": "#/definitions/AccessToken"
             }
           },
           "401": {
@@ -2841,26 +5498,26 @@ func init() {
         }
       }
     },
-    "/admin/resources/{resource_name}": {
+    "/policies/{policy_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
+        "description": "Get policy",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "Get the availability of the resource",
-        "operationId": "GetResourceIsAvailable",
+        "summary": "Get policy",
+        "operationId": "GetPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "resource_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           }
@@ -2869,7 +5526,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ResourceStatus"
+              "$ref": "#/definitions/PolicyDescribed"
             }
           },
           "401": {
@@ -2891,45 +5548,56 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/policies/{policy_name}/availability": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Sets the availability of a resource, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
+        "description": "Get the availability of the slots in the policy that use the ui_set (or of all the slots in the policy, if no ui_set is given), merged into a single list of the intervals when at least one of the slots is free. Slots whose resource is offline are left out. A merged interval may span more than one slot, so a booking for the whole of it may not be granted. Pagination is supported by the limit and offset parameters, in the same way as for the availability of a single slot.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "Set the availability of the resource",
-        "operationId": "SetResourceIsAvailable",
+        "summary": "Get availability for any slot in the policy",
+        "operationId": "GetAvailabilityAnySlot",
         "parameters": [
           {
             "type": "string",
-            "name": "resource_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "name": "available",
-            "in": "query",
-            "required": true
+            "type": "string",
+            "name": "ui_set",
+            "in": "query"
           },
           {
-            "type": "string",
-            "name": "reason",
-            "in": "query",
-            "required": true
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Intervals"
+            }
           },
           "401": {
             "description": "Unauthorized",
@@ -2952,35 +5620,63 @@ func init() {
         }
       }
     },
-    "/admin/slots/{slot_name}": {
-      "get": {
+    "/policies/{policy_name}/bookings": {
+      "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets the availability of the underlying resource for the slot, including a status message. Indicates when equipment is offline temprorarily, e.g. due to failing an automated test.",
+        "description": "A booking is requested on whichever slot in the policy that uses the ui_set (or any slot in the policy, if no ui_set is given) is free for the interval, so that users do not have to check each slot in turn. The booking is returned, so that the user can see which slot was booked. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "Get the availability of the slot",
-        "operationId": "GetSlotIsAvailable",
+        "summary": "Request a booking on any slot in the policy",
+        "operationId": "MakeBookingAnySlot",
         "parameters": [
           {
             "type": "string",
-            "name": "slot_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "ui_set",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/ResourceStatus"
+              "$ref": "#/definitions/Booking"
             }
           },
           "401": {
@@ -3002,45 +5698,63 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/policies/{policy_name}/slots/availability": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Sets the availability of the underlying resource for the slot, including a status message. Used to prevent users accessing equipment that should not be used, e.g. after failing an automated test, or make it available again after fixing it.",
+        "description": "Get the availability of each of the slots in the policy that use the ui_set (or of all the slots in the policy, if no ui_set is given), in one request instead of one per slot. Availability is given from now until as far ahead as the policy allows, unless narrowed by the optional from and to parameters, and limit restricts the number of intervals given for each slot. A slot whose resource is unavailable has no availability.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "Set the availability of the slot",
-        "operationId": "SetSlotIsAvailable",
+        "summary": "Get availability for each slot in the policy",
+        "operationId": "GetAvailabilityForSlots",
         "parameters": [
           {
             "type": "string",
-            "name": "slot_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "name": "available",
-            "in": "query",
-            "required": true
+            "type": "string",
+            "name": "ui_set",
+            "in": "query"
           },
           {
             "type": "string",
-            "name": "reason",
-            "in": "query",
-            "required": true
+            "format": "date-time",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/SlotsAvailability"
+            }
           },
           "401": {
             "description": "Unauthorized",
@@ -3063,27 +5777,60 @@ func init() {
         }
       }
     },
-    "/admin/status": {
+    "/slots/{slot_name}": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets a count of the number of elements in the store, e.g. Bookings, Descriptions etc to facilitate a necessary but not sufficient check that replace manifest and replace bookings have produced the correct results.",
+        "description": "Availability is given from now until as far ahead as the policy allows, unless narrowed by the optional from and to parameters, e.g. to the week that a UI is showing. Pagination is supported by the limit and offset parameters. For the first query '?limit=20\u0026offset=0', the second '?limit=20\u0026offset=20'. The offset is equal to the zero-indexed value of the first item of the next page to be returned (20 items are indexed from 0 to 19, so 20 is the first item to be returned in the second page). Note that drift can occur if slots are booked during the sending of availability data, potentially preventing a user from seeing some slots that move earlier in the index and cross a pagination boundary. Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
+        ],
+        "summary": "Get availability for the slot",
+        "operationId": "GetAvailability",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "slot_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          }
         ],
-        "summary": "Get current store status",
-        "operationId": "getStoreStatusAdmin",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
+              "$ref": "#/definitions/Intervals"
             }
           },
           "401": {
@@ -3106,42 +5853,55 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Set whether the booking system is locked for users",
+        "description": "A booking is requested for a specific combination of policy-slot-user-from-to. Users should check availability first, and only make requests that are likely to be granted. If there is no current availability then requests will be denied. If there is availability, there is still a chance another user requests the same slot just before - whichever request is received by the server first will be allowed, the other denied. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "set/release booking lock",
-        "operationId": "setLock",
+        "summary": "Request a booking",
+        "operationId": "MakeBooking",
         "parameters": [
           {
-            "type": "boolean",
-            "description": "set booking lock",
-            "name": "lock",
+            "type": "string",
+            "name": "slot_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user_name",
             "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "description": "set message of the day (use query so it can be seen in server logs)",
-            "name": "msg",
-            "in": "query"
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/StoreStatusAdmin"
-            }
+          "204": {
+            "description": "OK - No Content"
           },
           "401": {
             "description": "Unauthorized",
@@ -3155,6 +5915,10 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "409": {
+            "description": "Conflict - unavailable for the requested interval",
+            "schema": {}
+          },
           "500": {
             "description": "Internal Error",
             "schema": {
@@ -3164,27 +5928,27 @@ func init() {
         }
       }
     },
-    "/admin/users": {
+    "/users/status": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Export bookings and usage data for each user",
+        "description": "Gets the current store status from a user perspective (e.g. is it locked? what is the reason?)",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "admin"
+          "users"
         ],
-        "summary": "Export users",
-        "operationId": "ExportUsers",
+        "summary": "Get current store status",
+        "operationId": "getStoreStatusUser",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Users"
+              "$ref": "#/definitions/StoreStatusUser"
             }
           },
           "401": {
@@ -3208,35 +5972,22 @@ func init() {
         }
       }
     },
-    "/descriptions/{description_name}": {
-      "get": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "description": "Get description",
+    "/users/unique": {
+      "post": {
+        "description": "Generates a unique username that meets the minimum length requirements for the booking system. No security/token needed, because users needs a/this name to login",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get description",
-        "operationId": "GetDescription",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "description_name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Request a new, unique username",
+        "operationId": "UniqueName",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Description"
+              "$ref": "#/definitions/UserName"
             }
           },
           "401": {
@@ -3245,12 +5996,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "The specified resource was not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal Error",
             "schema": {
@@ -3260,26 +6005,26 @@ func init() {
         }
       }
     },
-    "/groups/{group_name}": {
+    "/users/{user_name}/bookings": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get fully described group, including described policies, and described slots",
+        "description": "Get all current bookings for the user. It's assumed that no pagination will be required due to likely policy limits being a couple of bookings per policy and users typically having only a couple of policies. No pagination may cause issues for admins that book hundreds or thousands slots on behalf of students, although it is likely such bookings would be automated so no need to support rapid update of a GUI, at least for now.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get group",
-        "operationId": "GetGroup",
+        "summary": "Get all current bookings for the user",
+        "operationId": "GetBookingsForUser",
         "parameters": [
           {
             "type": "string",
-            "name": "group_name",
+            "name": "user_name",
             "in": "path",
             "required": true
           }
@@ -3288,7 +6033,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GroupDescribedWithPolicies"
+              "$ref": "#/definitions/Bookings"
             }
           },
           "401": {
@@ -3312,17 +6057,23 @@ func init() {
         }
       }
     },
-    "/login/{user_name}": {
-      "post": {
-        "description": "The access token is required to authenticate requests to the rest of the user-facing API. Ideally access to this endpoint should be secured by the identity management system. The access token has a limited lifetime but can be re-requested as needed. Consider rate-limiting this per-connection.",
+    "/users/{user_name}/bookings.ics": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Exports the current and old bookings for the user as an iCalendar (RFC 5545) calendar, so that users can subscribe to their bookings in their usual calendar app, instead of each booking UI preparing .ics files. Each booking is an event with the slot's name and short description, and a UID equal to the booking name, so that calendar apps update existing events on each export. Cancelled bookings are included with status CANCELLED, so that calendar apps remove them.",
         "produces": [
+          "text/calendar",
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Request a user access token",
-        "operationId": "GetAccessToken",
+        "summary": "Export the user's bookings as an iCalendar",
+        "operationId": "GetBookingsCalendarForUser",
         "parameters": [
           {
             "type": "string",
@@ -3335,7 +6086,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/AccessToken"
+              "type": "string"
             }
           },
           "401": {
@@ -3359,26 +6110,32 @@ func init() {
         }
       }
     },
-    "/policies/{policy_name}": {
-      "get": {
+    "/users/{user_name}/bookings/{booking_name}": {
+      "put": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get policy",
+        "description": "Checks if the booking has started or not and if so, returns an activity that can be used to access the booked resource. Since this mutates the state of the booking (from unfulfilled to fulfilled) it is a put not a get.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get policy",
-        "operationId": "GetPolicy",
+        "summary": "Request the activity that goes with the booking",
+        "operationId": "GetActivity",
         "parameters": [
           {
             "type": "string",
-            "name": "policy_name",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "booking_name",
             "in": "path",
             "required": true
           }
@@ -3387,7 +6144,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/PolicyDescribed"
+              "$ref": "#/definitions/Activity"
             }
           },
           "401": {
@@ -3409,16 +6166,14 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/slots/{slot_name}": {
-      "get": {
+      },
+      "delete": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Pagination is supported by the limit and offset parameters. For the first query '?limit=20\u0026offset=0', the second '?limit=20\u0026offset=20'. The offset is equal to the zero-indexed value of the first item of the next page to be returned (20 items are indexed from 0 to 19, so 20 is the first item to be returned in the second page). Note that drift can occur if slots are booked during the sending of availability data, potentially preventing a user from seeing some slots that move earlier in the index and cross a pagination boundary. Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.",
+        "description": "For users to cancel their booking(s) on at a time. Checks if the booking is unstarted and/or unfulfilled, and cancels if so. A booking cannot be cancelled once an activity has been requested. A booking can be cancelled after it started, so long as it is unfulfilled (no activity requested). The user must be the owner of the booking to cancel it. Admins can cancel bookings by Lock() -\u003e ExportBookings() -\u003e edit -\u003e ReplaceBookings()-\u003e Unlock(). There is no need for an endpoint for admin single booking cancellation because the only visibility they have of bookings is via ExportBookings. Remaing time in the booking at time of cancellation is refunded to the user's usage tracker for that policy. That is intended to encourage early cancellation. Returns 404 on successful cancellation, or if there is no such booking.",
         "consumes": [
           "application/json"
         ],
@@ -3428,33 +6183,23 @@ func init() {
         "tags": [
           "users"
         ],
-        "summary": "Get availability for the slot",
-        "operationId": "GetAvailability",
+        "summary": "Cancel the booking",
+        "operationId": "CancelBooking",
         "parameters": [
           {
             "type": "string",
-            "name": "slot_name",
+            "name": "user_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "booking_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/Intervals"
-            }
-          },
           "401": {
             "description": "Unauthorized",
             "schema": {
@@ -3475,35 +6220,32 @@ func init() {
           }
         }
       },
-      "post": {
+      "patch": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "A booking is requested for a specific combination of policy-slot-user-from-to. Users should check availability first, and only make requests that are likely to be granted. If there is no current availability then requests will be denied. If there is availability, there is still a chance another user requests the same slot just before - whichever request is received by the server first will be allowed, the other denied. The user_name must match the user_name the user logged in with, that is in the authorisation token in the header.",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Changes the interval of an existing booking in place, keeping its name, e.g. to extend it. The new interval is checked against the window for the slot, and the policy limits on book ahead, duration and usage, in the same way as a new booking, except that the booking does not count against the maximum number of bookings. A booking that has started can only have its end changed, to any time in the future. A started booking that is shortened is charged as if it had been cancelled at its new end, and access is ended at the new end. The usage charged for the booking is adjusted to match the new interval. Returns the resized booking.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Request a booking",
-        "operationId": "MakeBooking",
+        "summary": "Resize the booking",
+        "operationId": "ResizeBooking",
         "parameters": [
           {
             "type": "string",
-            "name": "slot_name",
+            "name": "user_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "user_name",
-            "in": "query",
+            "name": "booking_name",
+            "in": "path",
             "required": true
           },
           {
@@ -3522,8 +6264,11 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK - No Content"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/Booking"
+            }
           },
           "401": {
             "description": "Unauthorized",
@@ -3537,10 +6282,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "409": {
-            "description": "Conflict - unavailable for the requested interval",
-            "schema": {}
-          },
           "500": {
             "description": "Internal Error",
             "schema": {
@@ -3550,27 +6291,61 @@ func init() {
         }
       }
     },
-    "/users/status": {
-      "get": {
+    "/users/{user_name}/bookings/{booking_name}/swap": {
+      "put": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Gets the current store status from a user perspective (e.g. is it locked? what is the reason?)",
+        "description": "Atomically cancels the existing booking and makes a new booking for the slot and interval given in the query, so that the user cannot lose their booking to someone else in between. If the new booking cannot be made, the existing booking is kept unchanged. The new booking may overlap the time of the existing booking, e.g. to extend it. Usage is refunded for the cancelled booking before the new booking is checked against the policy. A booking that has started cannot be swapped. Returns the new booking.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get current store status",
-        "operationId": "getStoreStatusUser",
+        "summary": "Swap the booking for a new one",
+        "operationId": "SwapBooking",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "booking_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "slot_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/StoreStatusUser"
+              "$ref": "#/definitions/Booking"
             }
           },
           "401": {
@@ -3594,22 +6369,35 @@ func init() {
         }
       }
     },
-    "/users/unique": {
-      "post": {
-        "description": "Generates a unique username that meets the minimum length requirements for the booking system. No security/token needed, because users needs a/this name to login",
+    "/users/{user_name}/groups": {
+      "get": {
+        "security": [
+          {
+            "Bearer": []
+          }
+        ],
+        "description": "Get all current groups for user. This only returns groups that are currently listed within the user's groups. Group data includes a description, but not policies.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Request a new, unique username",
-        "operationId": "UniqueName",
+        "summary": "Get all current groups for user",
+        "operationId": "GetGroupsForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "user_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/UserName"
+              "$ref": "#/definitions/GroupsDescribed"
             }
           },
           "401": {
@@ -3618,6 +6406,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "404": {
+            "description": "The specified resource was not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Error",
             "schema": {
@@ -3627,36 +6421,39 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/bookings": {
-      "get": {
+    "/users/{user_name}/groups/{group_name}": {
+      "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get all current bookings for the user. It's assumed that no pagination will be required due to likely policy limits being a couple of bookings per policy and users typically having only a couple of policies. No pagination may cause issues for admins that book hundreds or thousands slots on behalf of students, although it is likely such bookings would be automated so no need to support rapid update of a GUI, at least for now.",
+        "description": "Add group to the list of groups with which this user is allowed to make bookings",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get all current bookings for the user",
-        "operationId": "GetBookingsForUser",
+        "summary": "Add group to user account",
+        "operationId": "AddGroupForUser",
         "parameters": [
           {
             "type": "string",
             "name": "user_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "group_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/Bookings"
-            }
+          "204": {
+            "description": "OK - No Content"
           },
           "401": {
             "description": "Unauthorized",
@@ -3679,41 +6476,35 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/bookings/{booking_name}": {
-      "put": {
+    "/users/{user_name}/oldbookings": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Checks if the booking has started or not and if so, returns an activity that can be used to access the booked resource. Since this mutates the state of the booking (from unfulfilled to fulfilled) it is a put not a get.",
+        "description": "Get all old bookings for the user. It's assumed that no pagination will be required due to likely policy limits including usage limits and users typically having only a couple of policies, although in practice pagination may be useful for heavy users.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Request the activity that goes with the booking",
-        "operationId": "GetActivity",
+        "summary": "Get all old bookings for the user",
+        "operationId": "GetOldBookingsForUser",
         "parameters": [
           {
             "type": "string",
             "name": "user_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "booking_name",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Activity"
+              "$ref": "#/definitions/Bookings"
             }
           },
           "401": {
@@ -3735,25 +6526,24 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/users/{user_name}/policies/{policy_name}": {
+      "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "For users to cancel their booking(s) on at a time. Checks if the booking is unstarted and/or unfulfilled, and cancels if so. A booking cannot be cancelled once an activity has been requested. A booking can be cancelled after it started, so long as it is unfulfilled (no activity requested). The user must be the owner of the booking to cancel it. Admins can cancel bookings by Lock() -\u003e ExportBookings() -\u003e edit -\u003e ReplaceBookings()-\u003e Unlock(). There is no need for an endpoint for admin single booking cancellation because the only visibility they have of bookings is via ExportBookings. Remaing time in the booking at time of cancellation is refunded to the user's usage tracker for that policy. That is intended to encourage early cancellation. Returns 404 on successful cancellation, or if there is no such booking.",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Get policy status for the user",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Cancel the booking",
-        "operationId": "CancelBooking",
+        "summary": "Get policy status",
+        "operationId": "GetPolicyStatusForUser",
         "parameters": [
           {
             "type": "string",
@@ -3763,12 +6553,18 @@ func init() {
           },
           {
             "type": "string",
-            "name": "booking_name",
+            "name": "policy_name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/PolicyStatus"
+            }
+          },
           "401": {
             "description": "Unauthorized",
             "schema": {
@@ -3790,22 +6586,22 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/groups": {
+    "/users/{user_name}/waitlist": {
       "get": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get all current groups for user. This only returns groups that are currently listed within the user's groups. Group data includes a description, but not policies.",
+        "description": "Get the waitlist entries for the user, in the order they were joined, including any offers of freed time.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get all current groups for user",
-        "operationId": "GetGroupsForUser",
+        "summary": "Get the waitlist entries for the user",
+        "operationId": "GetWaitlistForUser",
         "parameters": [
           {
             "type": "string",
@@ -3818,7 +6614,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GroupsDescribed"
+              "$ref": "#/definitions/WaitlistEntries"
             }
           },
           "401": {
@@ -3840,24 +6636,22 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/users/{user_name}/groups/{group_name}": {
+      },
       "post": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Add group to the list of groups with which this user is allowed to make bookings",
+        "description": "Registers interest in booking the slot for the interval given in the query, e.g. when it is already booked. When time on the same resource that overlaps the interval is freed, by a booking being cancelled (by its user, or automatically for not being started within the grace period), shortened or moved, the freed time is offered to the first user waiting for it, or booked for them automatically if the slot's policy has auto_book_waitlist set. Either way, this only happens if the booking would be within the policy. Offers are shown in the waitlist entry, and are booked in the usual way. The user must belong to a group that includes the slot's policy. Returns the waitlist entry.",
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Add group to user account",
-        "operationId": "AddGroupForUser",
+        "summary": "Join the waitlist for the slot",
+        "operationId": "JoinWaitlist",
         "parameters": [
           {
             "type": "string",
@@ -3867,14 +6661,31 @@ func init() {
           },
           {
             "type": "string",
-            "name": "group_name",
-            "in": "path",
+            "name": "slot_name",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "to",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK - No Content"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/WaitlistEntry"
+            }
           },
           "401": {
             "description": "Unauthorized",
@@ -3897,36 +6708,42 @@ func init() {
         }
       }
     },
-    "/users/{user_name}/oldbookings": {
-      "get": {
+    "/users/{user_name}/waitlist/{entry_name}": {
+      "delete": {
         "security": [
           {
             "Bearer": []
           }
         ],
-        "description": "Get all old bookings for the user. It's assumed that no pagination will be required due to likely policy limits including usage limits and users typically having only a couple of policies, although in practice pagination may be useful for heavy users.",
+        "description": "Removes the entry from the waitlist. The user must be the owner of the entry. Entries are also removed automatically when freed time is booked for the user, and once the interval has passed.",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "users"
         ],
-        "summary": "Get all old bookings for the user",
-        "operationId": "GetOldBookingsForUser",
+        "summary": "Leave the waitlist",
+        "operationId": "LeaveWaitlist",
         "parameters": [
           {
             "type": "string",
             "name": "user_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "entry_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/Bookings"
-            }
+          "204": {
+            "description": "OK - No Content"
           },
           "401": {
             "description": "Unauthorized",
@@ -3948,481 +6765,834 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "AccessToken": {
+      "description": "intended use is for users to access the API, and is tied to their user_name.",
+      "type": "object",
+      "title": "access token",
+      "required": [
+        "token",
+        "nbf",
+        "exp",
+        "aud",
+        "sub",
+        "scopes"
+      ],
+      "properties": {
+        "aud": {
+          "description": "Audience",
+          "type": "string"
+        },
+        "exp": {
+          "description": "Expires At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "iat": {
+          "description": "Issued At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "nbf": {
+          "description": "Not before",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "scopes": {
+          "description": "List of scopes",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "example": "user:booking"
+          }
+        },
+        "sub": {
+          "description": "Subject",
+          "type": "string"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "Activity": {
+      "description": "An activity represents connection details to instances of pre-agreed resource types such as video, data streams and user interfaces.",
+      "type": "object",
+      "title": "activity",
+      "required": [
+        "description",
+        "exp",
+        "nbf",
+        "streams",
+        "uis"
+      ],
+      "properties": {
+        "config": {
+          "description": "URL at which to GET the configuration object",
+          "type": "string",
+          "format": "url",
+          "example": "https://assets.practable.io/config/experiments/pvna/pvna01-0.0.json"
+        },
+        "description": {
+          "$ref": "#/definitions/Description"
+        },
+        "exp": {
+          "description": "Expires At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "nbf": {
+          "description": "Expires At",
+          "type": "number",
+          "format": "unix-timestamp"
+        },
+        "streams": {
+          "description": "A list of streams",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ActivityStream"
+          }
+        },
+        "uis": {
+          "description": "User interfaces",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UIDescribed"
+          }
+        }
+      }
+    },
+    "ActivityStream": {
+      "description": "Represents an assigned, valid booking slot for an individual piece of equipment",
+      "type": "object",
+      "title": "stream",
+      "required": [
+        "audience",
+        "connection_type",
+        "for",
+        "scopes",
+        "topic",
+        "url"
+      ],
+      "properties": {
+        "audience": {
+          "type": "string"
+        },
+        "connection_type": {
+          "type": "string"
+        },
+        "for": {
+          "description": "Describes the stream, and doubles as template key in the URL",
+          "type": "string",
+          "example": "video"
+        },
+        "prefix": {
+          "description": "prefix of the relay routing",
+          "type": "string",
+          "example": "session"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "token": {
+          "description": "signed jwt token for accessing the stream",
+          "type": "string"
+        },
+        "topic": {
+          "type": "string"
+        },
+        "url": {
+          "description": "URL at which to obtain access to the stream (getting a redirect URL containing a one time code)",
+          "type": "string",
+          "example": "https://relay-access.practable.io/session/abc123"
+        }
+      }
+    },
+    "Blackout": {
+      "description": "Times when nothing can be booked, such as holidays or maintenance, shared by the windows that reference the blackout",
+      "type": "object",
+      "title": "blackout",
+      "required": [
+        "denied",
+        "description"
+      ],
+      "properties": {
+        "denied": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          }
+        },
+        "description": {
+          "type": "string"
+        }
+      }
+    },
+    "Booking": {
+      "description": "A booking represents a promise to supply an activity. The booleans are not required because we don't process the booking status when loading old bookings (all old bookings are assumed to have been good bookings)",
+      "type": "object",
+      "title": "booking",
+      "required": [
+        "name",
+        "policy",
+        "slot",
+        "user",
+        "when"
+      ],
+      "properties": {
+        "cancelled": {
+          "description": "Has the booking been cancelled?",
+          "type": "boolean"
+        },
+        "cancelled_at": {
+          "description": "time the booking was cancelled",
+          "type": "string",
+          "format": "date-time"
+        },
+        "cancelled_by": {
+          "description": "who cancelled the booking, e.g. auto-grace-expired, admin or user",
+          "type": "string",
+          "example": "auto-grace-expired"
+        },
+        "name": {
+          "description": "unique name of the booking",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy under which the booking was made",
+          "type": "string"
+        },
+        "resource": {
+          "description": "resource substituted for the slot's resource because that went offline (empty if not substituted)",
+          "type": "string"
+        },
+        "slot": {
+          "description": "name of the slot that has been booked",
+          "type": "string"
+        },
+        "started": {
+          "description": "has the booking been started by the user?",
+          "type": "boolean"
+        },
+        "started_at": {
+          "description": "time the booking was first started by the user",
+          "type": "string",
+          "format": "date-time"
+        },
+        "unfulfilled": {
+          "description": "was the resource unavailable",
+          "type": "boolean"
+        },
+        "usage_charged": {
+          "description": "how much usage was actually charged for this booking",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who made the booking",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
+    "BookingImpact": {
+      "description": "An existing booking that a candidate manifest would affect, and the reasons why",
+      "type": "object",
+      "title": "booking impact",
+      "required": [
+        "booking",
+        "reasons",
+        "slot",
+        "when"
+      ],
+      "properties": {
+        "booking": {
+          "description": "name of the booking",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy under which the booking was made",
+          "type": "string"
+        },
+        "reasons": {
+          "description": "why the booking would be affected, e.g. its slot is removed, or it is outside the periods allowed by its window",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "slot": {
+          "description": "name of the slot that has been booked",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who made the booking",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
     },
-    "/users/{user_name}/policies/{policy_name}": {
-      "get": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "description": "Get policy status for the user",
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "users"
-        ],
-        "summary": "Get policy status",
-        "operationId": "GetPolicyStatusForUser",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "user_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "policy_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/PolicyStatus"
-            }
-          },
-          "401": {
-            "description": "Unauthorized",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "The specified resource was not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal Error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+    "Bookings": {
+      "description": "list of bookings",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Booking"
+      }
+    },
+    "Description": {
+      "description": "Description of a resource e.g. policy, slot, user interface",
+      "type": "object",
+      "title": "description",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "further": {
+          "description": "URL for further information",
+          "type": "string",
+          "example": "https://static.practable.io/info/penduino/ui.html"
+        },
+        "image": {
+          "description": "URL of main image (500x500)",
+          "type": "string",
+          "example": "https://assets.practable.io/images/penduino-500x500.png"
+        },
+        "long": {
+          "description": "280 char additional description of the interface in plain text",
+          "type": "string"
+        },
+        "name": {
+          "description": "Display name",
+          "type": "string",
+          "example": "Penduino (basic)"
+        },
+        "short": {
+          "description": "140 char max description of the interface in plain text",
+          "type": "string",
+          "example": "Interface to the penduino electromagnetic pendulum with variable drive, active braking, short and open circuit loading."
+        },
+        "thumb": {
+          "description": "URL of thumbnail image (150x150)",
+          "type": "string",
+          "example": "https://assets.practable.io/images/penduino-150x150.png"
+        },
+        "type": {
+          "description": "Type of resource being described, e.g. pool, activity, userinterface",
+          "type": "string",
+          "example": "userinterface"
         }
+      },
+      "example": {
+        "further": "https://static.practable.io/descriptions/pools/penduino/index.html",
+        "image": "https://assets.practable.io/images/pools/penduino/image.png",
+        "long": "Some longer description",
+        "name": "Penduino (basic)",
+        "short": "Penduino electromagnetic pendulums with pulsed drive and braking",
+        "thumb": "https://assets.practable.io/images/pools/penduino/thumb.png",
+        "type": "pool"
       }
-    }
-  },
-  "definitions": {
-    "AccessToken": {
-      "description": "intended use is for users to access the API, and is tied to their user_name.",
+    },
+    "DisplayGuide": {
       "type": "object",
-      "title": "access token",
       "required": [
-        "token",
-        "nbf",
-        "exp",
-        "aud",
-        "sub",
-        "scopes"
+        "book_ahead",
+        "duration",
+        "label",
+        "max_slots"
       ],
       "properties": {
-        "aud": {
-          "description": "Audience",
+        "book_ahead": {
           "type": "string"
         },
-        "exp": {
-          "description": "Expires At",
-          "type": "number",
-          "format": "unix-timestamp"
+        "duration": {
+          "type": "string"
         },
-        "iat": {
-          "description": "Issued At",
-          "type": "number",
-          "format": "unix-timestamp"
+        "label": {
+          "description": "what to display in the tab heading for these slots",
+          "type": "string"
         },
-        "nbf": {
-          "description": "Not before",
-          "type": "number",
-          "format": "unix-timestamp"
+        "max_slots": {
+          "type": "integer"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "code",
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
         },
-        "scopes": {
-          "description": "List of scopes",
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "ErrorList": {
+      "description": "use when there is one or more errors that may need returning, e.g. manifest check issues",
+      "type": "object",
+      "required": [
+        "code",
+        "message",
+        "errors"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "errors": {
           "type": "array",
           "items": {
-            "type": "string",
-            "example": "user:booking"
+            "type": "string"
           }
         },
-        "sub": {
-          "description": "Subject",
-          "type": "string"
-        },
-        "token": {
+        "message": {
           "type": "string"
         }
       }
     },
-    "Activity": {
-      "description": "An activity represents connection details to instances of pre-agreed resource types such as video, data streams and user interfaces.",
+    "Group": {
+      "description": "group as represented in manifest replace/export operations",
       "type": "object",
-      "title": "activity",
       "required": [
         "description",
-        "exp",
-        "nbf",
-        "streams",
-        "uis"
+        "policies"
       ],
       "properties": {
-        "config": {
-          "description": "URL at which to GET the configuration object",
-          "type": "string",
-          "format": "url",
-          "example": "https://assets.practable.io/config/experiments/pvna/pvna01-0.0.json"
-        },
         "description": {
-          "$ref": "#/definitions/Description"
+          "type": "string"
         },
-        "exp": {
-          "description": "Expires At",
-          "type": "number",
-          "format": "unix-timestamp"
+        "enforce_max_bookings": {
+          "description": "Limit the number of current/future bookings held by all members of the group, under the group's policies",
+          "type": "boolean"
         },
-        "nbf": {
-          "description": "Expires At",
-          "type": "number",
-          "format": "unix-timestamp"
+        "enforce_max_usage": {
+          "description": "Limit the usage of all members of the group, under the group's policies",
+          "type": "boolean"
         },
-        "streams": {
-          "description": "A list of streams",
+        "max_bookings": {
+          "type": "integer"
+        },
+        "max_usage": {
+          "type": "string"
+        },
+        "policies": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ActivityStream"
+            "type": "string"
           }
+        }
+      }
+    },
+    "GroupDescribed": {
+      "description": "group as reported when returning list of groups to user",
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "object",
+          "$ref": "#/definitions/Description"
+        }
+      }
+    },
+    "GroupDescribedWithPolicies": {
+      "description": "group description with full details of policies included",
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "object",
+          "$ref": "#/definitions/Description"
         },
-        "uis": {
-          "description": "User interfaces",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/UIDescribed"
-          }
+        "policies": {
+          "$ref": "#/definitions/PoliciesDescribed"
         }
       }
     },
-    "ActivityStream": {
-      "description": "Represents an assigned, valid booking slot for an individual piece of equipment",
+    "GroupStatus": {
+      "description": "bookings and usage of all members of a group, under the group's policies, with the allowance remaining where the group enforces a limit",
       "type": "object",
-      "title": "stream",
       "required": [
-        "audience",
-        "connection_type",
-        "for",
-        "scopes",
-        "topic",
-        "url"
+        "bookings_remaining",
+        "current_bookings",
+        "enforce_max_bookings",
+        "enforce_max_usage",
+        "usage",
+        "usage_remaining"
       ],
       "properties": {
-        "audience": {
-          "type": "string"
-        },
-        "connection_type": {
-          "type": "string"
+        "bookings_remaining": {
+          "type": "integer"
         },
-        "for": {
-          "description": "Describes the stream, and doubles as template key in the URL",
-          "type": "string",
-          "example": "video"
+        "current_bookings": {
+          "type": "integer"
         },
-        "prefix": {
-          "description": "prefix of the relay routing",
-          "type": "string",
-          "example": "session"
+        "enforce_max_bookings": {
+          "type": "boolean"
         },
-        "scopes": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "enforce_max_usage": {
+          "type": "boolean"
         },
-        "token": {
-          "description": "signed jwt token for accessing the stream",
+        "usage": {
           "type": "string"
         },
-        "topic": {
+        "usage_remaining": {
           "type": "string"
+        }
+      }
+    },
+    "GroupsDescribed": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/GroupDescribed"
+      }
+    },
+    "Interval": {
+      "type": "object",
+      "properties": {
+        "end": {
+          "type": "string",
+          "format": "date-time"
         },
-        "url": {
-          "description": "URL at which to obtain access to the stream (getting a redirect URL containing a one time code)",
+        "start": {
           "type": "string",
-          "example": "https://relay-access.practable.io/session/abc123"
+          "format": "date-time"
         }
       }
     },
-    "Booking": {
-      "description": "A booking represents a promise to supply an activity. The booleans are not required because we don't process the booking status when loading old bookings (all old bookings are assumed to have been good bookings)",
+    "Intervals": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Interval"
+      }
+    },
+    "Manifest": {
+      "description": "Represents resources that can be booked",
       "type": "object",
-      "title": "booking",
+      "title": "manifest",
       "required": [
-        "name",
-        "policy",
-        "slot",
-        "user",
-        "when"
+        "descriptions",
+        "policies",
+        "resources",
+        "slots",
+        "streams",
+        "uis",
+        "ui_sets",
+        "windows"
       ],
       "properties": {
-        "cancelled": {
-          "description": "Has the booking been cancelled?",
-          "type": "boolean"
+        "blackouts": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Blackout"
+          }
         },
-        "cancelled_at": {
-          "description": "time the booking was cancelled",
-          "type": "string",
-          "format": "date-time"
+        "descriptions": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Description"
+          }
         },
-        "cancelled_by": {
-          "description": "who cancelled the booking, e.g. auto-grace-expired, admin or user",
-          "type": "string",
-          "example": "auto-grace-expired"
+        "display_guides": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/DisplayGuide"
+          }
         },
-        "name": {
-          "description": "unique name of the booking",
-          "type": "string"
+        "groups": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Group"
+          }
         },
-        "policy": {
-          "description": "policy under which the booking was made",
-          "type": "string"
+        "policies": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Policy"
+          }
         },
-        "slot": {
-          "description": "name of the slot that has been booked",
-          "type": "string"
+        "pools": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Pool"
+          }
         },
-        "started": {
-          "description": "has the booking been started by the user?",
-          "type": "boolean"
+        "resources": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Resource"
+          }
         },
-        "started_at": {
-          "description": "time the booking was first started by the user",
-          "type": "string",
-          "format": "date-time"
+        "slots": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Slot"
+          }
         },
-        "unfulfilled": {
-          "description": "was the resource unavailable",
-          "type": "boolean"
+        "streams": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/ManifestStream"
+          }
         },
-        "usage_charged": {
-          "description": "how much usage was actually charged for this booking",
-          "type": "string"
+        "ui_sets": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/UISet"
+          }
         },
-        "user": {
-          "description": "name of the user who made the booking",
-          "type": "string"
+        "uis": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/UI"
+          }
         },
-        "when": {
-          "$ref": "#/definitions/Interval"
+        "windows": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Window"
+          }
         }
       }
     },
-    "Bookings": {
-      "description": "list of bookings",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Booking"
-      }
-    },
-    "Description": {
-      "description": "Description of a resource e.g. policy, slot, user interface",
+    "ManifestDeletions": {
+      "description": "Names of the entities of each kind to delete from the manifest",
       "type": "object",
-      "title": "description",
-      "required": [
-        "name",
-        "type"
-      ],
+      "title": "manifest deletions",
       "properties": {
-        "further": {
-          "description": "URL for further information",
-          "type": "string",
-          "example": "https://static.practable.io/info/penduino/ui.html"
+        "blackouts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "image": {
-          "description": "URL of main image (500x500)",
-          "type": "string",
-          "example": "https://assets.practable.io/images/penduino-500x500.png"
+        "descriptions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "long": {
-          "description": "280 char additional description of the interface in plain text",
-          "type": "string"
+        "display_guides": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "name": {
-          "description": "Display name",
-          "type": "string",
-          "example": "Penduino (basic)"
+        "streams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "short": {
-          "description": "140 char max description of the interface in plain text",
-          "type": "string",
-          "example": "Interface to the penduino electromagnetic pendulum with variable drive, active braking, short and open circuit loading."
+        "ui_sets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "thumb": {
-          "description": "URL of thumbnail image (150x150)",
-          "type": "string",
-          "example": "https://assets.practable.io/images/penduino-150x150.png"
+        "uis": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "type": {
-          "description": "Type of resource being described, e.g. pool, activity, userinterface",
-          "type": "string",
-          "example": "userinterface"
+        "windows": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         }
-      },
-      "example": {
-        "further": "https://static.practable.io/descriptions/pools/penduino/index.html",
-        "image": "https://assets.practable.io/images/pools/penduino/image.png",
-        "long": "Some longer description",
-        "name": "Penduino (basic)",
-        "short": "Penduino electromagnetic pendulums with pulsed drive and braking",
-        "thumb": "https://assets.practable.io/images/pools/penduino/thumb.png",
-        "type": "pool"
       }
     },
-    "DisplayGuide": {
+    "ManifestDiff": {
+      "description": "Entities that a candidate manifest adds, changes or removes compared to the current manifest, and the existing bookings that would be affected if it replaced the current manifest",
       "type": "object",
-      "required": [
-        "book_ahead",
-        "duration",
-        "label",
-        "max_slots"
-      ],
+      "title": "manifest diff",
       "properties": {
-        "book_ahead": {
-          "type": "string"
+        "added": {
+          "$ref": "#/definitions/ManifestEntities"
         },
-        "duration": {
-          "type": "string"
+        "bookings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BookingImpact"
+          }
         },
-        "label": {
-          "description": "what to display in the tab heading for these slots",
-          "type": "string"
+        "changed": {
+          "$ref": "#/definitions/ManifestEntities"
         },
-        "max_slots": {
-          "type": "integer"
+        "removed": {
+          "$ref": "#/definitions/ManifestEntities"
         }
       }
     },
-    "Error": {
+    "ManifestEntities": {
+      "description": "Names of the entities of each kind in a manifest",
       "type": "object",
-      "required": [
-        "code",
-        "message"
-      ],
+      "title": "manifest entities",
       "properties": {
-        "code": {
-          "type": "string"
+        "blackouts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "ErrorList": {
-      "description": "use when there is one or more errors that may need returning, e.g. manifest check issues",
-      "type": "object",
-      "required": [
-        "code",
-        "message",
-        "errors"
-      ],
-      "properties": {
-        "code": {
-          "type": "string"
+        "descriptions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
-        "errors": {
+        "display_guides": {
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "x-omitempty": true
         },
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "Group": {
-      "description": "group as represented in manifest replace/export operations",
-      "type": "object",
-      "required": [
-        "description",
-        "policies"
-      ],
-      "properties": {
-        "description": {
-          "type": "string"
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         },
         "policies": {
           "type": "array",
           "items": {
             "type": "string"
-          }
-        }
-      }
-    },
-    "GroupDescribed": {
-      "description": "group as reported when returning list of groups to user",
-      "type": "object",
-      "properties": {
-        "description": {
-          "type": "object",
-          "$ref": "#/definitions/Description"
-        }
-      }
-    },
-    "GroupDescribedWithPolicies": {
-      "description": "group description with full details of policies included",
-      "type": "object",
-      "properties": {
-        "description": {
-          "type": "object",
-          "$ref": "#/definitions/Description"
+          },
+          "x-omitempty": true
         },
-        "policies": {
-          "$ref": "#/definitions/PoliciesDescribed"
+        "pools": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "slots": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "streams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "ui_sets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "uis": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "windows": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
         }
       }
     },
-    "GroupsDescribed": {
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/GroupDescribed"
-      }
-    },
-    "Interval": {
+    "ManifestPatch": {
+      "description": "Changes to individual entities of the manifest. The entities in set are added, or replace existing entities of the same kind with the same name, and then the entities named in delete are removed.",
       "type": "object",
+      "title": "manifest patch",
       "properties": {
-        "end": {
-          "type": "string",
-          "format": "date-time"
+        "delete": {
+          "$ref": "#/definitions/ManifestDeletions"
         },
-        "start": {
-          "type": "string",
-          "format": "date-time"
+        "set": {
+          "$ref": "#/definitions/ManifestSet"
         }
       }
     },
-    "Intervals": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Interval"
-      }
-    },
-    "Manifest": {
-      "description": "Represents resources that can be booked",
+    "ManifestSet": {
+      "description": "Entities to add to the manifest, or to replace existing entities of the same kind with the same name",
       "type": "object",
-      "title": "manifest",
-      "required": [
-        "descriptions",
-        "policies",
-        "resources",
-        "slots",
-        "streams",
-        "uis",
-        "ui_sets",
-        "windows"
-      ],
+      "title": "manifest set",
       "properties": {
+        "blackouts": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Blackout"
+          }
+        },
         "descriptions": {
           "type": "object",
           "additionalProperties": {
@@ -4447,6 +7617,12 @@ func init() {
             "$ref": "#/definitions/Policy"
           }
         },
+        "pools": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/Pool"
+          }
+        },
         "resources": {
           "type": "object",
           "additionalProperties": {
@@ -4521,6 +7697,56 @@ func init() {
         }
       }
     },
+    "ManifestVersion": {
+      "description": "A manifest that was applied, and when and by whom it was applied",
+      "type": "object",
+      "title": "manifest version",
+      "properties": {
+        "applied": {
+          "description": "when the manifest was applied",
+          "type": "string",
+          "format": "date-time"
+        },
+        "author": {
+          "description": "subject of the token used to apply the manifest",
+          "type": "string"
+        },
+        "manifest": {
+          "$ref": "#/definitions/Manifest"
+        },
+        "version": {
+          "description": "number of the version, starting at one and increasing by one each time a manifest is applied",
+          "type": "integer"
+        }
+      }
+    },
+    "ManifestVersionInfo": {
+      "description": "When a version of the manifest was applied, and by whom",
+      "type": "object",
+      "title": "manifest version info",
+      "properties": {
+        "applied": {
+          "description": "when the manifest was applied",
+          "type": "string",
+          "format": "date-time"
+        },
+        "author": {
+          "description": "subject of the token used to apply the manifest",
+          "type": "string"
+        },
+        "version": {
+          "description": "number of the version, starting at one and increasing by one each time a manifest is applied",
+          "type": "integer"
+        }
+      }
+    },
+    "ManifestVersions": {
+      "description": "list of the retained versions of the manifest, oldest first",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ManifestVersionInfo"
+      }
+    },
     "PoliciesDescribed": {
       "type": "object",
       "additionalProperties": {
@@ -4538,6 +7764,9 @@ func init() {
         "allow_start_in_past_within": {
           "type": "string"
         },
+        "auto_book_waitlist": {
+          "type": "boolean"
+        },
         "book_ahead": {
           "type": "string"
         },
@@ -4550,12 +7779,18 @@ func init() {
             "type": "string"
           }
         },
+        "duration_step": {
+          "type": "string"
+        },
         "enforce_allow_start_in_past": {
           "type": "boolean"
         },
         "enforce_book_ahead": {
           "type": "boolean"
         },
+        "enforce_duration_step": {
+          "type": "boolean"
+        },
         "enforce_grace_period": {
           "type": "boolean"
         },
@@ -4568,12 +7803,18 @@ func init() {
         "enforce_max_usage": {
           "type": "boolean"
         },
+        "enforce_max_usage_per_period": {
+          "type": "boolean"
+        },
         "enforce_min_duration": {
           "type": "boolean"
         },
         "enforce_next_available": {
           "type": "boolean"
         },
+        "enforce_start_alignment": {
+          "type": "boolean"
+        },
         "enforce_starts_within": {
           "type": "boolean"
         },
@@ -4595,20 +7836,43 @@ func init() {
         "max_usage": {
           "type": "string"
         },
+        "max_usage_per_period": {
+          "description": "Usage allowed in each usage period, e.g. 2h, if enforced",
+          "type": "string"
+        },
         "min_duration": {
           "type": "string"
         },
         "next_available": {
           "type": "string"
         },
+        "priority": {
+          "description": "Bookings under this policy displace conflicting bookings under policies of lower priority (default 0)",
+          "type": "integer"
+        },
         "slots": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
+        "start_alignment": {
+          "type": "string"
+        },
         "starts_within": {
           "type": "string"
+        },
+        "usage_period": {
+          "description": "Period over which max_usage_per_period applies, one of daily, weekly (starting Monday, UTC) or termly",
+          "type": "string"
+        },
+        "usage_terms": {
+          "description": "Terms for a termly usage period. Bookings starting outside all terms are not limited per period",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          },
+          "x-omitempty": true
         }
       }
     },
@@ -4622,6 +7886,9 @@ func init() {
         "allow_start_in_past_within": {
           "type": "string"
         },
+        "auto_book_waitlist": {
+          "type": "boolean"
+        },
         "book_ahead": {
           "type": "string"
         },
@@ -4634,12 +7901,18 @@ func init() {
             "$ref": "#/definitions/DisplayGuide"
           }
         },
+        "duration_step": {
+          "type": "string"
+        },
         "enforce_allow_start_in_past": {
           "type": "boolean"
         },
         "enforce_book_ahead": {
           "type": "boolean"
         },
+        "enforce_duration_step": {
+          "type": "boolean"
+        },
         "enforce_grace_period": {
           "type": "boolean"
         },
@@ -4652,12 +7925,18 @@ func init() {
         "enforce_max_usage": {
           "type": "boolean"
         },
+        "enforce_max_usage_per_period": {
+          "type": "boolean"
+        },
         "enforce_min_duration": {
           "type": "boolean"
         },
         "enforce_next_available": {
           "type": "boolean"
         },
+        "enforce_start_alignment": {
+          "type": "boolean"
+        },
         "enforce_starts_within": {
           "type": "boolean"
         },
@@ -4679,20 +7958,43 @@ func init() {
         "max_usage": {
           "type": "string"
         },
+        "max_usage_per_period": {
+          "description": "Usage allowed in each usage period, e.g. 2h, if enforced",
+          "type": "string"
+        },
         "min_duration": {
           "type": "string"
         },
         "next_available": {
           "type": "string"
         },
+        "priority": {
+          "description": "Bookings under this policy displace conflicting bookings under policies of lower priority (default 0)",
+          "type": "integer"
+        },
         "slots": {
           "type": "object",
           "additionalProperties": {
             "$ref": "#/definitions/SlotDescribed"
           }
         },
+        "start_alignment": {
+          "type": "string"
+        },
         "starts_within": {
           "type": "string"
+        },
+        "usage_period": {
+          "description": "Period over which max_usage_per_period applies, one of daily, weekly (starting Monday, UTC) or termly",
+          "type": "string"
+        },
+        "usage_terms": {
+          "description": "Terms for a termly usage period. Bookings starting outside all terms are not limited per period",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          },
+          "x-omitempty": true
         }
       }
     },
@@ -4707,14 +8009,194 @@ func init() {
         "current_bookings": {
           "type": "integer"
         },
+        "groups": {
+          "description": "Status of each of the user's groups that include the policy and limit bookings or usage",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/GroupStatus"
+          }
+        },
         "old_bookings": {
           "type": "integer"
         },
+        "period_end": {
+          "description": "End of the current usage period, if the policy limits usage per period",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "period_remaining": {
+          "description": "Usage remaining in the current usage period",
+          "type": "string"
+        },
+        "period_start": {
+          "description": "Start of the current usage period, if the policy limits usage per period",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "period_usage": {
+          "description": "Usage in the current usage period",
+          "type": "string"
+        },
         "usage": {
           "type": "string"
         }
       }
     },
+    "Pool": {
+      "description": "Set of interchangeable resources, so that bookings on a resource that goes offline can be moved to another resource in the pool",
+      "type": "object",
+      "title": "pool",
+      "required": [
+        "description",
+        "resources"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "ReconcileReport": {
+      "description": "What was done to each existing booking affected by a new manifest",
+      "type": "object",
+      "title": "reconcile report",
+      "properties": {
+        "bookings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReconciledBooking"
+          }
+        },
+        "mode": {
+          "description": "what was done with affected bookings, one of keep, cancel or move",
+          "type": "string"
+        }
+      }
+    },
+    "ReconciledBooking": {
+      "description": "An existing booking affected by a new manifest, and what was done to it",
+      "type": "object",
+      "title": "reconciled booking",
+      "required": [
+        "action",
+        "booking",
+        "reasons",
+        "slot",
+        "when"
+      ],
+      "properties": {
+        "action": {
+          "description": "what was done to the booking, one of kept, cancelled or moved",
+          "type": "string"
+        },
+        "booking": {
+          "description": "name of the booking",
+          "type": "string"
+        },
+        "new_slot": {
+          "description": "name of the slot that the booking was moved to",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy under which the booking was made",
+          "type": "string"
+        },
+        "reasons": {
+          "description": "why the booking was affected, e.g. its slot was removed, or it is outside the periods allowed by its window",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "slot": {
+          "description": "name of the slot that was booked",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who made the booking",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
+    "Recurrence": {
+      "description": "A rule for repeating a booking, similar to an iCalendar RRULE. Occurrences start at the same time of day as the first booking, in the time zone (UTC if not given), every interval days or weeks, until count occurrences have been generated, or the next one would start after until. Occurrences on the same date as any of the except times are skipped, but still count towards the count.",
+      "type": "object",
+      "title": "recurrence",
+      "required": [
+        "frequency"
+      ],
+      "properties": {
+        "count": {
+          "description": "number of occurrences, including any that are skipped",
+          "type": "integer"
+        },
+        "except": {
+          "description": "dates on which occurrences are skipped, e.g. for holidays",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "date-time"
+          }
+        },
+        "frequency": {
+          "description": "how often the booking repeats, either daily or weekly",
+          "type": "string",
+          "example": "weekly"
+        },
+        "interval": {
+          "description": "number of days or weeks between occurrences (default 1)",
+          "type": "integer"
+        },
+        "time_zone": {
+          "description": "IANA time zone in which occurrences keep the time of day of the first booking, across changes to daylight saving time (default UTC)",
+          "type": "string",
+          "example": "Europe/London"
+        },
+        "until": {
+          "description": "latest time that an occurrence may start",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "RecurringBooking": {
+      "description": "A request for a booking of a slot for a user, that is repeated according to the recurrence. Either all the occurrences are booked, or none are.",
+      "type": "object",
+      "title": "recurring booking",
+      "required": [
+        "recurrence",
+        "slot",
+        "user",
+        "when"
+      ],
+      "properties": {
+        "recurrence": {
+          "$ref": "#/definitions/Recurrence"
+        },
+        "slot": {
+          "description": "name of the slot to book",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user to book for",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
     "Resource": {
       "type": "object",
       "required": [
@@ -4723,6 +8205,10 @@ func init() {
         "topic_stub"
       ],
       "properties": {
+        "buffer": {
+          "description": "Turnaround time that must be left free before and after each booking, e.g. 5m",
+          "type": "string"
+        },
         "config_url": {
           "type": "string",
           "format": "url"
@@ -4795,6 +8281,27 @@ func init() {
         }
       }
     },
+    "SlotAvailability": {
+      "description": "The availability of one of the slots in a policy.",
+      "type": "object",
+      "required": [
+        "slot",
+        "available"
+      ],
+      "properties": {
+        "available": {
+          "description": "the intervals for which the slot is available",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Interval"
+          }
+        },
+        "slot": {
+          "description": "name of the slot",
+          "type": "string"
+        }
+      }
+    },
     "SlotDescribed": {
       "type": "object",
       "required": [
@@ -4810,6 +8317,13 @@ func init() {
         }
       }
     },
+    "SlotsAvailability": {
+      "description": "list of the availability of the slots in a policy",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SlotAvailability"
+      }
+    },
     "StoreStatusAdmin": {
       "type": "object",
       "required": [
@@ -5016,11 +8530,61 @@ func init() {
         "$ref": "#/definitions/User"
       }
     },
-    "Window": {
+    "WaitlistEntries": {
+      "description": "list of waitlist entries",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/WaitlistEntry"
+      }
+    },
+    "WaitlistEntry": {
+      "description": "A waitlist entry represents a user's interest in booking a slot for an interval, in case time is freed, e.g. by a cancellation. Freed time that is offered to the user is held for them, so no-one else can book it, until the offer expires.",
       "type": "object",
+      "title": "waitlist entry",
       "required": [
-        "allowed"
+        "name",
+        "slot",
+        "user",
+        "when"
       ],
+      "properties": {
+        "joined_at": {
+          "description": "time the user joined the waitlist",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "description": "unique name of the waitlist entry",
+          "type": "string"
+        },
+        "offer_expires_at": {
+          "description": "time the offered time stops being held for the user",
+          "type": "string",
+          "format": "date-time"
+        },
+        "offered": {
+          "$ref": "#/definitions/Interval"
+        },
+        "offered_at": {
+          "description": "time the freed time was offered to the user",
+          "type": "string",
+          "format": "date-time"
+        },
+        "slot": {
+          "description": "name of the slot that the user is waiting for",
+          "type": "string"
+        },
+        "user": {
+          "description": "name of the user who is waiting",
+          "type": "string"
+        },
+        "when": {
+          "$ref": "#/definitions/Interval"
+        }
+      }
+    },
+    "Window": {
+      "type": "object",
       "properties": {
         "allowed": {
           "type": "array",
@@ -5028,11 +8592,69 @@ func init() {
             "$ref": "#/definitions/Interval"
           }
         },
+        "allowed_rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WindowRule"
+          },
+          "x-omitempty": true
+        },
+        "blackouts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "denied": {
           "type": "array",
           "items": {
             "$ref": "#/definitions/Interval"
           }
+        },
+        "denied_rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WindowRule"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "WindowRule": {
+      "description": "Recurring period between the start and end times of day (15:04) in the time zone (UTC if not given), on each of the days of the week given (every day if none are given), from the from date until the until date inclusive",
+      "type": "object",
+      "title": "window rule",
+      "required": [
+        "end",
+        "from",
+        "start",
+        "until"
+      ],
+      "properties": {
+        "days": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "end": {
+          "type": "string"
+        },
+        "from": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "start": {
+          "type": "string"
+        },
+        "time_zone": {
+          "type": "string"
+        },
+        "until": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     }
@@ -77,9 +77,18 @@ func NewServeAPI(spec *loads.Document) *ServeAPI {
 		UsersGetAvailabilityHandler: users.GetAvailabilityHandlerFunc(func(params users.GetAvailabilityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.GetAvailability has not yet been implemented")
 		}),
+		UsersGetAvailabilityAnySlotHandler: users.GetAvailabilityAnySlotHandlerFunc(func(params users.GetAvailabilityAnySlotParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetAvailabilityAnySlot has not yet been implemented")
+		}),
+		UsersGetAvailabilityForSlotsHandler: users.GetAvailabilityForSlotsHandlerFunc(func(params users.GetAvailabilityForSlotsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetAvailabilityForSlots has not yet been implemented")
+		}),
 		UsersGetBookingsForUserHandler: users.GetBookingsForUserHandlerFunc(func(params users.GetBookingsForUserParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.GetBookingsForUser has not yet been implemented")
 		}),
+		UsersGetBookingsCalendarForUserHandler: users.GetBookingsCalendarForUserHandlerFunc(func(params users.GetBookingsCalendarForUserParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetBookingsCalendarForUser has not yet been implemented")
+		}),
 		UsersGetDescriptionHandler: users.GetDescriptionHandlerFunc(func(params users.GetDescriptionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.GetDescription has not yet been implemented")
 		}),
@@ -101,21 +110,54 @@ func NewServeAPI(spec *loads.Document) *ServeAPI {
 		AdminGetResourceIsAvailableHandler: admin.GetResourceIsAvailableHandlerFunc(func(params admin.GetResourceIsAvailableParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.GetResourceIsAvailable has not yet been implemented")
 		}),
+		AdminGetBookingsCalendarForResourceHandler: admin.GetBookingsCalendarForResourceHandlerFunc(func(params admin.GetBookingsCalendarForResourceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetBookingsCalendarForResource has not yet been implemented")
+		}),
+		AdminGetManifestVersionHandler: admin.GetManifestVersionHandlerFunc(func(params admin.GetManifestVersionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetManifestVersion has not yet been implemented")
+		}),
+		AdminGetManifestVersionsHandler: admin.GetManifestVersionsHandlerFunc(func(params admin.GetManifestVersionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetManifestVersions has not yet been implemented")
+		}),
 		AdminGetResourcesHandler: admin.GetResourcesHandlerFunc(func(params admin.GetResourcesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.GetResources has not yet been implemented")
 		}),
 		AdminGetSlotIsAvailableHandler: admin.GetSlotIsAvailableHandlerFunc(func(params admin.GetSlotIsAvailableParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.GetSlotIsAvailable has not yet been implemented")
 		}),
+		UsersGetWaitlistForUserHandler: users.GetWaitlistForUserHandlerFunc(func(params users.GetWaitlistForUserParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.GetWaitlistForUser has not yet been implemented")
+		}),
+		UsersJoinWaitlistHandler: users.JoinWaitlistHandlerFunc(func(params users.JoinWaitlistParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.JoinWaitlist has not yet been implemented")
+		}),
+		UsersLeaveWaitlistHandler: users.LeaveWaitlistHandlerFunc(func(params users.LeaveWaitlistParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.LeaveWaitlist has not yet been implemented")
+		}),
 		UsersMakeBookingHandler: users.MakeBookingHandlerFunc(func(params users.MakeBookingParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.MakeBooking has not yet been implemented")
 		}),
+		UsersMakeBookingAnySlotHandler: users.MakeBookingAnySlotHandlerFunc(func(params users.MakeBookingAnySlotParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.MakeBookingAnySlot has not yet been implemented")
+		}),
+		AdminMakeRecurringBookingHandler: admin.MakeRecurringBookingHandlerFunc(func(params admin.MakeRecurringBookingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.MakeRecurringBooking has not yet been implemented")
+		}),
 		AdminReplaceBookingsHandler: admin.ReplaceBookingsHandlerFunc(func(params admin.ReplaceBookingsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.ReplaceBookings has not yet been implemented")
 		}),
 		AdminReplaceManifestHandler: admin.ReplaceManifestHandlerFunc(func(params admin.ReplaceManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.ReplaceManifest has not yet been implemented")
 		}),
+		AdminPatchManifestHandler: admin.PatchManifestHandlerFunc(func(params admin.PatchManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.PatchManifest has not yet been implemented")
+		}),
+		AdminDiffManifestHandler: admin.DiffManifestHandlerFunc(func(params admin.DiffManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.DiffManifest has not yet been implemented")
+		}),
+		AdminReconcileManifestHandler: admin.ReconcileManifestHandlerFunc(func(params admin.ReconcileManifestParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.ReconcileManifest has not yet been implemented")
+		}),
 		AdminReplaceOldBookingsHandler: admin.ReplaceOldBookingsHandlerFunc(func(params admin.ReplaceOldBookingsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.ReplaceOldBookings has not yet been implemented")
 		}),
@@ -125,6 +167,12 @@ func NewServeAPI(spec *loads.Document) *ServeAPI {
 		AdminSetSlotIsAvailableHandler: admin.SetSlotIsAvailableHandlerFunc(func(params admin.SetSlotIsAvailableParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation admin.SetSlotIsAvailable has not yet been implemented")
 		}),
+		UsersResizeBookingHandler: users.ResizeBookingHandlerFunc(func(params users.ResizeBookingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.ResizeBooking has not yet been implemented")
+		}),
+		UsersSwapBookingHandler: users.SwapBookingHandlerFunc(func(params users.SwapBookingParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation users.SwapBooking has not yet been implemented")
+		}),
 		UsersUniqueNameHandler: users.UniqueNameHandlerFunc(func(params users.UniqueNameParams) middleware.Responder {
 			return middleware.NotImplemented("operation users.UniqueName has not yet been implemented")
 		}),
@@ -213,8 +261,14 @@ type ServeAPI struct {
 	UsersGetActivityHandler users.GetActivityHandler
 	// UsersGetAvailabilityHandler sets the operation handler for the get availability operation
 	UsersGetAvailabilityHandler users.GetAvailabilityHandler
+	// UsersGetAvailabilityAnySlotHandler sets the operation handler for the get availability any slot operation
+	UsersGetAvailabilityAnySlotHandler users.GetAvailabilityAnySlotHandler
+	// UsersGetAvailabilityForSlotsHandler sets the operation handler for the get availability for slots operation
+	UsersGetAvailabilityForSlotsHandler users.GetAvailabilityForSlotsHandler
 	// UsersGetBookingsForUserHandler sets the operation handler for the get bookings for user operation
 	UsersGetBookingsForUserHandler users.GetBookingsForUserHandler
+	// UsersGetBookingsCalendarForUserHandler sets the operation handler for the get bookings calendar for user operation
+	UsersGetBookingsCalendarForUserHandler users.GetBookingsCalendarForUserHandler
 	// UsersGetDescriptionHandler sets the operation handler for the get description operation
 	UsersGetDescriptionHandler users.GetDescriptionHandler
 	// UsersGetGroupHandler sets the operation handler for the get group operation
@@ -229,22 +283,48 @@ type ServeAPI struct {
 	UsersGetPolicyStatusForUserHandler users.GetPolicyStatusForUserHandler
 	// AdminGetResourceIsAvailableHandler sets the operation handler for the get resource is available operation
 	AdminGetResourceIsAvailableHandler admin.GetResourceIsAvailableHandler
+	// AdminGetBookingsCalendarForResourceHandler sets the operation handler for the get bookings calendar for resource operation
+	AdminGetBookingsCalendarForResourceHandler admin.GetBookingsCalendarForResourceHandler
+	// AdminGetManifestVersionHandler sets the operation handler for the get manifest version operation
+	AdminGetManifestVersionHandler admin.GetManifestVersionHandler
+	// AdminGetManifestVersionsHandler sets the operation handler for the get manifest versions operation
+	AdminGetManifestVersionsHandler admin.GetManifestVersionsHandler
 	// AdminGetResourcesHandler sets the operation handler for the get resources operation
 	AdminGetResourcesHandler admin.GetResourcesHandler
 	// AdminGetSlotIsAvailableHandler sets the operation handler for the get slot is available operation
 	AdminGetSlotIsAvailableHandler admin.GetSlotIsAvailableHandler
+	// UsersGetWaitlistForUserHandler sets the operation handler for the get waitlist for user operation
+	UsersGetWaitlistForUserHandler users.GetWaitlistForUserHandler
+	// UsersJoinWaitlistHandler sets the operation handler for the join waitlist operation
+	UsersJoinWaitlistHandler users.JoinWaitlistHandler
+	// UsersLeaveWaitlistHandler sets the operation handler for the leave waitlist operation
+	UsersLeaveWaitlistHandler users.LeaveWaitlistHandler
 	// UsersMakeBookingHandler sets the operation handler for the make booking operation
 	UsersMakeBookingHandler users.MakeBookingHandler
+	// UsersMakeBookingAnySlotHandler sets the operation handler for the make booking any slot operation
+	UsersMakeBookingAnySlotHandler users.MakeBookingAnySlotHandler
+	// AdminMakeRecurringBookingHandler sets the operation handler for the make recurring booking operation
+	AdminMakeRecurringBookingHandler admin.MakeRecurringBookingHandler
 	// AdminReplaceBookingsHandler sets the operation handler for the replace bookings operation
 	AdminReplaceBookingsHandler admin.ReplaceBookingsHandler
 	// AdminReplaceManifestHandler sets the operation handler for the replace manifest operation
 	AdminReplaceManifestHandler admin.ReplaceManifestHandler
+	// AdminPatchManifestHandler sets the operation handler for the patch manifest operation
+	AdminPatchManifestHandler admin.PatchManifestHandler
+	// AdminDiffManifestHandler sets the operation handler for the diff manifest operation
+	AdminDiffManifestHandler admin.DiffManifestHandler
+	// AdminReconcileManifestHandler sets the operation handler for the reconcile manifest operation
+	AdminReconcileManifestHandler admin.ReconcileManifestHandler
 	// AdminReplaceOldBookingsHandler sets the operation handler for the replace old bookings operation
 	AdminReplaceOldBookingsHandler admin.ReplaceOldBookingsHandler
 	// AdminSetResourceIsAvailableHandler sets the operation handler for the set resource is available operation
 	AdminSetResourceIsAvailableHandler admin.SetResourceIsAvailableHandler
 	// AdminSetSlotIsAvailableHandler sets the operation handler for the set slot is available operation
 	AdminSetSlotIsAvailableHandler admin.SetSlotIsAvailableHandler
+	// UsersResizeBookingHandler sets the operation handler for the resize booking operation
+	UsersResizeBookingHandler users.ResizeBookingHandler
+	// UsersSwapBookingHandler sets the operation handler for the swap booking operation
+	UsersSwapBookingHandler users.SwapBookingHandler
 	// UsersUniqueNameHandler sets the operation handler for the unique name operation
 	UsersUniqueNameHandler users.UniqueNameHandler
 	// AdminGetStoreStatusAdminHandler sets the operation handler for the get store status admin operation
@@ -370,9 +450,18 @@ func (o *ServeAPI) Validate() error {
 	if o.UsersGetAvailabilityHandler == nil {
 		unregistered = append(unregistered, "users.GetAvailabilityHandler")
 	}
+	if o.UsersGetAvailabilityAnySlotHandler == nil {
+		unregistered = append(unregistered, "users.GetAvailabilityAnySlotHandler")
+	}
+	if o.UsersGetAvailabilityForSlotsHandler == nil {
+		unregistered = append(unregistered, "users.GetAvailabilityForSlotsHandler")
+	}
 	if o.UsersGetBookingsForUserHandler == nil {
 		unregistered = append(unregistered, "users.GetBookingsForUserHandler")
 	}
+	if o.UsersGetBookingsCalendarForUserHandler == nil {
+		unregistered = append(unregistered, "users.GetBookingsCalendarForUserHandler")
+	}
 	if o.UsersGetDescriptionHandler == nil {
 		unregistered = append(unregistered, "users.GetDescriptionHandler")
 	}
@@ -394,21 +483,54 @@ func (o *ServeAPI) Validate() error {
 	if o.AdminGetResourceIsAvailableHandler == nil {
 		unregistered = append(unregistered, "admin.GetResourceIsAvailableHandler")
 	}
+	if o.AdminGetBookingsCalendarForResourceHandler == nil {
+		unregistered = append(unregistered, "admin.GetBookingsCalendarForResourceHandler")
+	}
+	if o.AdminGetManifestVersionHandler == nil {
+		unregistered = append(unregistered, "admin.GetManifestVersionHandler")
+	}
+	if o.AdminGetManifestVersionsHandler == nil {
+		unregistered = append(unregistered, "admin.GetManifestVersionsHandler")
+	}
 	if o.AdminGetResourcesHandler == nil {
 		unregistered = append(unregistered, "admin.GetResourcesHandler")
 	}
 	if o.AdminGetSlotIsAvailableHandler == nil {
 		unregistered = append(unregistered, "admin.GetSlotIsAvailableHandler")
 	}
+	if o.UsersGetWaitlistForUserHandler == nil {
+		unregistered = append(unregistered, "users.GetWaitlistForUserHandler")
+	}
+	if o.UsersJoinWaitlistHandler == nil {
+		unregistered = append(unregistered, "users.JoinWaitlistHandler")
+	}
+	if o.UsersLeaveWaitlistHandler == nil {
+		unregistered = append(unregistered, "users.LeaveWaitlistHandler")
+	}
 	if o.UsersMakeBookingHandler == nil {
 		unregistered = append(unregistered, "users.MakeBookingHandler")
 	}
+	if o.UsersMakeBookingAnySlotHandler == nil {
+		unregistered = append(unregistered, "users.MakeBookingAnySlotHandler")
+	}
+	if o.AdminMakeRecurringBookingHandler == nil {
+		unregistered = append(unregistered, "admin.MakeRecurringBookingHandler")
+	}
 	if o.AdminReplaceBookingsHandler == nil {
 		unregistered = append(unregistered, "admin.ReplaceBookingsHandler")
 	}
 	if o.AdminReplaceManifestHandler == nil {
 		unregistered = append(unregistered, "admin.ReplaceManifestHandler")
 	}
+	if o.AdminPatchManifestHandler == nil {
+		unregistered = append(unregistered, "admin.PatchManifestHandler")
+	}
+	if o.AdminDiffManifestHandler == nil {
+		unregistered = append(unregistered, "admin.DiffManifestHandler")
+	}
+	if o.AdminReconcileManifestHandler == nil {
+		unregistered = append(unregistered, "admin.ReconcileManifestHandler")
+	}
 	if o.AdminReplaceOldBookingsHandler == nil {
 		unregistered = append(unregistered, "admin.ReplaceOldBookingsHandler")
 	}
@@ -418,6 +540,12 @@ func (o *ServeAPI) Validate() error {
 	if o.AdminSetSlotIsAvailableHandler == nil {
 		unregistered = append(unregistered, "admin.SetSlotIsAvailableHandler")
 	}
+	if o.UsersResizeBookingHandler == nil {
+		unregistered = append(unregistered, "users.ResizeBookingHandler")
+	}
+	if o.UsersSwapBookingHandler == nil {
+		unregistered = append(unregistered, "users.SwapBookingHandler")
+	}
 	if o.UsersUniqueNameHandler == nil {
 		unregistered = append(unregistered, "users.UniqueNameHandler")
 	}
@@ -574,10 +702,22 @@ func (o *ServeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policies/{policy_name}/availability"] = users.NewGetAvailabilityAnySlot(o.context, o.UsersGetAvailabilityAnySlotHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/policies/{policy_name}/slots/availability"] = users.NewGetAvailabilityForSlots(o.context, o.UsersGetAvailabilityForSlotsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users/{user_name}/bookings"] = users.NewGetBookingsForUser(o.context, o.UsersGetBookingsForUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/{user_name}/bookings.ics"] = users.NewGetBookingsCalendarForUser(o.context, o.UsersGetBookingsCalendarForUserHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/descriptions/{description_name}"] = users.NewGetDescription(o.context, o.UsersGetDescriptionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -606,15 +746,47 @@ func (o *ServeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/resources/{resource_name}/bookings.ics"] = admin.NewGetBookingsCalendarForResource(o.context, o.AdminGetBookingsCalendarForResourceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/manifest/versions/{version}"] = admin.NewGetManifestVersion(o.context, o.AdminGetManifestVersionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/manifest/versions"] = admin.NewGetManifestVersions(o.context, o.AdminGetManifestVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/resources"] = admin.NewGetResources(o.context, o.AdminGetResourcesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/slots/{slot_name}"] = admin.NewGetSlotIsAvailable(o.context, o.AdminGetSlotIsAvailableHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{user_name}/waitlist"] = users.NewGetWaitlistForUser(o.context, o.UsersGetWaitlistForUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{user_name}/waitlist"] = users.NewJoinWaitlist(o.context, o.UsersJoinWaitlistHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/{user_name}/waitlist/{entry_name}"] = users.NewLeaveWaitlist(o.context, o.UsersLeaveWaitlistHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/slots/{slot_name}"] = users.NewMakeBooking(o.context, o.UsersMakeBookingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policies/{policy_name}/bookings"] = users.NewMakeBookingAnySlot(o.context, o.UsersMakeBookingAnySlotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/bookings/recurring"] = admin.NewMakeRecurringBooking(o.context, o.AdminMakeRecurringBookingHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -623,6 +795,18 @@ func (o *ServeAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/admin/manifest"] = admin.NewReplaceManifest(o.context, o.AdminReplaceManifestHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/admin/manifest"] = admin.NewPatchManifest(o.context, o.AdminPatchManifestHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/manifest/diff"] = admin.NewDiffManifest(o.context, o.AdminDiffManifestHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/manifest/reconcile"] = admin.NewReconcileManifest(o.context, o.AdminReconcileManifestHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -635,6 +819,14 @@ func (o *ServeAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/admin/slots/{slot_name}"] = admin.NewSetSlotIsAvailable(o.context, o.AdminSetSlotIsAvailableHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/users/{user_name}/bookings/{booking_name}"] = users.NewResizeBooking(o.context, o.UsersResizeBookingHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/users/{user_name}/bookings/{booking_name}/swap"] = users.NewSwapBooking(o.context, o.UsersSwapBookingHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewGetAvailabilityParams creates a new GetAvailabilityParams object
@@ -32,6 +33,10 @@ type GetAvailabilityParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*
+	  In: query
+	*/
+	From *strfmt.DateTime
 	/*
 	  In: query
 	*/
@@ -45,6 +50,10 @@ type GetAvailabilityParams struct {
 	  In: path
 	*/
 	SlotName string
+	/*
+	  In: query
+	*/
+	To *strfmt.DateTime
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -58,6 +67,11 @@ func (o *GetAvailabilityParams) BindRequest(r *http.Request, route *middleware.M
 
 	qs := runtime.Values(r.URL.Query())
 
+	qFrom, qhkFrom, _ := qs.GetOK("from")
+	if err := o.bindFrom(qFrom, qhkFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -72,12 +86,54 @@ func (o *GetAvailabilityParams) BindRequest(r *http.Request, route *middleware.M
 	if err := o.bindSlotName(rSlotName, rhkSlotName, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qTo, qhkTo, _ := qs.GetOK("to")
+	if err := o.bindTo(qTo, qhkTo, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindFrom binds and validates parameter From from query.
+func (o *GetAvailabilityParams) bindFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("from", "query", "strfmt.DateTime", raw)
+	}
+	o.From = (value.(*strfmt.DateTime))
+
+	if err := o.validateFrom(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFrom carries on validations for parameter From
+func (o *GetAvailabilityParams) validateFrom(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("from", "query", "date-time", o.From.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *GetAvailabilityParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -137,3 +193,40 @@ func (o *GetAvailabilityParams) bindSlotName(rawData []string, hasKey bool, form
 
 	return nil
 }
+
+// bindTo binds and validates parameter To from query.
+func (o *GetAvailabilityParams) bindTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("to", "query", "strfmt.DateTime", raw)
+	}
+	o.To = (value.(*strfmt.DateTime))
+
+	if err := o.validateTo(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTo carries on validations for parameter To
+func (o *GetAvailabilityParams) validateTo(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("to", "query", "date-time", o.To.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
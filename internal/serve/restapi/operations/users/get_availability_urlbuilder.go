@@ -11,6 +11,7 @@ import (
 	golangswaggerpaths "path"
 	"strings"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
 
@@ -18,8 +19,10 @@ import (
 type GetAvailabilityURL struct {
 	SlotName string
 
+	From   *strfmt.DateTime
 	Limit  *int64
 	Offset *int64
+	To     *strfmt.DateTime
 
 	_basePath string
 	// avoid unkeyed usage
@@ -62,6 +65,14 @@ func (o *GetAvailabilityURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var fromQ string
+	if o.From != nil {
+		fromQ = o.From.String()
+	}
+	if fromQ != "" {
+		qs.Set("from", fromQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt64(*o.Limit)
@@ -78,6 +89,14 @@ func (o *GetAvailabilityURL) Build() (*url.URL, error) {
 		qs.Set("offset", offsetQ)
 	}
 
+	var toQ string
+	if o.To != nil {
+		toQ = o.To.String()
+	}
+	if toQ != "" {
+		qs.Set("to", toQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -6,6 +6,7 @@ import (
 	"flag"
 
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/practable/book/internal/config"
 	"github.com/practable/book/internal/serve/restapi"
 	"github.com/practable/book/internal/serve/restapi/operations"
@@ -44,6 +45,9 @@ func API(ctx context.Context, config config.ServerConfig, cancelOthers func()) {
 	// set the port this service will run on
 	server.Port = config.Port
 
+	// calendars are rendered to text by the handlers, so can be written by the text producer
+	api.RegisterProducer("text/calendar", runtime.TextProducer())
+
 	// set the Authorizer
 	api.BearerAuth = validateHeader(config.StoreSecret, config.Host)
 
@@ -51,6 +55,9 @@ func API(ctx context.Context, config config.ServerConfig, cancelOthers func()) {
 
 	// *** ADMIN *** //
 	api.AdminCheckManifestHandler = admin.CheckManifestHandlerFunc(checkManifestHandler(config))
+	api.AdminGetBookingsCalendarForResourceHandler = admin.GetBookingsCalendarForResourceHandlerFunc(getBookingsCalendarForResourceHandler(config))
+	api.AdminGetManifestVersionHandler = admin.GetManifestVersionHandlerFunc(getManifestVersionHandler(config))
+	api.AdminGetManifestVersionsHandler = admin.GetManifestVersionsHandlerFunc(getManifestVersionsHandler(config))
 	api.AdminGetResourceIsAvailableHandler = admin.GetResourceIsAvailableHandlerFunc(getResourceIsAvailableHandler(config))
 	api.AdminGetStoreStatusAdminHandler = admin.GetStoreStatusAdminHandlerFunc(getStoreStatusAdminHandler(config))
 	api.AdminGetSlotIsAvailableHandler = admin.GetSlotIsAvailableHandlerFunc(getSlotIsAvailableHandler(config))
@@ -59,6 +66,10 @@ func API(ctx context.Context, config config.ServerConfig, cancelOthers func()) {
 	api.AdminExportManifestHandler = admin.ExportManifestHandlerFunc(exportManifestHandler(config))
 	api.AdminExportOldBookingsHandler = admin.ExportOldBookingsHandlerFunc(exportOldBookingsHandler(config))
 	api.AdminExportUsersHandler = admin.ExportUsersHandlerFunc(exportUsersHandler(config))
+	api.AdminMakeRecurringBookingHandler = admin.MakeRecurringBookingHandlerFunc(makeRecurringBookingHandler(config))
+	api.AdminDiffManifestHandler = admin.DiffManifestHandlerFunc(diffManifestHandler(config))
+	api.AdminPatchManifestHandler = admin.PatchManifestHandlerFunc(patchManifestHandler(config))
+	api.AdminReconcileManifestHandler = admin.ReconcileManifestHandlerFunc(reconcileManifestHandler(config))
 	api.AdminReplaceBookingsHandler = admin.ReplaceBookingsHandlerFunc(replaceBookingsHandler(config))
 	api.AdminReplaceManifestHandler = admin.ReplaceManifestHandlerFunc(replaceManifestHandler(config))
 	api.AdminReplaceOldBookingsHandler = admin.ReplaceOldBookingsHandlerFunc(replaceOldBookingsHandler(config))
@@ -72,6 +83,9 @@ func API(ctx context.Context, config config.ServerConfig, cancelOthers func()) {
 	api.UsersGetAccessTokenHandler = users.GetAccessTokenHandlerFunc(getAccessTokenHandler(config))
 	api.UsersGetActivityHandler = users.GetActivityHandlerFunc(getActivityHandler(config))
 	api.UsersGetAvailabilityHandler = users.GetAvailabilityHandlerFunc(getAvailabilityHandler(config))
+	api.UsersGetAvailabilityAnySlotHandler = users.GetAvailabilityAnySlotHandlerFunc(getAvailabilityAnySlotHandler(config))
+	api.UsersGetAvailabilityForSlotsHandler = users.GetAvailabilityForSlotsHandlerFunc(getAvailabilityForSlotsHandler(config))
+	api.UsersGetBookingsCalendarForUserHandler = users.GetBookingsCalendarForUserHandlerFunc(getBookingsCalendarForUserHandler(config))
 	api.UsersGetBookingsForUserHandler = users.GetBookingsForUserHandlerFunc(getBookingsForUserHandler(config))
 	api.UsersGetDescriptionHandler = users.GetDescriptionHandlerFunc(getDescriptionHandler(config))
 	api.UsersGetGroupHandler = users.GetGroupHandlerFunc(getGroupHandler(config))
@@ -80,7 +94,13 @@ func API(ctx context.Context, config config.ServerConfig, cancelOthers func()) {
 	api.UsersGetPolicyHandler = users.GetPolicyHandlerFunc(getPolicyHandler(config))
 	api.UsersGetPolicyStatusForUserHandler = users.GetPolicyStatusForUserHandlerFunc(getPolicyStatusForUserHandler(config))
 	api.UsersGetStoreStatusUserHandler = users.GetStoreStatusUserHandlerFunc(getStoreStatusUserHandler(config))
+	api.UsersGetWaitlistForUserHandler = users.GetWaitlistForUserHandlerFunc(getWaitlistForUserHandler(config))
+	api.UsersJoinWaitlistHandler = users.JoinWaitlistHandlerFunc(joinWaitlistHandler(config))
+	api.UsersLeaveWaitlistHandler = users.LeaveWaitlistHandlerFunc(leaveWaitlistHandler(config))
 	api.UsersMakeBookingHandler = users.MakeBookingHandlerFunc(makeBookingHandler(config))
+	api.UsersMakeBookingAnySlotHandler = users.MakeBookingAnySlotHandlerFunc(makeBookingAnySlotHandler(config))
+	api.UsersResizeBookingHandler = users.ResizeBookingHandlerFunc(resizeBookingHandler(config))
+	api.UsersSwapBookingHandler = users.SwapBookingHandlerFunc(swapBookingHandler(config))
 	api.UsersUniqueNameHandler = users.UniqueNameHandlerFunc(uniqueNameHandler(config))
 
 	c := make(chan struct{})
@@ -2,6 +2,7 @@ package serve
 
 import (
 	"encoding/json"
+	"sort"
 	"strconv"
 	"time"
 
@@ -43,6 +44,48 @@ func convertStoreStatusUserToModel(s store.StoreStatusUser) (models.StoreStatusU
 
 }
 
+// convertBookingToModel converts from internal to API type
+func convertBookingToModel(v store.Booking) models.Booking {
+	return models.Booking{
+		Cancelled:   v.Cancelled,
+		Name:        gog.Ptr(v.Name),
+		Policy:      gog.Ptr(v.Policy),
+		Resource:    v.Resource,
+		Slot:        gog.Ptr(v.Slot),
+		Started:     v.Started,
+		Unfulfilled: v.Unfulfilled,
+		User:        gog.Ptr(v.User),
+		When: gog.Ptr(models.Interval{
+			Start: strfmt.DateTime(v.When.Start),
+			End:   strfmt.DateTime(v.When.End),
+		}),
+	}
+}
+
+// convertWaitlistEntryToModel converts from internal to API type
+// The offer is omitted if no time has been offered
+func convertWaitlistEntryToModel(v store.WaitlistEntry) models.WaitlistEntry {
+	w := models.WaitlistEntry{
+		JoinedAt: strfmt.DateTime(v.JoinedAt),
+		Name:     gog.Ptr(v.Name),
+		Slot:     gog.Ptr(v.Slot),
+		User:     gog.Ptr(v.User),
+		When: gog.Ptr(models.Interval{
+			Start: strfmt.DateTime(v.When.Start),
+			End:   strfmt.DateTime(v.When.End),
+		}),
+	}
+	if !v.OfferedAt.IsZero() {
+		w.Offered = gog.Ptr(models.Interval{
+			Start: strfmt.DateTime(v.Offered.Start),
+			End:   strfmt.DateTime(v.Offered.End),
+		})
+		w.OfferedAt = strfmt.DateTime(v.OfferedAt)
+		w.OfferExpiresAt = strfmt.DateTime(v.OfferExpiresAt)
+	}
+	return w
+}
+
 // dt "github.com/practable/book/internal/datetime
 // getAccessTokenHandler
 func getAccessTokenHandler(config config.ServerConfig) func(users.GetAccessTokenParams) middleware.Responder {
@@ -254,8 +297,19 @@ func getGroupHandler(config config.ServerConfig) func(users.GetGroupParams, inte
 				slm[sln] = sld
 			}
 
+			ut := []*models.Interval{}
+
+			for _, si := range p.UsageTerms {
+				mi := models.Interval{
+					Start: strfmt.DateTime(si.Start),
+					End:   strfmt.DateTime(si.End),
+				}
+				ut = append(ut, &mi)
+			}
+
 			pm := models.PolicyDescribed{
 				AllowStartInPastWithin: store.HumaniseDuration(p.AllowStartInPastWithin),
+				AutoBookWaitlist:       p.AutoBookWaitlist,
 				BookAhead:              store.HumaniseDuration(p.BookAhead),
 				Description: gog.Ptr(models.Description{
 					Name:    &descr.Name,
@@ -266,23 +320,32 @@ func getGroupHandler(config config.ServerConfig) func(users.GetGroupParams, inte
 					Thumb:   descr.Thumb,
 					Image:   descr.Image,
 				}),
-				DisplayGuides:           dgm,
-				EnforceAllowStartInPast: p.EnforceAllowStartInPast,
-				EnforceBookAhead:        p.EnforceBookAhead,
-				EnforceMaxBookings:      p.EnforceMaxBookings,
-				EnforceMaxDuration:      p.EnforceMaxDuration,
-				EnforceMinDuration:      p.EnforceMinDuration,
-				EnforceMaxUsage:         p.EnforceMaxUsage,
-				EnforceNextAvailable:    p.EnforceNextAvailable,
-				EnforceStartsWithin:     p.EnforceStartsWithin,
-				EnforceUnlimitedUsers:   p.EnforceUnlimitedUsers,
-				MaxBookings:             p.MaxBookings,
-				MaxDuration:             store.HumaniseDuration(p.MaxDuration),
-				MinDuration:             store.HumaniseDuration(p.MinDuration),
-				MaxUsage:                store.HumaniseDuration(p.MaxUsage),
-				NextAvailable:           store.HumaniseDuration(p.NextAvailable),
-				Slots:                   slm,
-				StartsWithin:            store.HumaniseDuration(p.StartsWithin),
+				DisplayGuides:            dgm,
+				DurationStep:             store.HumaniseDuration(p.DurationStep),
+				EnforceAllowStartInPast:  p.EnforceAllowStartInPast,
+				EnforceBookAhead:         p.EnforceBookAhead,
+				EnforceDurationStep:      p.EnforceDurationStep,
+				EnforceMaxBookings:       p.EnforceMaxBookings,
+				EnforceMaxDuration:       p.EnforceMaxDuration,
+				EnforceMinDuration:       p.EnforceMinDuration,
+				EnforceMaxUsage:          p.EnforceMaxUsage,
+				EnforceMaxUsagePerPeriod: p.EnforceMaxUsagePerPeriod,
+				EnforceNextAvailable:     p.EnforceNextAvailable,
+				EnforceStartAlignment:    p.EnforceStartAlignment,
+				EnforceStartsWithin:      p.EnforceStartsWithin,
+				EnforceUnlimitedUsers:    p.EnforceUnlimitedUsers,
+				MaxBookings:              p.MaxBookings,
+				MaxDuration:              store.HumaniseDuration(p.MaxDuration),
+				MinDuration:              store.HumaniseDuration(p.MinDuration),
+				MaxUsage:                 store.HumaniseDuration(p.MaxUsage),
+				MaxUsagePerPeriod:        store.HumaniseDuration(p.MaxUsagePerPeriod),
+				NextAvailable:            store.HumaniseDuration(p.NextAvailable),
+				Priority:                 p.Priority,
+				Slots:                    slm,
+				StartAlignment:           store.HumaniseDuration(p.StartAlignment),
+				StartsWithin:             store.HumaniseDuration(p.StartsWithin),
+				UsagePeriod:              p.UsagePeriod,
+				UsageTerms:               ut,
 			}
 
 			pms[pn] = pm
@@ -378,8 +441,19 @@ func getPolicyHandler(config config.ServerConfig) func(users.GetPolicyParams, in
 			slm[sln] = sld
 		}
 
+		ut := []*models.Interval{}
+
+		for _, si := range p.UsageTerms {
+			mi := models.Interval{
+				Start: strfmt.DateTime(si.Start),
+				End:   strfmt.DateTime(si.End),
+			}
+			ut = append(ut, &mi)
+		}
+
 		pm := models.PolicyDescribed{
 			AllowStartInPastWithin: store.HumaniseDuration(p.AllowStartInPastWithin),
+			AutoBookWaitlist:       p.AutoBookWaitlist,
 			BookAhead:              store.HumaniseDuration(p.BookAhead),
 			Description: gog.Ptr(models.Description{
 				Name:    &descr.Name,
@@ -390,23 +464,32 @@ func getPolicyHandler(config config.ServerConfig) func(users.GetPolicyParams, in
 				Thumb:   descr.Thumb,
 				Image:   descr.Image,
 			}),
-			DisplayGuides:           dgm,
-			EnforceAllowStartInPast: p.EnforceAllowStartInPast,
-			EnforceBookAhead:        p.EnforceBookAhead,
-			EnforceMaxBookings:      p.EnforceMaxBookings,
-			EnforceMaxDuration:      p.EnforceMaxDuration,
-			EnforceMinDuration:      p.EnforceMinDuration,
-			EnforceMaxUsage:         p.EnforceMaxUsage,
-			EnforceNextAvailable:    p.EnforceNextAvailable,
-			EnforceStartsWithin:     p.EnforceStartsWithin,
-			EnforceUnlimitedUsers:   p.EnforceUnlimitedUsers,
-			MaxBookings:             p.MaxBookings,
-			MaxDuration:             store.HumaniseDuration(p.MaxDuration),
-			MinDuration:             store.HumaniseDuration(p.MinDuration),
-			MaxUsage:                store.HumaniseDuration(p.MaxUsage),
-			NextAvailable:           store.HumaniseDuration(p.NextAvailable),
-			Slots:                   slm,
-			StartsWithin:            store.HumaniseDuration(p.StartsWithin),
+			DisplayGuides:            dgm,
+			DurationStep:             store.HumaniseDuration(p.DurationStep),
+			EnforceAllowStartInPast:  p.EnforceAllowStartInPast,
+			EnforceBookAhead:         p.EnforceBookAhead,
+			EnforceDurationStep:      p.EnforceDurationStep,
+			EnforceMaxBookings:       p.EnforceMaxBookings,
+			EnforceMaxDuration:       p.EnforceMaxDuration,
+			EnforceMinDuration:       p.EnforceMinDuration,
+			EnforceMaxUsage:          p.EnforceMaxUsage,
+			EnforceMaxUsagePerPeriod: p.EnforceMaxUsagePerPeriod,
+			EnforceNextAvailable:     p.EnforceNextAvailable,
+			EnforceStartAlignment:    p.EnforceStartAlignment,
+			EnforceStartsWithin:      p.EnforceStartsWithin,
+			EnforceUnlimitedUsers:    p.EnforceUnlimitedUsers,
+			MaxBookings:              p.MaxBookings,
+			MaxDuration:              store.HumaniseDuration(p.MaxDuration),
+			MinDuration:              store.HumaniseDuration(p.MinDuration),
+			MaxUsage:                 store.HumaniseDuration(p.MaxUsage),
+			MaxUsagePerPeriod:        store.HumaniseDuration(p.MaxUsagePerPeriod),
+			NextAvailable:            store.HumaniseDuration(p.NextAvailable),
+			Priority:                 p.Priority,
+			Slots:                    slm,
+			StartAlignment:           store.HumaniseDuration(p.StartAlignment),
+			StartsWithin:             store.HumaniseDuration(p.StartsWithin),
+			UsagePeriod:              p.UsagePeriod,
+			UsageTerms:               ut,
 		}
 
 		return users.NewGetPolicyOK().WithPayload(&pm)
@@ -438,7 +521,39 @@ func getAvailabilityHandler(config config.ServerConfig) func(users.GetAvailabili
 			return users.NewGetAvailabilityNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
 		}
 
-		when, err := config.Store.GetAvailability(params.SlotName)
+		// from and to are optional, and narrow the window set by the policy, e.g. to the week shown by a UI
+		var from, to time.Time
+
+		if params.From != nil {
+			from = time.Time(*params.From)
+		}
+		if params.To != nil {
+			to = time.Time(*params.To)
+		}
+
+		if !from.IsZero() && !to.IsZero() && to.Before(from) {
+			c := "404"
+			m := "?to=" + params.To.String() + " is before ?from=" + params.From.String()
+			return users.NewGetAvailabilityNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		var limit, offset int
+
+		if params.Limit != nil {
+			limit = int(*(params.Limit))
+		}
+		if params.Offset != nil {
+			offset = int(*(params.Offset))
+		}
+
+		// only the intervals up to the end of the requested page are needed from the store
+		var last int
+
+		if limit > 0 {
+			last = offset + limit
+		}
+
+		when, err := config.Store.GetAvailabilityBetween(params.SlotName, from, to, last)
 
 		if err != nil {
 			c := "500"
@@ -453,6 +568,71 @@ func getAvailabilityHandler(config config.ServerConfig) func(users.GetAvailabili
 		// Users should refresh their results from 0 offset on a regular-ish basis if they wish to avoid this.
 		// Or request more results in a single page.
 
+		if offset > len(when) {
+			offset = len(when)
+		}
+
+		page := when[offset:]
+
+		if limit > 0 && limit < len(page) {
+			page = page[:limit]
+		}
+
+		pm := []*models.Interval{}
+
+		for _, v := range page {
+			p := models.Interval{
+				Start: strfmt.DateTime(v.Start),
+				End:   strfmt.DateTime(v.End),
+			}
+			pm = append(pm, &p)
+		}
+
+		return users.NewGetAvailabilityOK().WithPayload(pm)
+
+	}
+}
+
+// getAvailabilityAnySlotHandler
+func getAvailabilityAnySlotHandler(config config.ServerConfig) func(users.GetAvailabilityAnySlotParams, interface{}) middleware.Responder {
+	return func(params users.GetAvailabilityAnySlotParams, principal interface{}) middleware.Responder {
+
+		isAdmin, _, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewGetAvailabilityAnySlotUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewGetAvailabilityAnySlotUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.PolicyName == "" {
+			c := "404"
+			m := "no policy_name in path"
+			return users.NewGetAvailabilityAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		var uiSet string
+
+		if params.UISet != nil {
+			uiSet = *params.UISet
+		}
+
+		when, err := config.Store.GetAvailabilityAnySlot(params.PolicyName, uiSet)
+
+		if err != nil {
+			c := "500"
+			m := "error getting availability from store: " + err.Error()
+			return users.NewGetAvailabilityAnySlotInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// handle pagination in the same way as for the availability of a single slot
+
 		var limit, offset int
 
 		if params.Limit != nil {
@@ -462,9 +642,13 @@ func getAvailabilityHandler(config config.ServerConfig) func(users.GetAvailabili
 			offset = int(*(params.Offset))
 		}
 
+		if offset > len(when) {
+			offset = len(when)
+		}
+
 		page := when[offset:]
 
-		if limit > 0 {
+		if limit > 0 && limit < len(page) {
 			page = page[:limit]
 		}
 
@@ -478,7 +662,100 @@ func getAvailabilityHandler(config config.ServerConfig) func(users.GetAvailabili
 			pm = append(pm, &p)
 		}
 
-		return users.NewGetAvailabilityOK().WithPayload(pm)
+		return users.NewGetAvailabilityAnySlotOK().WithPayload(pm)
+
+	}
+}
+
+// getAvailabilityForSlotsHandler
+func getAvailabilityForSlotsHandler(config config.ServerConfig) func(users.GetAvailabilityForSlotsParams, interface{}) middleware.Responder {
+	return func(params users.GetAvailabilityForSlotsParams, principal interface{}) middleware.Responder {
+
+		isAdmin, _, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewGetAvailabilityForSlotsUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewGetAvailabilityForSlotsUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.PolicyName == "" {
+			c := "404"
+			m := "no policy_name in path"
+			return users.NewGetAvailabilityForSlotsNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		var uiSet string
+
+		if params.UISet != nil {
+			uiSet = *params.UISet
+		}
+
+		var from, to time.Time
+
+		if params.From != nil {
+			from = time.Time(*params.From)
+		}
+		if params.To != nil {
+			to = time.Time(*params.To)
+		}
+
+		if !from.IsZero() && !to.IsZero() && to.Before(from) {
+			c := "404"
+			m := "?to=" + params.To.String() + " is before ?from=" + params.From.String()
+			return users.NewGetAvailabilityForSlotsNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		var limit int
+
+		if params.Limit != nil {
+			limit = int(*(params.Limit))
+		}
+
+		sa, err := config.Store.GetAvailabilityForSlots(params.PolicyName, uiSet, from, to, limit)
+
+		if err != nil {
+			c := "500"
+			m := "error getting availability from store: " + err.Error()
+			return users.NewGetAvailabilityForSlotsInternalServerError().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// sort by slot name so that the order is stable between requests
+		slots := []string{}
+
+		for k := range sa {
+			slots = append(slots, k)
+		}
+
+		sort.Strings(slots)
+
+		pm := models.SlotsAvailability{}
+
+		for _, k := range slots {
+
+			a := []*models.Interval{}
+
+			for _, v := range sa[k] {
+				a = append(a, &models.Interval{
+					Start: strfmt.DateTime(v.Start),
+					End:   strfmt.DateTime(v.End),
+				})
+			}
+
+			slot := k
+			pm = append(pm, &models.SlotAvailability{
+				Available: a,
+				Slot:      &slot,
+			})
+		}
+
+		return users.NewGetAvailabilityForSlotsOK().WithPayload(pm)
 
 	}
 }
@@ -584,6 +861,104 @@ func makeBookingHandler(config config.ServerConfig) func(users.MakeBookingParams
 	}
 }
 
+// makeBookingAnySlotHandler
+func makeBookingAnySlotHandler(config config.ServerConfig) func(users.MakeBookingAnySlotParams, interface{}) middleware.Responder {
+	return func(params users.MakeBookingAnySlotParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			log.WithFields(log.Fields{"token": principal, "error": err.Error()}).Debug("make booking any slot unauthorized")
+			return users.NewMakeBookingAnySlotUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewMakeBookingAnySlotUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in query"
+			log.Debug("make booking any slot no user_name in query")
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token (admins can book on behalf of users, so ignore if
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in query does not match subject in token"
+			return users.NewMakeBookingAnySlotUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.PolicyName == "" {
+			c := "404"
+			m := "no policy_name in path"
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// Check that the from, to exist and that they parse as future dates
+		var emptyDT strfmt.DateTime
+
+		if params.From == emptyDT {
+			c := "404"
+			m := `no query parameter: from`
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+		if params.To == emptyDT {
+			c := "404"
+			m := `no query parameter: to`
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		from, err := dt.Parse(params.From.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?from=" + params.From.String() + " as RFC3339 datetime"
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		to, err := dt.Parse(params.To.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?to=" + params.To.String() + " as RFC3339 datetime"
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		when := interval.Interval{
+			Start: from,
+			End:   to,
+		}
+
+		log.Debug(when)
+
+		var uiSet string
+
+		if params.UISet != nil {
+			uiSet = *params.UISet
+		}
+
+		b, err := config.Store.MakeBookingAnySlot(params.PolicyName, uiSet, params.UserName, when)
+
+		if err != nil {
+			c := "404"
+			m := "could not make the booking because " + err.Error()
+			return users.NewMakeBookingAnySlotNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// the booking is returned so that the user can see which slot was booked
+		bm := convertBookingToModel(b)
+
+		return users.NewMakeBookingAnySlotOK().WithPayload(&bm)
+
+	}
+}
+
 // getStoreStatusUserHandler
 func getStoreStatusUserHandler(config config.ServerConfig) func(users.GetStoreStatusUserParams, interface{}) middleware.Responder {
 	return func(params users.GetStoreStatusUserParams, principal interface{}) middleware.Responder {
@@ -606,6 +981,49 @@ func getStoreStatusUserHandler(config config.ServerConfig) func(users.GetStoreSt
 	}
 }
 
+// getBookingsCalendarForUserHandler
+func getBookingsCalendarForUserHandler(config config.ServerConfig) func(users.GetBookingsCalendarForUserParams, interface{}) middleware.Responder {
+	return func(params users.GetBookingsCalendarForUserParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewGetBookingsCalendarForUserUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewGetBookingsCalendarForUserUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in query"
+			return users.NewGetBookingsCalendarForUserNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token, unless admin (admin can check on behalf of users)
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in path " + params.UserName + " does not match subject " + claims.Subject + " in token"
+			return users.NewGetBookingsCalendarForUserUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		cal, err := config.Store.GetCalendarFor(params.UserName)
+
+		if err != nil {
+			c := "404"
+			m := "error retrieving bookings for user " + params.UserName + ": " + err.Error()
+			return users.NewGetBookingsCalendarForUserNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		return users.NewGetBookingsCalendarForUserOK().WithPayload(cal.Render(config.Store.Now()))
+	}
+}
+
 // getBookingsForHandler
 func getBookingsForUserHandler(config config.ServerConfig) func(users.GetBookingsForUserParams, interface{}) middleware.Responder {
 	return func(params users.GetBookingsForUserParams, principal interface{}) middleware.Responder {
@@ -653,6 +1071,7 @@ func getBookingsForUserHandler(config config.ServerConfig) func(users.GetBooking
 				Cancelled:   v.Cancelled,
 				Name:        gog.Ptr(v.Name),
 				Policy:      gog.Ptr(v.Policy),
+				Resource:    v.Resource,
 				Slot:        gog.Ptr(v.Slot),
 				Started:     v.Started,
 				Unfulfilled: v.Unfulfilled,
@@ -735,8 +1154,354 @@ func cancelBookingHandler(config config.ServerConfig) func(users.CancelBookingPa
 	}
 }
 
-// getActivityHandler
-func getActivityHandler(config config.ServerConfig) func(users.GetActivityParams, interface{}) middleware.Responder {
+// swapBookingHandler
+func swapBookingHandler(config config.ServerConfig) func(users.SwapBookingParams, interface{}) middleware.Responder {
+	return func(params users.SwapBookingParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewSwapBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewSwapBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in path"
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token, if not admin (admin can swap on behalf of users)
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in path does not match subject in token"
+			return users.NewSwapBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.BookingName == "" {
+			c := "404"
+			m := "no booking_name in path"
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.SlotName == "" {
+			c := "404"
+			m := "no query parameter: slot_name"
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		from, err := dt.Parse(params.From.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?from=" + params.From.String() + " as RFC3339 datetime"
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		to, err := dt.Parse(params.To.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?to=" + params.To.String() + " as RFC3339 datetime"
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		b, err := config.Store.GetBooking(params.BookingName)
+
+		// users can only swap their own bookings
+		if err != nil || b.User != params.UserName {
+			c := "404"
+			m := "not found"
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		cancelledBy := claims.Subject
+		if isAdmin && claims.Subject == "" {
+			cancelledBy = "admin"
+		}
+
+		when := interval.Interval{
+			Start: from,
+			End:   to,
+		}
+
+		v, err := config.Store.SwapBooking(b, params.SlotName, when, cancelledBy)
+
+		if err != nil {
+			c := "404"
+			m := "could not swap the booking because " + err.Error()
+			return users.NewSwapBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		nb := convertBookingToModel(v)
+
+		return users.NewSwapBookingOK().WithPayload(&nb)
+	}
+}
+
+// resizeBookingHandler
+func resizeBookingHandler(config config.ServerConfig) func(users.ResizeBookingParams, interface{}) middleware.Responder {
+	return func(params users.ResizeBookingParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewResizeBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewResizeBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in path"
+			return users.NewResizeBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token, if not admin (admin can resize on behalf of users)
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in path does not match subject in token"
+			return users.NewResizeBookingUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.BookingName == "" {
+			c := "404"
+			m := "no booking_name in path"
+			return users.NewResizeBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		from, err := dt.Parse(params.From.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?from=" + params.From.String() + " as RFC3339 datetime"
+			return users.NewResizeBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		to, err := dt.Parse(params.To.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?to=" + params.To.String() + " as RFC3339 datetime"
+			return users.NewResizeBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		b, err := config.Store.GetBooking(params.BookingName)
+
+		// users can only resize their own bookings
+		if err != nil || b.User != params.UserName {
+			c := "404"
+			m := "not found"
+			return users.NewResizeBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		when := interval.Interval{
+			Start: from,
+			End:   to,
+		}
+
+		v, err := config.Store.ResizeBooking(b, when)
+
+		if err != nil {
+			c := "404"
+			m := "could not resize the booking because " + err.Error()
+			return users.NewResizeBookingNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		rb := convertBookingToModel(v)
+
+		return users.NewResizeBookingOK().WithPayload(&rb)
+	}
+}
+
+// getWaitlistForUserHandler
+func getWaitlistForUserHandler(config config.ServerConfig) func(users.GetWaitlistForUserParams, interface{}) middleware.Responder {
+	return func(params users.GetWaitlistForUserParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewGetWaitlistForUserUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewGetWaitlistForUserUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in path"
+			return users.NewGetWaitlistForUserNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token, unless admin (admin can check on behalf of users)
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in path " + params.UserName + " does not match subject " + claims.Subject + " in token"
+			return users.NewGetWaitlistForUserUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		ws, err := config.Store.GetWaitlistFor(params.UserName)
+
+		if err != nil {
+			c := "404"
+			m := "error retrieving waitlist for user " + params.UserName + ": " + err.Error()
+			return users.NewGetWaitlistForUserNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		wm := models.WaitlistEntries{}
+
+		for _, v := range ws {
+			w := convertWaitlistEntryToModel(v)
+			wm = append(wm, &w)
+		}
+
+		return users.NewGetWaitlistForUserOK().WithPayload(wm)
+	}
+}
+
+// joinWaitlistHandler
+func joinWaitlistHandler(config config.ServerConfig) func(users.JoinWaitlistParams, interface{}) middleware.Responder {
+	return func(params users.JoinWaitlistParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewJoinWaitlistUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewJoinWaitlistUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in path"
+			return users.NewJoinWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token, if not admin (admin can wait on behalf of users)
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in path does not match subject in token"
+			return users.NewJoinWaitlistUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.SlotName == "" {
+			c := "404"
+			m := "no query parameter: slot_name"
+			return users.NewJoinWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		from, err := dt.Parse(params.From.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?from=" + params.From.String() + " as RFC3339 datetime"
+			return users.NewJoinWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		to, err := dt.Parse(params.To.String())
+
+		if err != nil {
+			c := "404"
+			m := "could not parse ?to=" + params.To.String() + " as RFC3339 datetime"
+			return users.NewJoinWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		when := interval.Interval{
+			Start: from,
+			End:   to,
+		}
+
+		v, err := config.Store.JoinWaitlist(params.SlotName, params.UserName, when)
+
+		if err != nil {
+			c := "404"
+			m := "could not join the waitlist because " + err.Error()
+			return users.NewJoinWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		w := convertWaitlistEntryToModel(v)
+
+		return users.NewJoinWaitlistOK().WithPayload(&w)
+	}
+}
+
+// leaveWaitlistHandler
+func leaveWaitlistHandler(config config.ServerConfig) func(users.LeaveWaitlistParams, interface{}) middleware.Responder {
+	return func(params users.LeaveWaitlistParams, principal interface{}) middleware.Responder {
+
+		isAdmin, claims, err := isAdminOrUser(principal)
+
+		if err != nil {
+			c := "401"
+			m := err.Error()
+			return users.NewLeaveWaitlistUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if config.Store.Locked && !isAdmin {
+			c := "401"
+			m := "store locked to users: " + config.Store.Message
+			return users.NewLeaveWaitlistUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.UserName == "" {
+			c := "404"
+			m := "no user_name in path"
+			return users.NewLeaveWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		// check username against token, if not admin (admin can remove entries on behalf of users)
+		if (!isAdmin) && (claims.Subject != params.UserName) {
+			c := "401"
+			m := "user_name in path does not match subject in token"
+			return users.NewLeaveWaitlistUnauthorized().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		if params.EntryName == "" {
+			c := "404"
+			m := "no entry_name in path"
+			return users.NewLeaveWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		err = config.Store.LeaveWaitlist(params.UserName, params.EntryName)
+
+		if err != nil {
+			c := "404"
+			m := err.Error()
+			return users.NewLeaveWaitlistNotFound().WithPayload(&models.Error{Code: &c, Message: &m})
+		}
+
+		log.WithFields(log.Fields{"user": params.UserName, "entry": params.EntryName}).Info("left waitlist")
+
+		return users.NewLeaveWaitlistNoContent()
+	}
+}
+
+// getActivityHandler
+func getActivityHandler(config config.ServerConfig) func(users.GetActivityParams, interface{}) middleware.Responder {
 	return func(params users.GetActivityParams, principal interface{}) middleware.Responder {
 
 		isAdmin, claims, err := isAdminOrUser(principal)
@@ -935,6 +1700,7 @@ func getOldBookingsForUserHandler(config config.ServerConfig) func(users.GetOldB
 				Cancelled:   v.Cancelled,
 				Name:        gog.Ptr(v.Name),
 				Policy:      gog.Ptr(v.Policy),
+				Resource:    v.Resource,
 				Slot:        gog.Ptr(v.Slot),
 				Started:     v.Started,
 				Unfulfilled: v.Unfulfilled,
@@ -1069,6 +1835,28 @@ func getPolicyStatusForUserHandler(config config.ServerConfig) func(users.GetPol
 			Usage:           gog.Ptr(ps.Usage.String()),
 		}
 
+		for k, v := range ps.Groups {
+			if pm.Groups == nil {
+				pm.Groups = make(map[string]models.GroupStatus)
+			}
+			pm.Groups[k] = models.GroupStatus{
+				BookingsRemaining:  gog.Ptr(v.BookingsRemaining),
+				CurrentBookings:    gog.Ptr(v.CurrentBookings),
+				EnforceMaxBookings: gog.Ptr(v.EnforceMaxBookings),
+				EnforceMaxUsage:    gog.Ptr(v.EnforceMaxUsage),
+				Usage:              gog.Ptr(v.Usage.String()),
+				UsageRemaining:     gog.Ptr(v.UsageRemaining.String()),
+			}
+		}
+
+		// only policies that limit usage per period have a current period
+		if !ps.PeriodStart.IsZero() {
+			pm.PeriodEnd = gog.Ptr(strfmt.DateTime(ps.PeriodEnd))
+			pm.PeriodRemaining = ps.PeriodRemaining.String()
+			pm.PeriodStart = gog.Ptr(strfmt.DateTime(ps.PeriodStart))
+			pm.PeriodUsage = ps.PeriodUsage.String()
+		}
+
 		return users.NewGetPolicyStatusForUserOK().WithPayload(&pm)
 	}
 }
@@ -2,17 +2,21 @@ package server
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/practable/book/internal/config"
+	"github.com/practable/book/internal/history"
 	"github.com/practable/book/internal/serve"
 	"github.com/practable/book/internal/store"
 	log "github.com/sirupsen/logrus"
 )
 
 type Server struct {
-	Config config.ServerConfig
-	Store  *store.Store
+	Config  config.ServerConfig
+	Store   *store.Store
+	history *history.History
 }
 
 // New Creates a new server, and provides a pointer to underlying store
@@ -29,6 +33,52 @@ func New(config config.ServerConfig) *Server {
 		st.WithGraceRebound(config.GraceRebound)
 	}
 
+	if config.ManifestVersions != 0 {
+		st.WithMaxManifestVersions(config.ManifestVersions)
+	}
+
+	if config.WaitlistOfferHold != time.Duration(0) {
+		st.WithWaitlistOfferHold(config.WaitlistOfferHold)
+	}
+
+	var h *history.History
+
+	if config.PersistDir != "" {
+
+		st.WithPersistDir(config.PersistDir)
+
+		// the journal must be opened before restoring, so that
+		// actions recorded since the last snapshot are replayed
+		err := os.MkdirAll(config.PersistDir, 0700)
+
+		if err != nil {
+			log.Errorf("failed to create persist directory %s because %s", config.PersistDir, err.Error())
+		} else {
+			fn := filepath.Join(config.PersistDir, store.JournalFile)
+			h, err = history.Open(fn)
+			if err != nil {
+				log.Errorf("failed to open journal %s because %s", fn, err.Error())
+			} else {
+				st.WithHistory(h)
+			}
+		}
+
+		if config.PersistEvery != time.Duration(0) {
+			st.WithPersistEvery(config.PersistEvery)
+		}
+
+		err, msgs := st.Restore()
+
+		if err != nil {
+			log.Errorf("failed to restore store from %s because %s", config.PersistDir, err.Error())
+			for _, m := range msgs {
+				log.Error(m)
+			}
+		} else {
+			log.Infof("restored store from %s", config.PersistDir)
+		}
+	}
+
 	if config.Now == nil {
 		config.Now = func() time.Time { return time.Now() }
 	}
@@ -45,8 +95,9 @@ func New(config config.ServerConfig) *Server {
 	config.Store = st
 
 	s := &Server{
-		Config: config,
-		Store:  st,
+		Config:  config,
+		Store:   st,
+		history: h,
 	}
 
 	return s
@@ -78,4 +129,18 @@ func (s *Server) Run(ctx context.Context) {
 
 	<-ctxStore.Done() //cannot use ctx.Done() because will leave hanging process when used with book/cmd where there is no cancellation of ctx
 
+	// take a final snapshot so that nothing is lost on a clean shutdown
+	err := s.Store.Persist()
+
+	if err != nil {
+		log.Errorf("failed to persist store on shutdown because %s", err.Error())
+	}
+
+	if s.history != nil {
+		err = s.history.Close()
+		if err != nil {
+			log.Errorf("failed to close journal on shutdown because %s", err.Error())
+		}
+	}
+
 }
@@ -11,6 +11,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -903,6 +904,299 @@ func TestManifestOK(t *testing.T) {
 	}
 }
 
+func TestDiffManifest(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	do := func(method, path string, body []byte) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest(method, cfg.Host+"/api/v1/admin/manifest"+path, bytes.NewReader(body))
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", satoken)
+		req.Header.Add("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body2, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body2))
+		}
+		return resp.StatusCode, body2
+	}
+
+	code, body := do("GET", "", nil)
+	assert.Equal(t, 200, code)
+
+	m := models.Manifest{}
+	err := json.Unmarshal(body, &m)
+	assert.NoError(t, err)
+
+	// the exported manifest makes no difference
+	code, body = do("POST", "/diff", body)
+	assert.Equal(t, 200, code)
+
+	d := models.ManifestDiff{}
+	err = json.Unmarshal(body, &d)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ManifestEntities{}, d.Removed)
+	assert.Empty(t, d.Bookings)
+
+	// remove a slot, and the reference to it in its policy
+	p := m.Policies["p-a"]
+	p.Slots = []string{}
+	for _, v := range m.Policies["p-a"].Slots {
+		if v != "sl-a" {
+			p.Slots = append(p.Slots, v)
+		}
+	}
+	m.Policies["p-a"] = p
+	delete(m.Slots, "sl-a")
+
+	mb, err := json.Marshal(m)
+	assert.NoError(t, err)
+
+	code, body = do("POST", "/diff", mb)
+	assert.Equal(t, 200, code)
+
+	d = models.ManifestDiff{}
+	err = json.Unmarshal(body, &d)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p-a"}, d.Changed.Policies)
+	assert.Equal(t, []string{"sl-a"}, d.Removed.Slots)
+
+	// the candidate manifest is checked
+	delete(m.Policies, "p-a")
+
+	mb, err = json.Marshal(m)
+	assert.NoError(t, err)
+
+	code, _ = do("POST", "/diff", mb)
+	assert.Equal(t, 409, code)
+
+	// nothing was changed
+	code, body = do("GET", "", nil)
+	assert.Equal(t, 200, code)
+
+	m = models.Manifest{}
+	err = json.Unmarshal(body, &m)
+	assert.NoError(t, err)
+	_, ok := m.Slots["sl-a"]
+	assert.True(t, ok)
+
+}
+
+func TestReconcileManifest(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	// user access token
+	sutoken, err := signedUserToken()
+	assert.NoError(t, err)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", cfg.Host+"/api/v1/users/someuser/groups/g-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	client = &http.Client{}
+	req, err = http.NewRequest("POST", cfg.Host+"/api/v1/slots/sl-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	q := req.URL.Query()
+	q.Add("user_name", "someuser")
+	q.Add("from", "2022-11-05T00:01:00Z")
+	q.Add("to", "2022-11-05T00:07:00Z")
+	req.URL.RawQuery = q.Encode()
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	do := func(method, path string, body []byte) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest(method, cfg.Host+"/api/v1/admin/manifest"+path, bytes.NewReader(body))
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", satoken)
+		req.Header.Add("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body2, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body2))
+		}
+		return resp.StatusCode, body2
+	}
+
+	code, body := do("GET", "", nil)
+	assert.Equal(t, 200, code)
+
+	m := models.Manifest{}
+	err = json.Unmarshal(body, &m)
+	assert.NoError(t, err)
+
+	// remove the booked slot, and the reference to it in its policy
+	p := m.Policies["p-b"]
+	p.Slots = []string{}
+	for _, v := range m.Policies["p-b"].Slots {
+		if v != "sl-b" {
+			p.Slots = append(p.Slots, v)
+		}
+	}
+	m.Policies["p-b"] = p
+	delete(m.Slots, "sl-b")
+
+	mb, err := json.Marshal(m)
+	assert.NoError(t, err)
+
+	// the mode must be known
+	code, _ = do("PUT", "/reconcile?mode=ignore", mb)
+	assert.Equal(t, 409, code)
+
+	code, body = do("PUT", "/reconcile?mode=cancel", mb)
+	assert.Equal(t, 200, code)
+
+	r := models.ReconcileReport{}
+	err = json.Unmarshal(body, &r)
+	assert.NoError(t, err)
+	assert.Equal(t, "cancel", r.Mode)
+	assert.Equal(t, 1, len(r.Bookings))
+	assert.Equal(t, "cancelled", *r.Bookings[0].Action)
+	assert.Equal(t, "sl-b", *r.Bookings[0].Slot)
+	assert.Equal(t, []string{"slot sl-b is removed"}, r.Bookings[0].Reasons)
+
+	// the manifest was replaced, and the booking cancelled
+	code, body = do("GET", "", nil)
+	assert.Equal(t, 200, code)
+
+	m = models.Manifest{}
+	err = json.Unmarshal(body, &m)
+	assert.NoError(t, err)
+	_, ok := m.Slots["sl-b"]
+	assert.False(t, ok)
+
+	bm := getBookings(t)
+	assert.Equal(t, 0, len(bm))
+
+}
+
+func TestManifestVersions(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+
+	do := func(method, path string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest(method, cfg.Host+"/api/v1/admin/manifest"+path, nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", satoken)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, body
+	}
+
+	code, body := do("GET", "/versions")
+	assert.Equal(t, 200, code)
+
+	vs := models.ManifestVersions{}
+	err := json.Unmarshal(body, &vs)
+	assert.NoError(t, err)
+
+	// the manifest just loaded is the current version
+	assert.True(t, len(vs) > 0)
+	v := vs[len(vs)-1]
+	assert.Equal(t, "someuser", v.Author)
+	assert.Equal(t, strfmt.DateTime(ct), v.Applied)
+
+	code, body = do("GET", "/versions/"+strconv.Itoa(int(v.Version)))
+	assert.Equal(t, 200, code)
+
+	mv := models.ManifestVersion{}
+	err = json.Unmarshal(body, &mv)
+	assert.NoError(t, err)
+	assert.Equal(t, v.Version, mv.Version)
+	assert.Equal(t, "someuser", mv.Author)
+
+	code, body = do("GET", "")
+	assert.Equal(t, 200, code)
+
+	m := models.Manifest{}
+	err = json.Unmarshal(body, &m)
+	assert.NoError(t, err)
+	assert.Equal(t, m, *mv.Manifest)
+
+	code, _ = do("GET", "/versions/0")
+	assert.Equal(t, 404, code)
+
+}
+
+func TestPatchManifest(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	do := func(method, body string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest(method, cfg.Host+"/api/v1/admin/manifest", bytes.NewReader([]byte(body)))
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", satoken)
+		req.Header.Add("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body2, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body2))
+		}
+		return resp.StatusCode, body2
+	}
+
+	code, body := do("PATCH", `{"delete":{"slots":["sl-x"]}}`)
+	assert.Equal(t, 409, code)
+
+	el := models.ErrorList{}
+	err := json.Unmarshal(body, &el)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cannot delete non-existent slot: sl-x"}, el.Errors)
+
+	code, _ = do("PATCH", `{"set":{"windows":{"w-c":{"allowed":[{"start":"2022-11-04T00:00:00Z","end":"2022-11-06T00:00:00Z"}]}}}}`)
+	assert.Equal(t, 200, code)
+
+	code, body = do("GET", "")
+	assert.Equal(t, 200, code)
+
+	m := models.Manifest{}
+	err = json.Unmarshal(body, &m)
+	assert.NoError(t, err)
+	_, ok := m.Windows["w-c"]
+	assert.True(t, ok)
+	_, ok = m.Windows["w-a"]
+	assert.True(t, ok)
+
+}
+
 func TestReplaceManifestWithClient(t *testing.T) {
 
 	var manifest cmodels.Manifest
@@ -1452,7 +1746,7 @@ func TestGetPolicy(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 200, resp.StatusCode) //should be ok!
 	body, err := ioutil.ReadAll(resp.Body)
-	expected := `{"allow_start_in_past_within":"0s","book_ahead":"1h0m0s","description":{"name":"policy-a","short":"a","type":"policy"},"display_guides":{"1mFor20m":{"book_ahead":"20m0s","duration":"1m0s","label":"1m","max_slots":15}},"enforce_book_ahead":true,"max_duration":"0s","max_usage":"0s","min_duration":"0s","next_available":"0s","slots":{"sl-a":{"description":{"name":"slot-a","short":"a","type":"slot"},"policy":"p-a"}},"starts_within":"0s"}` + "\n"
+	expected := `{"allow_start_in_past_within":"0s","book_ahead":"1h0m0s","description":{"name":"policy-a","short":"a","type":"policy"},"display_guides":{"1mFor20m":{"book_ahead":"20m0s","duration":"1m0s","label":"1m","max_slots":15}},"duration_step":"0s","enforce_book_ahead":true,"max_duration":"0s","max_usage":"0s","max_usage_per_period":"0s","min_duration":"0s","next_available":"0s","slots":{"sl-a":{"description":{"name":"slot-a","short":"a","type":"slot"},"policy":"p-a"}},"start_alignment":"0s","starts_within":"0s"}` + "\n"
 	assert.Equal(t, expected, string(body))
 	resp.Body.Close()
 
@@ -1604,6 +1898,82 @@ func TestGetAvailability(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestGetAvailabilityBetween(t *testing.T) {
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	// load some bookings to break up the future availability in discrete intervals
+	client := &http.Client{}
+	bodyReader := bytes.NewReader(bookings2JSON)
+	req, err := http.NewRequest("PUT", cfg.Host+"/api/v1/admin/bookings", bodyReader)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", satoken)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	sutoken, err := signedUserToken()
+	assert.NoError(t, err)
+
+	get := func(path string, query map[string]string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest("GET", cfg.Host+"/api/v1"+path, nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", sutoken)
+		q := req.URL.Query()
+		for k, v := range query {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, body
+	}
+
+	code, body := get("/slots/sl-b", map[string]string{"from": "2022-11-05T00:32:00Z", "to": "2022-11-05T01:02:00Z"})
+	assert.Equal(t, 200, code)
+	expected := `[{"end":"2022-11-05T00:34:59.999Z","start":"2022-11-05T00:32:00.000Z"},{"end":"2022-11-05T00:44:59.999Z","start":"2022-11-05T00:40:00.000Z"},{"end":"2022-11-05T00:54:59.999Z","start":"2022-11-05T00:50:00.000Z"},{"end":"2022-11-05T01:02:00.000Z","start":"2022-11-05T01:00:00.000Z"}]` + "\n"
+	assert.Equal(t, expected, string(body))
+
+	// pagination applies within the window
+	code, body = get("/slots/sl-b", map[string]string{"from": "2022-11-05T00:32:00Z", "to": "2022-11-05T01:02:00Z", "limit": "2", "offset": "1"})
+	assert.Equal(t, 200, code)
+	expected = `[{"end":"2022-11-05T00:44:59.999Z","start":"2022-11-05T00:40:00.000Z"},{"end":"2022-11-05T00:54:59.999Z","start":"2022-11-05T00:50:00.000Z"}]` + "\n"
+	assert.Equal(t, expected, string(body))
+
+	// an offset beyond the end gives an empty page
+	code, body = get("/slots/sl-b", map[string]string{"from": "2022-11-05T00:32:00Z", "to": "2022-11-05T01:02:00Z", "offset": "10"})
+	assert.Equal(t, 200, code)
+	assert.Equal(t, "[]\n", string(body))
+
+	code, _ = get("/slots/sl-b", map[string]string{"from": "2022-11-05T01:02:00Z", "to": "2022-11-05T00:32:00Z"})
+	assert.Equal(t, 404, code)
+
+	code, body = get("/policies/p-b/slots/availability", map[string]string{"to": "2022-11-05T00:20:00Z", "limit": "1"})
+	assert.Equal(t, 200, code)
+	expected = `[{"available":[{"end":"2022-11-05T00:09:59.999Z","start":"2022-11-05T00:00:00.000Z"}],"slot":"sl-b"}]` + "\n"
+	assert.Equal(t, expected, string(body))
+
+	var sa models.SlotsAvailability
+	err = json.Unmarshal(body, &sa)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(sa))
+
+	code, _ = get("/policies/p-unknown/slots/availability", map[string]string{})
+	assert.Equal(t, 500, code)
+
+}
+
 func TestMakeBooking(t *testing.T) {
 
 	// make sure our pre-prepared bookings are in the future
@@ -1672,6 +2042,228 @@ func TestMakeBooking(t *testing.T) {
 
 }
 
+func TestMakeBookingAnySlot(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	loadTestManifest(t)
+	removeAllBookings(t)
+
+	sutoken, err := signedUserToken()
+	assert.NoError(t, err)
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", cfg.Host+"/api/v1/users/someuser/groups/g-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	book := func(uiSet, from, to string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest("POST", cfg.Host+"/api/v1/policies/p-b/bookings", nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", sutoken)
+		q := req.URL.Query()
+		q.Add("user_name", "someuser")
+		if uiSet != "" {
+			q.Add("ui_set", uiSet)
+		}
+		q.Add("from", from)
+		q.Add("to", to)
+		req.URL.RawQuery = q.Encode()
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, body
+	}
+
+	// no slots in p-b use us-a
+	code, _ := book("us-a", "2022-11-05T00:01:00Z", "2022-11-05T00:07:00Z")
+	assert.Equal(t, 404, code)
+
+	code, body := book("us-b", "2022-11-05T00:01:00Z", "2022-11-05T00:07:00Z")
+	assert.Equal(t, 200, code)
+
+	b := models.Booking{}
+	err = json.Unmarshal(body, &b)
+	assert.NoError(t, err)
+	assert.Equal(t, "someuser", *b.User)
+	assert.Equal(t, "sl-b", *b.Slot)
+
+	// the only slot is now booked at that time
+	code, _ = book("", "2022-11-05T00:02:00Z", "2022-11-05T00:05:00Z")
+	assert.Equal(t, 404, code)
+
+	client = &http.Client{}
+	req, err = http.NewRequest("GET", cfg.Host+"/api/v1/policies/p-b/availability", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	body, err = ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	var a models.Intervals
+	err = json.Unmarshal(body, &a)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(a))
+	assert.Equal(t, "2022-11-05T00:00:59.999Z", a[0].End.String())
+	assert.Equal(t, "2022-11-05T00:07:00.000Z", a[1].Start.String())
+
+}
+
+func TestMakeRecurringBooking(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	recur := func(body string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest("POST", cfg.Host+"/api/v1/admin/bookings/recurring", bytes.NewReader([]byte(body)))
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", satoken)
+		req.Header.Add("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body2, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body2))
+		}
+		return resp.StatusCode, body2
+	}
+
+	code, _ := recur(`{"slot":"sl-x","user":"staff","when":{"start":"2022-11-05T00:10:00Z","end":"2022-11-05T00:15:00Z"},"recurrence":{"frequency":"daily","count":2}}`)
+	assert.Equal(t, 404, code)
+
+	// the second occurrence is beyond the book ahead limit of the policy, so neither is booked
+	code, body := recur(`{"slot":"sl-b","user":"staff","when":{"start":"2022-11-05T00:10:00Z","end":"2022-11-05T00:15:00Z"},"recurrence":{"frequency":"daily","count":2}}`)
+	assert.Equal(t, 409, code)
+
+	el := models.ErrorList{}
+	err := json.Unmarshal(body, &el)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(el.Errors))
+	assert.Equal(t, 0, len(getBookings(t)))
+
+	code, body = recur(`{"slot":"sl-b","user":"staff","when":{"start":"2022-11-05T00:10:00Z","end":"2022-11-05T00:15:00Z"},"recurrence":{"frequency":"daily","count":2,"except":["2022-11-06T00:00:00Z"]}}`)
+	assert.Equal(t, 200, code)
+
+	bm := models.Bookings{}
+	err = json.Unmarshal(body, &bm)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(bm))
+	assert.Equal(t, "staff", *bm[0].User)
+	assert.Equal(t, 1, len(getBookings(t)))
+
+}
+
+func TestGetBookingsCalendar(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	sutoken, err := signedUserToken()
+	assert.NoError(t, err)
+
+	get := func(path, token string) (int, string, string) {
+		client := &http.Client{}
+		req, err := http.NewRequest("GET", cfg.Host+"/api/v1"+path, nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", token)
+		req.Header.Add("Accept", "text/calendar")
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, resp.Header.Get("Content-Type"), string(body)
+	}
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", cfg.Host+"/api/v1/users/someuser/groups/g-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	req, err = http.NewRequest("POST", cfg.Host+"/api/v1/slots/sl-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	q := req.URL.Query()
+	q.Add("user_name", "someuser")
+	q.Add("from", "2022-11-05T00:01:00Z")
+	q.Add("to", "2022-11-05T00:07:00Z")
+	req.URL.RawQuery = q.Encode()
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	bm := getBookings(t)
+	assert.Equal(t, 1, len(bm))
+
+	code, ctype, body := get("/users/someuser/bookings.ics", sutoken)
+	assert.Equal(t, 200, code)
+	assert.Equal(t, "text/calendar", ctype)
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	assert.Contains(t, body, "UID:"+*bm[0].Name+"\r\n")
+	assert.Contains(t, body, "DTSTART:20221105T000100Z\r\n")
+	assert.Contains(t, body, "DTEND:20221105T000700Z\r\n")
+	assert.Contains(t, body, "SUMMARY:slot-b\r\n")
+	assert.Contains(t, body, "STATUS:CONFIRMED\r\n")
+
+	// users cannot get other users' calendars
+	code, _, _ = get("/users/otheruser/bookings.ics", sutoken)
+	assert.Equal(t, 401, code)
+
+	// only admins can get a resource's calendar
+	code, _, _ = get("/admin/resources/r-b/bookings.ics", sutoken)
+	assert.Equal(t, 401, code)
+
+	code, _, body = get("/admin/resources/r-b/bookings.ics", satoken)
+	assert.Equal(t, 200, code)
+	assert.Contains(t, body, "UID:"+*bm[0].Name+"\r\n")
+
+	code, _, _ = get("/admin/resources/r-x/bookings.ics", satoken)
+	assert.Equal(t, 404, code)
+
+	// cancelled bookings stay in the calendar, so that calendar apps remove them
+	req, err = http.NewRequest("DELETE", cfg.Host+"/api/v1/users/someuser/bookings/"+*bm[0].Name, nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+	resp.Body.Close()
+
+	code, _, body = get("/users/someuser/bookings.ics", sutoken)
+	assert.Equal(t, 200, code)
+	assert.Contains(t, body, "UID:"+*bm[0].Name+"\r\n")
+	assert.Contains(t, body, "STATUS:CANCELLED\r\n")
+
+}
+
 func TestGetStoreStatus(t *testing.T) {
 
 	// make sure our pre-prepared bookings are in the future
@@ -1842,6 +2434,251 @@ func TestGetCancelBookingsGetOldBookings(t *testing.T) {
 
 }
 
+func TestSwapBooking(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	client := &http.Client{}
+	bodyReader := bytes.NewReader(bookings2JSON)
+	req, err := http.NewRequest("PUT", cfg.Host+"/api/v1/admin/bookings", bodyReader)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", satoken)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	sutoken, err := signedUserTokenFor("user-g")
+	assert.NoError(t, err)
+
+	// swapping checks groups, like making a booking
+	client = &http.Client{}
+	req, err = http.NewRequest("POST", cfg.Host+"/api/v1/users/user-g/groups/g-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", sutoken)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	swap := func(token, user, booking, from, to string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest("PUT", cfg.Host+"/api/v1/users/"+user+"/bookings/"+booking+"/swap", nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", token)
+		q := req.URL.Query()
+		q.Add("slot_name", "sl-b")
+		q.Add("from", from)
+		q.Add("to", to)
+		req.URL.RawQuery = q.Encode()
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, body
+	}
+
+	// conflicts with bk-5, so bk-6 is kept
+	code, _ := swap(sutoken, "user-g", "bk-6", "2022-11-05T01:08:00Z", "2022-11-05T01:14:00Z")
+	assert.Equal(t, 404, code)
+
+	// another user cannot swap user-g's booking
+	ftoken, err := signedUserTokenFor("user-f")
+	assert.NoError(t, err)
+	code, _ = swap(ftoken, "user-g", "bk-6", "2022-11-05T01:40:00Z", "2022-11-05T01:45:00Z")
+	assert.Equal(t, 401, code)
+	code, _ = swap(ftoken, "user-f", "bk-6", "2022-11-05T01:40:00Z", "2022-11-05T01:45:00Z")
+	assert.Equal(t, 404, code)
+
+	bm := getBookings(t)
+	bn := make(map[string]bool)
+	for _, b := range bm {
+		bn[*b.Name] = true
+	}
+	assert.True(t, bn["bk-6"])
+
+	// extend bk-6 into the gap before bk-7, overlapping its existing time
+	code, body := swap(sutoken, "user-g", "bk-6", "2022-11-05T01:14:00Z", "2022-11-05T01:24:00Z")
+	assert.Equal(t, 200, code)
+
+	nb := models.Booking{}
+	err = json.Unmarshal(body, &nb)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-g", *nb.User)
+	assert.Equal(t, "sl-b", *nb.Slot)
+	assert.NotEqual(t, "bk-6", *nb.Name)
+
+	bm = getBookings(t)
+	bn = make(map[string]bool)
+	for _, b := range bm {
+		bn[*b.Name] = true
+	}
+	assert.False(t, bn["bk-6"])
+	assert.True(t, bn[*nb.Name])
+
+}
+
+func TestResizeBooking(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	client := &http.Client{}
+	bodyReader := bytes.NewReader(bookings2JSON)
+	req, err := http.NewRequest("PUT", cfg.Host+"/api/v1/admin/bookings", bodyReader)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", satoken)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	sutoken, err := signedUserTokenFor("user-g")
+	assert.NoError(t, err)
+
+	resize := func(from, to string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest("PATCH", cfg.Host+"/api/v1/users/user-g/bookings/bk-6", nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", sutoken)
+		q := req.URL.Query()
+		q.Add("from", from)
+		q.Add("to", to)
+		req.URL.RawQuery = q.Encode()
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, body
+	}
+
+	// clashes with bk-7
+	code, _ := resize("2022-11-05T01:15:00Z", "2022-11-05T01:25:30Z")
+	assert.Equal(t, 404, code)
+
+	code, body := resize("2022-11-05T01:15:00Z", "2022-11-05T01:24:00Z")
+	assert.Equal(t, 200, code)
+
+	b := models.Booking{}
+	err = json.Unmarshal(body, &b)
+	assert.NoError(t, err)
+	assert.Equal(t, "bk-6", *b.Name)
+	assert.Equal(t, "2022-11-05T01:24:00.000Z", b.When.End.String())
+
+}
+
+func TestWaitlist(t *testing.T) {
+
+	ct := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	setNow(s, ct)
+	satoken := loadTestManifest(t)
+	removeAllBookings(t)
+
+	client := &http.Client{}
+	bodyReader := bytes.NewReader(bookings2JSON)
+	req, err := http.NewRequest("PUT", cfg.Host+"/api/v1/admin/bookings", bodyReader)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", satoken)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	resp.Body.Close()
+
+	ftoken, err := signedUserTokenFor("user-f")
+	assert.NoError(t, err)
+
+	// waiting checks groups, like making a booking
+	client = &http.Client{}
+	req, err = http.NewRequest("POST", cfg.Host+"/api/v1/users/user-f/groups/g-b", nil)
+	assert.NoError(t, err)
+	req.Header.Add("Authorization", ftoken)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	resp.Body.Close()
+
+	do := func(method, path, token string, query map[string]string) (int, []byte) {
+		client := &http.Client{}
+		req, err := http.NewRequest(method, cfg.Host+"/api/v1"+path, nil)
+		assert.NoError(t, err)
+		req.Header.Add("Authorization", token)
+		q := req.URL.Query()
+		for k, v := range query {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		if debug {
+			t.Log(string(body))
+		}
+		return resp.StatusCode, body
+	}
+
+	// wait for the time booked by bk-6
+	code, body := do("POST", "/users/user-f/waitlist", ftoken, map[string]string{
+		"slot_name": "sl-b",
+		"from":      "2022-11-05T01:15:00Z",
+		"to":        "2022-11-05T01:20:00Z",
+	})
+	assert.Equal(t, 200, code)
+
+	w := models.WaitlistEntry{}
+	err = json.Unmarshal(body, &w)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-f", *w.User)
+	assert.Equal(t, "sl-b", *w.Slot)
+	assert.Nil(t, w.Offered)
+
+	// another user cannot see user-f's waitlist
+	gtoken, err := signedUserTokenFor("user-g")
+	assert.NoError(t, err)
+	code, _ = do("GET", "/users/user-f/waitlist", gtoken, nil)
+	assert.Equal(t, 401, code)
+
+	// user-g cancels bk-6, so the time is offered to user-f
+	code, _ = do("DELETE", "/users/user-g/bookings/bk-6", gtoken, nil)
+	assert.Equal(t, 404, code) // cancellation is indicated by NotFound
+
+	code, body = do("GET", "/users/user-f/waitlist", ftoken, nil)
+	assert.Equal(t, 200, code)
+
+	ws := models.WaitlistEntries{}
+	err = json.Unmarshal(body, &ws)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ws))
+	assert.Equal(t, *w.Name, *ws[0].Name)
+	assert.NotNil(t, ws[0].Offered)
+	assert.Equal(t, "2022-11-05T01:15:00.000Z", ws[0].Offered.Start.String())
+	assert.Equal(t, "2022-11-05T01:20:00.000Z", ws[0].Offered.End.String())
+
+	code, _ = do("DELETE", "/users/user-f/waitlist/"+*w.Name, ftoken, nil)
+	assert.Equal(t, 204, code)
+
+	code, _ = do("DELETE", "/users/user-f/waitlist/"+*w.Name, ftoken, nil)
+	assert.Equal(t, 404, code)
+
+}
+
 func TestGetActivity(t *testing.T) {
 
 	// make sure our pre-prepared bookings are in the future
@@ -2672,7 +3509,7 @@ func TestGroups(t *testing.T) {
 	if debug {
 		t.Log(string(body))
 	}
-	assert.Equal(t, `{"description":{"name":"group-a","short":"a","type":"group"},"policies":{"p-a":{"allow_start_in_past_within":"0s","book_ahead":"1h0m0s","description":{"name":"policy-a","short":"a","type":"policy"},"display_guides":{"1mFor20m":{"book_ahead":"20m0s","duration":"1m0s","label":"1m","max_slots":15}},"enforce_book_ahead":true,"max_duration":"0s","max_usage":"0s","min_duration":"0s","next_available":"0s","slots":{"sl-a":{"description":{"name":"slot-a","short":"a","type":"slot"},"policy":"p-a"}},"starts_within":"0s"}}}`+"\n", string(body))
+	assert.Equal(t, `{"description":{"name":"group-a","short":"a","type":"group"},"policies":{"p-a":{"allow_start_in_past_within":"0s","book_ahead":"1h0m0s","description":{"name":"policy-a","short":"a","type":"policy"},"display_guides":{"1mFor20m":{"book_ahead":"20m0s","duration":"1m0s","label":"1m","max_slots":15}},"duration_step":"0s","enforce_book_ahead":true,"max_duration":"0s","max_usage":"0s","max_usage_per_period":"0s","min_duration":"0s","next_available":"0s","slots":{"sl-a":{"description":{"name":"slot-a","short":"a","type":"slot"},"policy":"p-a"}},"start_alignment":"0s","starts_within":"0s"}}}`+"\n", string(body))
 
 	// Add nonexistent group - needs to return a 404 not 500
 	client = &http.Client{}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetAvailabilityAnySlot returns the union of the availability of the slots in the policy that use the UI set
+// (or of all the slots in the policy, if uiSet is empty), so that users can see when any of them can be booked.
+// Slots whose resource is offline are left out. Overlapping intervals from different slots are merged, so a merged
+// interval may not be free on any one slot for its whole length - use GetAvailability on a single slot if that matters.
+func (s *Store) GetAvailabilityAnySlot(policy, uiSet string) ([]interval.Interval, error) {
+
+	where := "store.GetAvailabilityAnySlot"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	return s.getAvailabilityAnySlot(policy, uiSet)
+}
+
+// getAvailabilityAnySlot returns the merged availability of the slots in the policy that use the UI set
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) getAvailabilityAnySlot(policy, uiSet string) ([]interval.Interval, error) {
+
+	sls, err := s.getSlotsFor(policy, uiSet)
+
+	if err != nil {
+		return []interval.Interval{}, err
+	}
+
+	a := []interval.Interval{}
+
+	for _, sl := range sls {
+
+		// a slot whose resource is offline cannot be booked, as for GetAvailabilityForSlots
+		if ok, _, err := s.getSlotIsAvailable(sl); err == nil && !ok {
+			continue
+		}
+
+		sa, err := s.getAvailability(sl, time.Time{}, time.Time{}, 0)
+
+		if err != nil {
+			return []interval.Interval{}, err
+		}
+
+		a = append(a, sa...)
+	}
+
+	return interval.Merge(a), nil
+}
+
+// GetAvailabilityForSlots returns the availability of each slot in the policy that uses the UI set (or of every slot
+// in the policy, if uiSet is empty), by slot name, so that UIs showing the slots side by side need only make one request.
+// The from, to and limit apply to each slot, as for GetAvailabilityBetween.
+func (s *Store) GetAvailabilityForSlots(policy, uiSet string, from, to time.Time, limit int) (map[string][]interval.Interval, error) {
+
+	where := "store.GetAvailabilityForSlots"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	sls, err := s.getSlotsFor(policy, uiSet)
+
+	if err != nil {
+		return map[string][]interval.Interval{}, err
+	}
+
+	am := make(map[string][]interval.Interval)
+
+	for _, sl := range sls {
+
+		// a slot whose resource is offline has no availability, but shouldn't hide the other slots
+		if ok, _, err := s.getSlotIsAvailable(sl); err == nil && !ok {
+			am[sl] = []interval.Interval{}
+			continue
+		}
+
+		a, err := s.getAvailability(sl, from, to, limit)
+
+		if err != nil {
+			return map[string][]interval.Interval{}, err
+		}
+
+		am[sl] = a
+	}
+
+	return am, nil
+}
+
+// MakeBookingAnySlot books whichever slot in the policy that uses the UI set (or any slot in the policy, if
+// uiSet is empty) is free for the interval, trying them in order of name. Every slot is tried before any
+// booking under a policy of lower priority is bumped, so bumping only happens if no slot is free.
+// The booking is otherwise the same as for MakeBooking, and is recorded as a request for the slot
+// that was booked, so that it replays identically.
+func (s *Store) MakeBookingAnySlot(policy, uiSet, user string, when interval.Interval) (Booking, error) {
+	where := "store.MakeBookingAnySlot"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	sls, err := s.getSlotsFor(policy, uiSet)
+
+	if err != nil {
+		return Booking{}, err
+	}
+
+	name := uuid.New().String()
+
+	// try every slot without bumping first, then again with bumping, if the policy has a priority
+	attempts := []bool{false}
+
+	if s.Policies[policy].Priority > 0 {
+		attempts = append(attempts, true)
+	}
+
+	for _, bump := range attempts {
+		for _, slot := range sls {
+
+			var b Booking
+
+			b, err = s.makeBookingOnResource(slot, "", user, when, name, true, bump) //check groups
+
+			if err != nil {
+				log.WithFields(log.Fields{"slot": slot, "user": user, "start": when.Start.String(), "end": when.End.String(), "name": name}).Debugf("slot not booked because %s", err.Error())
+				continue
+			}
+
+			s.record(history.Action{
+				Do:      history.RequestBooking,
+				Slot:    slot,
+				User:    user,
+				When:    when,
+				Booking: name,
+				Flag:    true,
+			})
+
+			log.WithFields(log.Fields{"policy": policy, "ui_set": uiSet, "slot": slot, "user": user, "start": when.Start.String(), "end": when.End.String(), "name": name}).Info("successful booking")
+
+			return b, nil
+		}
+	}
+
+	log.WithFields(log.Fields{"policy": policy, "ui_set": uiSet, "user": user, "start": when.Start.String(), "end": when.End.String(), "name": name}).Info("failed booking because " + err.Error())
+
+	// the error from the last slot tried is representative, because the policy checks are the same for every slot
+	return Booking{}, errors.New("no slot could be booked because " + err.Error())
+}
+
+// getSlotsFor returns the names of the slots in the policy that use the UI set (or all the slots in
+// the policy, if uiSet is empty) in order of name, or an error if there are none
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) getSlotsFor(policy, uiSet string) ([]string, error) {
+
+	p, ok := s.Policies[policy]
+
+	if !ok {
+		return []string{}, errors.New("policy " + policy + " not found")
+	}
+
+	sls := []string{}
+
+	for _, k := range p.Slots {
+
+		sl, ok := s.Slots[k]
+
+		if !ok {
+			continue
+		}
+
+		if uiSet != "" && sl.UISet != uiSet {
+			continue
+		}
+
+		sls = append(sls, k)
+	}
+
+	if len(sls) == 0 {
+		return []string{}, errors.New("no slots in policy " + policy + " use ui_set " + uiSet)
+	}
+
+	sort.Strings(sls)
+
+	return sls, nil
+}
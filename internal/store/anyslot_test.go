@@ -0,0 +1,188 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestMakeBookingAnySlot(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML2, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	for _, u := range []string{"user1", "user2", "user3"} {
+		err = s.AddGroupForUser(u, "g-everyone")
+		assert.NoError(t, err)
+	}
+
+	when := interval.Interval{
+		Start: time.Date(2023, 3, 1, 0, 10, 0, 0, time.UTC),
+		End:   time.Date(2023, 3, 1, 0, 20, 0, 0, time.UTC),
+	}
+
+	_, err = s.MakeBookingAnySlot("p-everyone-pend", "us-spin-engdes1", "user1", when)
+	assert.Error(t, err)
+
+	_, err = s.MakeBookingAnySlot("p-engdes1-lab-pend", "", "user1", when)
+	assert.Error(t, err) // not in the group for this policy
+
+	b1, err := s.MakeBookingAnySlot("p-everyone-pend", "us-pend-everyone", "user1", when)
+	assert.NoError(t, err)
+	assert.Equal(t, "sl-everyone-pend00", b1.Slot)
+
+	b2, err := s.MakeBookingAnySlot("p-everyone-pend", "", "user2", when)
+	assert.NoError(t, err)
+	assert.Equal(t, "sl-everyone-pend01", b2.Slot)
+
+	_, err = s.MakeBookingAnySlot("p-everyone-pend", "", "user3", when)
+	assert.Error(t, err)
+
+	// availability is merged across the slots
+	a, err := s.GetAvailabilityAnySlot("p-everyone-pend", "us-pend-everyone")
+	assert.NoError(t, err)
+	exp := []interval.Interval{
+		interval.Interval{
+			Start: s.Now(),
+			End:   when.Start.Add(-time.Nanosecond),
+		},
+		interval.Interval{
+			Start: when.End.Add(time.Nanosecond),
+			End:   s.Now().Add(2 * time.Hour),
+		},
+	}
+	assert.Equal(t, exp, a)
+
+	err = s.CancelBooking(b2, "user2")
+	assert.NoError(t, err)
+
+	a, err = s.GetAvailabilityAnySlot("p-everyone-pend", "")
+	assert.NoError(t, err)
+	exp = []interval.Interval{
+		interval.Interval{
+			Start: s.Now(),
+			End:   s.Now().Add(2 * time.Hour),
+		},
+	}
+	assert.Equal(t, exp, a)
+
+	// a slot whose resource is offline is left out, so the times offered can be booked
+	err = s.SetSlotIsAvailable("sl-everyone-pend01", false, "failed test")
+	assert.NoError(t, err)
+
+	a, err = s.GetAvailabilityAnySlot("p-everyone-pend", "")
+	assert.NoError(t, err)
+	exp = []interval.Interval{
+		interval.Interval{
+			Start: s.Now(),
+			End:   when.Start.Add(-time.Nanosecond),
+		},
+		interval.Interval{
+			Start: when.End.Add(time.Nanosecond),
+			End:   s.Now().Add(2 * time.Hour),
+		},
+	}
+	assert.Equal(t, exp, a)
+
+	_, err = s.MakeBookingAnySlot("p-everyone-pend", "", "user2", when)
+	assert.Error(t, err)
+
+	err = s.SetSlotIsAvailable("sl-everyone-pend01", true, "fixed")
+	assert.NoError(t, err)
+
+	_, err = s.GetAvailabilityAnySlot("p-x", "")
+	assert.Error(t, err)
+
+	// bookings are replayed on the slot that was booked
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2023, 3, 1, 0, 1, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+}
+
+func TestGetAvailabilityForSlots(t *testing.T) {
+
+	s := New()
+
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.SetNow(func() time.Time { return now })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML2, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	err = s.AddGroupForUser("user1", "g-everyone")
+	assert.NoError(t, err)
+
+	when := interval.Interval{
+		Start: time.Date(2023, 3, 1, 0, 10, 0, 0, time.UTC),
+		End:   time.Date(2023, 3, 1, 0, 20, 0, 0, time.UTC),
+	}
+
+	_, err = s.MakeBooking("sl-everyone-pend00", "user1", when)
+	assert.NoError(t, err)
+
+	to := now.Add(time.Hour)
+
+	am, err := s.GetAvailabilityForSlots("p-everyone-pend", "us-pend-everyone", time.Time{}, to, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(am))
+
+	exp := []interval.Interval{
+		interval.Interval{
+			Start: now,
+			End:   when.Start.Add(-time.Nanosecond),
+		},
+		interval.Interval{
+			Start: when.End.Add(time.Nanosecond),
+			End:   to,
+		},
+	}
+	assert.Equal(t, exp, am["sl-everyone-pend00"])
+
+	exp = []interval.Interval{
+		interval.Interval{
+			Start: now,
+			End:   to,
+		},
+	}
+	assert.Equal(t, exp, am["sl-everyone-pend01"])
+
+	// limit applies to each slot
+	am, err = s.GetAvailabilityForSlots("p-everyone-pend", "", time.Time{}, to, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(am["sl-everyone-pend00"]))
+	assert.Equal(t, 1, len(am["sl-everyone-pend01"]))
+
+	// a slot that is offline has no availability, without affecting the others
+	err = s.SetSlotIsAvailable("sl-everyone-pend01", false, "failed test")
+	assert.NoError(t, err)
+
+	am, err = s.GetAvailabilityForSlots("p-everyone-pend", "", time.Time{}, to, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(am["sl-everyone-pend00"]))
+	assert.Equal(t, []interval.Interval{}, am["sl-everyone-pend01"])
+
+	_, err = s.GetAvailabilityForSlots("p-unknown", "", time.Time{}, to, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+// blackoutManifest adds a maintenance blackout on the morning of 5 Nov 2022, referenced by both windows
+func blackoutManifest(t *testing.T) Manifest {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	m.Blackouts = map[string]Blackout{
+		"bo-maintenance": Blackout{
+			Denied: []interval.Interval{
+				interval.Interval{
+					Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+					End:   time.Date(2022, 11, 5, 4, 0, 0, 0, time.UTC),
+				},
+			},
+			Description: "d-p-a",
+		},
+	}
+
+	for k, w := range m.Windows {
+		w.Blackouts = []string{"bo-maintenance"}
+		m.Windows[k] = w
+	}
+
+	return m
+}
+
+func TestCheckManifestBlackouts(t *testing.T) {
+
+	m := blackoutManifest(t)
+
+	err, msg := CheckManifest(m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	bo := m.Blackouts["bo-maintenance"]
+	bo.Denied = nil
+	m.Blackouts["bo-maintenance"] = bo
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"missing denied field in blackout bo-maintenance"}, msg)
+
+	m = blackoutManifest(t)
+	w := m.Windows["w-a"]
+	w.Blackouts = []string{"bo-holiday"}
+	m.Windows["w-a"] = w
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"window w-a references non-existent blackout: bo-holiday"}, msg)
+}
+
+func TestBlackouts(t *testing.T) {
+
+	m := blackoutManifest(t)
+
+	s := New()
+	err := s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	// the blackouts are exported with the manifest
+	em := s.ExportManifest()
+	assert.Equal(t, m.Blackouts, em.Blackouts)
+	assert.Equal(t, m.Windows["w-a"].Blackouts, em.Windows["w-a"].Blackouts)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	book := func(sh, sm, eh, em int) (Booking, error) {
+		return s.MakeBooking("sl-a", "u-a", interval.Interval{
+			Start: time.Date(2022, 11, 5, sh, sm, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, eh, em, 0, 0, time.UTC),
+		})
+	}
+
+	// the blackout is denied even though the window itself denies nothing
+	_, err = book(2, 30, 3, 0)
+	assert.Error(t, err)
+
+	_, err = book(4, 5, 4, 30)
+	assert.NoError(t, err)
+}
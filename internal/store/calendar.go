@@ -0,0 +1,111 @@
+package store
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/practable/book/internal/ical"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetCalendarFor returns the current and old bookings for the given user as a calendar,
+// with cancelled bookings included as cancelled events so that calendar apps can remove them
+func (s *Store) GetCalendarFor(user string) (ical.Calendar, error) {
+	where := "store.GetCalendarFor"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	bs, err := s.getBookingsFor(user)
+
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+
+	obs, err := s.getOldBookingsFor(user)
+
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+
+	return s.getCalendar("bookings for "+user, append(bs, obs...)), nil
+}
+
+// GetCalendarForResource returns the current and old bookings for the given resource as a calendar,
+// with cancelled bookings included as cancelled events so that calendar apps can remove them
+func (s *Store) GetCalendarForResource(resource string) (ical.Calendar, error) {
+	where := "store.GetCalendarForResource"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	if _, ok := s.Resources[resource]; !ok {
+		return ical.Calendar{}, errors.New("resource " + resource + " not found")
+	}
+
+	bs := []Booking{}
+
+	for _, v := range s.Bookings {
+		if s.bookingResource(*v) == resource {
+			bs = append(bs, *v)
+		}
+	}
+
+	for _, v := range s.OldBookings {
+		if s.bookingResource(*v) == resource {
+			bs = append(bs, *v)
+		}
+	}
+
+	return s.getCalendar("bookings for "+resource, bs), nil
+}
+
+// getCalendar returns the bookings as a calendar of events, in order of start time,
+// using the name and short description of each booking's slot
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) getCalendar(name string, bs []Booking) ical.Calendar {
+
+	sort.Slice(bs, func(i, j int) bool {
+		if bs[i].When.Start.Equal(bs[j].When.Start) {
+			return bs[i].Name < bs[j].Name
+		}
+		return bs[i].When.Start.Before(bs[j].When.Start)
+	})
+
+	es := []ical.Event{}
+
+	for _, b := range bs {
+
+		e := ical.Event{
+			UID:       b.Name,
+			Start:     b.When.Start,
+			End:       b.When.End,
+			Summary:   b.Slot,
+			Cancelled: b.Cancelled,
+		}
+
+		if sl, ok := s.Slots[b.Slot]; ok {
+			if d, ok := s.Descriptions[sl.Description]; ok {
+				if d.Name != "" {
+					e.Summary = d.Name
+				}
+				e.Description = d.Short
+			}
+		}
+
+		es = append(es, e)
+	}
+
+	return ical.Calendar{
+		Name:   name,
+		Events: es,
+	}
+}
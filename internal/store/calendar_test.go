@@ -0,0 +1,72 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestGetCalendarFor(t *testing.T) {
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+	s := New()
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	user := "u-a"
+	s.AddGroupForUser(user, "g-a")
+
+	later := interval.Interval{
+		Start: time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 3, 10, 0, 0, time.UTC),
+	}
+	b0, err := s.MakeBookingWithName("sl-a", user, later, "bk-0", false)
+	assert.NoError(t, err)
+
+	earlier := interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+	}
+	b1, err := s.MakeBookingWithName("sl-a", user, earlier, "bk-1", false)
+	assert.NoError(t, err)
+
+	err = s.CancelBooking(b0, user)
+	assert.NoError(t, err)
+
+	c, err := s.GetCalendarFor(user)
+	assert.NoError(t, err)
+	assert.Equal(t, "bookings for u-a", c.Name)
+	assert.Equal(t, 2, len(c.Events))
+
+	// events are in order of start, and cancelled bookings are kept
+	assert.Equal(t, b1.Name, c.Events[0].UID)
+	assert.Equal(t, earlier.Start, c.Events[0].Start)
+	assert.Equal(t, earlier.End, c.Events[0].End)
+	assert.Equal(t, "slot-a", c.Events[0].Summary)
+	assert.Equal(t, "a", c.Events[0].Description)
+	assert.False(t, c.Events[0].Cancelled)
+
+	assert.Equal(t, b0.Name, c.Events[1].UID)
+	assert.True(t, c.Events[1].Cancelled)
+
+	_, err = s.GetCalendarFor("u-unknown")
+	assert.Error(t, err)
+
+	c, err = s.GetCalendarForResource("r-a")
+	assert.NoError(t, err)
+	assert.Equal(t, "bookings for r-a", c.Name)
+	assert.Equal(t, 2, len(c.Events))
+
+	c, err = s.GetCalendarForResource("r-b")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(c.Events))
+
+	_, err = s.GetCalendarForResource("r-unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,278 @@
+package store
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/practable/book/internal/filter"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// ManifestEntities represents the names of the entities of each kind in a manifest
+type ManifestEntities struct {
+	Blackouts     []string `json:"blackouts,omitempty" yaml:"blackouts,omitempty"`
+	Descriptions  []string `json:"descriptions,omitempty" yaml:"descriptions,omitempty"`
+	DisplayGuides []string `json:"display_guides,omitempty" yaml:"display_guides,omitempty"`
+	Groups        []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Policies      []string `json:"policies,omitempty" yaml:"policies,omitempty"`
+	Pools         []string `json:"pools,omitempty" yaml:"pools,omitempty"`
+	Resources     []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Slots         []string `json:"slots,omitempty" yaml:"slots,omitempty"`
+	Streams       []string `json:"streams,omitempty" yaml:"streams,omitempty"`
+	UIs           []string `json:"uis,omitempty" yaml:"uis,omitempty"`
+	UISets        []string `json:"ui_sets,omitempty" yaml:"ui_sets,omitempty"`
+	Windows       []string `json:"windows,omitempty" yaml:"windows,omitempty"`
+}
+
+// BookingImpact represents an existing booking that a candidate manifest would affect, and the reasons why
+type BookingImpact struct {
+	Booking string            `json:"booking" yaml:"booking"`
+	Policy  string            `json:"policy" yaml:"policy"`
+	Reasons []string          `json:"reasons" yaml:"reasons"`
+	Slot    string            `json:"slot" yaml:"slot"`
+	User    string            `json:"user" yaml:"user"`
+	When    interval.Interval `json:"when" yaml:"when"`
+}
+
+// ManifestDiff represents the entities that a candidate manifest adds, changes or removes compared to
+// the current manifest, and the existing bookings that would be affected if it replaced the current manifest
+type ManifestDiff struct {
+	Added    ManifestEntities `json:"added" yaml:"added"`
+	Bookings []BookingImpact  `json:"bookings" yaml:"bookings"`
+	Changed  ManifestEntities `json:"changed" yaml:"changed"`
+	Removed  ManifestEntities `json:"removed" yaml:"removed"`
+}
+
+// DiffManifest compares the candidate manifest to the current manifest, and reports the existing bookings
+// that would reference a missing slot, policy, resource or group, or fall outside the periods allowed by their
+// window, if the candidate replaced the current manifest. The candidate is checked as for CheckManifest,
+// and the reasons for any failed checks are returned. The store is not modified.
+func (s *Store) DiffManifest(m Manifest) (ManifestDiff, error, []string) {
+	where := "store.DiffManifest"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	return s.diffManifest(m)
+}
+
+// diffManifest compares the candidate manifest to the current manifest
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) diffManifest(m Manifest) (ManifestDiff, error, []string) {
+
+	d := ManifestDiff{
+		Bookings: []BookingImpact{},
+	}
+
+	err, msg := checkManifest(m)
+
+	if err != nil {
+		return d, err, msg
+	}
+
+	// make the filters for the candidate windows, as replaceManifest would
+	fm := make(map[string]*filter.Filter)
+
+	for k, w := range m.Windows {
+
+		f := filter.New()
+		allowed, err := windowAllowed(w)
+		if err == nil {
+			err = f.SetAllowed(allowed)
+		}
+		if err == nil {
+			var denied []interval.Interval
+			denied, err = windowDenied(w, m.Blackouts)
+			if err == nil {
+				err = f.SetDenied(denied)
+			}
+		}
+		if err != nil {
+			return d, errors.New("failed creating filter"), []string{"failed to create filter for window " + k + ": " + err.Error()}
+		}
+
+		fm[k] = f
+	}
+
+	c := s.exportManifest()
+
+	d.Added.Blackouts, d.Changed.Blackouts, d.Removed.Blackouts = diffEntities(c.Blackouts, m.Blackouts)
+	d.Added.Descriptions, d.Changed.Descriptions, d.Removed.Descriptions = diffEntities(c.Descriptions, m.Descriptions)
+	d.Added.DisplayGuides, d.Changed.DisplayGuides, d.Removed.DisplayGuides = diffEntities(c.DisplayGuides, m.DisplayGuides)
+	d.Added.Groups, d.Changed.Groups, d.Removed.Groups = diffEntities(c.Groups, m.Groups)
+	d.Added.Policies, d.Changed.Policies, d.Removed.Policies = diffEntities(c.Policies, m.Policies)
+	d.Added.Pools, d.Changed.Pools, d.Removed.Pools = diffEntities(c.Pools, m.Pools)
+	d.Added.Resources, d.Changed.Resources, d.Removed.Resources = diffEntities(c.Resources, m.Resources)
+	d.Added.Slots, d.Changed.Slots, d.Removed.Slots = diffEntities(c.Slots, m.Slots)
+	d.Added.Streams, d.Changed.Streams, d.Removed.Streams = diffEntities(c.Streams, m.Streams)
+	d.Added.UIs, d.Changed.UIs, d.Removed.UIs = diffEntities(c.UIs, m.UIs)
+	d.Added.UISets, d.Changed.UISets, d.Removed.UISets = diffEntities(c.UISets, m.UISets)
+	d.Added.Windows, d.Changed.Windows, d.Removed.Windows = diffEntities(c.Windows, m.Windows)
+
+	names := []string{}
+
+	for k, b := range s.Bookings {
+		if !b.Cancelled {
+			names = append(names, k)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, k := range names {
+
+		b := s.Bookings[k]
+
+		ug := map[string]bool{}
+
+		if u, ok := s.Users[b.User]; ok {
+			ug = u.Groups
+		}
+
+		reasons := bookingReasons(*b, ug, c, m, fm)
+
+		if len(reasons) > 0 {
+			d.Bookings = append(d.Bookings, BookingImpact{
+				Booking: b.Name,
+				Policy:  b.Policy,
+				Reasons: reasons,
+				Slot:    b.Slot,
+				User:    b.User,
+				When:    b.When,
+			})
+		}
+	}
+
+	return d, nil, []string{}
+}
+
+// bookingReasons returns the reasons why the booking would be affected if the candidate manifest m
+// replaced the current manifest c, given the groups of the user who made the booking, and the filters
+// for the windows of the candidate manifest
+func bookingReasons(b Booking, ug map[string]bool, c, m Manifest, fm map[string]*filter.Filter) []string {
+
+	reasons := []string{}
+
+	sl, ok := m.Slots[b.Slot]
+
+	if !ok {
+		return append(reasons, "slot "+b.Slot+" is removed")
+	}
+
+	p, ok := m.Policies[b.Policy]
+
+	if !ok {
+		reasons = append(reasons, "policy "+b.Policy+" is removed")
+	} else {
+		listed := false
+		for _, k := range p.Slots {
+			if k == b.Slot {
+				listed = true
+			}
+		}
+		if !listed {
+			reasons = append(reasons, "policy "+b.Policy+" no longer includes slot "+b.Slot)
+		}
+	}
+
+	// the booking is affected if it was allowed by the user's groups, and none of them allow it any more,
+	// but not if it was made by admin for a user with no group that includes the policy
+	if current := groupsWithPolicy(ug, c.Groups, b.Policy); len(current) > 0 && len(groupsWithPolicy(ug, m.Groups, b.Policy)) == 0 {
+		for _, k := range current {
+			if _, ok := m.Groups[k]; !ok {
+				reasons = append(reasons, "group "+k+" is removed")
+			} else {
+				reasons = append(reasons, "group "+k+" no longer includes policy "+b.Policy)
+			}
+		}
+	}
+
+	r := b.Resource
+
+	if r == "" {
+		r = sl.Resource
+		// the booking is in the diary of the resource that the slot used to have
+		if cs, ok := c.Slots[b.Slot]; ok && cs.Resource != sl.Resource {
+			reasons = append(reasons, "slot "+b.Slot+" is moved from resource "+cs.Resource+" to "+sl.Resource)
+		}
+	}
+
+	if _, ok := m.Resources[r]; !ok {
+		reasons = append(reasons, "resource "+r+" is removed")
+	}
+
+	if f, ok := fm[sl.Window]; ok && !f.Allowed(b.When) {
+		reasons = append(reasons, "outside the periods allowed by window "+sl.Window)
+	}
+
+	return reasons
+}
+
+// groupsWithPolicy returns the names of the groups that include the policy, of those in ug, in order of name
+func groupsWithPolicy(ug map[string]bool, groups map[string]Group, policy string) []string {
+
+	gs := []string{}
+
+	for k := range ug {
+
+		g, ok := groups[k]
+
+		if !ok {
+			continue
+		}
+
+		for _, p := range g.Policies {
+			if p == policy {
+				gs = append(gs, k)
+				break
+			}
+		}
+	}
+
+	sort.Strings(gs)
+
+	return gs
+}
+
+// diffEntities returns the sorted names of the entities that are added, changed or removed
+// in the candidate compared to the current entities. Entities are compared in their manifest
+// format, so that information held only in the store does not count as a change.
+func diffEntities[T any](current, candidate map[string]T) ([]string, []string, []string) {
+
+	var added, changed, removed []string
+
+	for k, v := range candidate {
+
+		cv, ok := current[k]
+
+		if !ok {
+			added = append(added, k)
+			continue
+		}
+
+		a, erra := yaml.Marshal(cv)
+		b, errb := yaml.Marshal(v)
+
+		if erra != nil || errb != nil || string(a) != string(b) {
+			changed = append(changed, k)
+		}
+	}
+
+	for k := range current {
+		if _, ok := candidate[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+
+	return added, changed, removed
+}
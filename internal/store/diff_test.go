@@ -0,0 +1,179 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestDiffManifest(t *testing.T) {
+
+	s := New()
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	b, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 3, 10, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 3, 20, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	// an unchanged manifest makes no difference
+	d, err, msg := s.DiffManifest(s.ExportManifest())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, ManifestDiff{Bookings: []BookingImpact{}}, d)
+
+	c := Manifest{}
+	err = yaml.Unmarshal(manifestYAML, &c)
+	assert.NoError(t, err)
+
+	w := c.Windows["w-a"]
+	w.Denied = []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 4, 0, 0, 0, time.UTC),
+		},
+	}
+	c.Windows["w-a"] = w
+
+	c.Resources["r-c"] = Resource{
+		Description: "d-r-a",
+		Streams:     []string{"st-a"},
+		TopicStub:   "cccc00",
+	}
+
+	p := c.Policies["p-a"]
+	p.Slots = []string{"sl-b"}
+	c.Policies["p-a"] = p
+
+	d, err, msg = s.DiffManifest(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, []string{"r-c"}, d.Added.Resources)
+	assert.Equal(t, []string{"p-a"}, d.Changed.Policies)
+	assert.Equal(t, []string{"w-a"}, d.Changed.Windows)
+	assert.Equal(t, ManifestEntities{}, d.Removed)
+	assert.Equal(t, []BookingImpact{
+		BookingImpact{
+			Booking: b.Name,
+			Policy:  "p-a",
+			Reasons: []string{
+				"policy p-a no longer includes slot sl-a",
+				"outside the periods allowed by window w-a",
+			},
+			Slot: "sl-a",
+			User: "u-a",
+			When: b.When,
+		},
+	}, d.Bookings)
+
+	// removing the slot orphans the booking
+	delete(c.Slots, "sl-a")
+	p.Slots = []string{"sl-b"}
+	c.Policies["p-a"] = p
+
+	d, err, msg = s.DiffManifest(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, []string{"sl-a"}, d.Removed.Slots)
+	assert.Equal(t, 1, len(d.Bookings))
+	assert.Equal(t, []string{"slot sl-a is removed"}, d.Bookings[0].Reasons)
+
+	// the candidate is checked
+	delete(c.Resources, "r-simulation")
+	_, err, msg = s.DiffManifest(c)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"slot sl-simulation references non-existent resource: r-simulation"}, msg)
+
+	// the store is not modified
+	_, ok := s.ExportManifest().Slots["sl-a"]
+	assert.True(t, ok)
+}
+
+func TestDiffManifestGroups(t *testing.T) {
+
+	s := New()
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	b, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	// admin bookings for users without a group for the policy do not depend on the groups
+	_, err = s.MakeBookingWithName("sl-a", "u-x", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 20, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 30, 0, 0, time.UTC),
+	}, "bk-admin", false)
+	assert.NoError(t, err)
+
+	impact := func(reason string) []BookingImpact {
+		return []BookingImpact{
+			BookingImpact{
+				Booking: b.Name,
+				Policy:  "p-a",
+				Reasons: []string{reason},
+				Slot:    "sl-a",
+				User:    "u-a",
+				When:    b.When,
+			},
+		}
+	}
+
+	c := Manifest{}
+	err = yaml.Unmarshal(manifestYAML, &c)
+	assert.NoError(t, err)
+
+	delete(c.Groups, "g-a")
+
+	d, err, msg := s.DiffManifest(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, []string{"g-a"}, d.Removed.Groups)
+	assert.Equal(t, impact("group g-a is removed"), d.Bookings)
+
+	g := m.Groups["g-a"]
+	g.Policies = []string{"p-b"}
+	c.Groups["g-a"] = g
+
+	d, err, msg = s.DiffManifest(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, impact("group g-a no longer includes policy p-a"), d.Bookings)
+
+	// the booking is unaffected while another of the user's groups includes the policy
+	s.AddGroupForUser("u-a", "g-b")
+
+	g = m.Groups["g-b"]
+	g.Policies = append(g.Policies, "p-a")
+	c.Groups["g-b"] = g
+
+	d, err, msg = s.DiffManifest(c)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, []BookingImpact{}, d.Bookings)
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// groupUsage returns the number of current/future bookings, and the usage, of all members of the group,
+// under the group's policies. Cancelled bookings, and bookings that have ended, are not counted as current,
+// even if they have not yet been moved to the old bookings.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) groupUsage(group string, g GroupDescribed) (int64, time.Duration) {
+
+	pm := make(map[string]bool)
+
+	for _, p := range g.Policies {
+		pm[p] = true
+	}
+
+	current := int64(0)
+	usage := time.Duration(0)
+	now := s.now()
+
+	for _, u := range s.Users {
+
+		if !u.Groups[group] {
+			continue
+		}
+
+		for _, b := range u.Bookings {
+			if pm[b.Policy] && !b.Cancelled && !now.After(b.When.End) {
+				current++
+			}
+		}
+
+		for p := range pm {
+			if ut, ok := u.Usage[p]; ok {
+				usage += *ut
+			}
+		}
+	}
+
+	return current, usage
+}
+
+// userGroupsFor returns the names of the user's groups that include the policy
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) userGroupsFor(user, policy string) []string {
+
+	gn := []string{}
+
+	u, ok := s.Users[user]
+
+	if !ok {
+		return gn
+	}
+
+	for k := range u.Groups {
+		g, ok := s.Groups[k]
+		if !ok {
+			continue
+		}
+		for _, p := range g.Policies {
+			if p == policy {
+				gn = append(gn, k)
+				break
+			}
+		}
+	}
+
+	return gn
+}
+
+// checkGroupLimits checks that a booking of the duration given, under the policy, would not take any
+// of the user's groups that include the policy over their limits on bookings or usage. The number
+// of bookings is only checked if newBooking is true, so that an existing booking can be resized.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) checkGroupLimits(user, policy string, duration time.Duration, newBooking bool) error {
+
+	for _, k := range s.userGroupsFor(user, policy) {
+
+		g := s.Groups[k]
+
+		if !g.EnforceMaxBookings && !g.EnforceMaxUsage {
+			continue
+		}
+
+		current, usage := s.groupUsage(k, g)
+
+		if newBooking && g.EnforceMaxBookings && current >= g.MaxBookings {
+			return errors.New("group " + k +
+				" currently has " +
+				strconv.FormatInt(current, 10) +
+				" current/future bookings which is at or exceeds the group limit of " +
+				strconv.FormatInt(g.MaxBookings, 10))
+		}
+
+		if g.EnforceMaxUsage && duration > 0 && usage+duration > g.MaxUsage {
+			remaining := g.MaxUsage - usage
+			if remaining < 0 {
+				remaining = 0
+			}
+			return errors.New("requested duration of " +
+				HumaniseDuration(duration) +
+				" exceeds remaining usage limit of " +
+				HumaniseDuration(remaining) +
+				" for group " + k)
+		}
+	}
+
+	return nil
+}
+
+// groupStatusFor returns the status of each of the user's groups that include the policy and limit
+// bookings or usage, or nil if there are none
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) groupStatusFor(user, policy string) map[string]GroupStatus {
+
+	var gs map[string]GroupStatus
+
+	for _, k := range s.userGroupsFor(user, policy) {
+
+		g := s.Groups[k]
+
+		if !g.EnforceMaxBookings && !g.EnforceMaxUsage {
+			continue
+		}
+
+		current, usage := s.groupUsage(k, g)
+
+		status := GroupStatus{
+			CurrentBookings:    current,
+			EnforceMaxBookings: g.EnforceMaxBookings,
+			EnforceMaxUsage:    g.EnforceMaxUsage,
+			Usage:              usage,
+		}
+
+		if g.EnforceMaxBookings && g.MaxBookings > current {
+			status.BookingsRemaining = g.MaxBookings - current
+		}
+
+		if g.EnforceMaxUsage && g.MaxUsage > usage {
+			status.UsageRemaining = g.MaxUsage - usage
+		}
+
+		if gs == nil {
+			gs = make(map[string]GroupStatus)
+		}
+
+		gs[k] = status
+	}
+
+	return gs
+}
+
+// checkGroupLimitSettings returns a message for each limit of the group that cannot be enforced
+func checkGroupLimitSettings(name string, g Group) []string {
+
+	msg := []string{}
+
+	if g.EnforceMaxBookings && g.MaxBookings < 1 {
+		msg = append(msg, "max_bookings must be positive in group "+name)
+	}
+
+	if g.EnforceMaxUsage && g.MaxUsage <= 0 {
+		msg = append(msg, "max_usage must be positive in group "+name)
+	}
+
+	return msg
+}
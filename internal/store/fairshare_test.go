@@ -0,0 +1,115 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestCheckManifestGroupLimits(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	g := m.Groups["g-a"]
+	g.EnforceMaxBookings = true
+	g.MaxBookings = 2
+	g.EnforceMaxUsage = true
+	g.MaxUsage = time.Hour
+	m.Groups["g-a"] = g
+
+	err, msg := CheckManifest(m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	g.MaxBookings = 0
+	m.Groups["g-a"] = g
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"max_bookings must be positive in group g-a"}, msg)
+}
+
+func TestGroupLimits(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	g := m.Groups["g-a"]
+	g.EnforceMaxBookings = true
+	g.MaxBookings = 2
+	g.EnforceMaxUsage = true
+	g.MaxUsage = time.Hour
+	m.Groups["g-a"] = g
+
+	s := New()
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	// the limits are exported with the manifest
+	assert.Equal(t, g, s.ExportManifest().Groups["g-a"])
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+	s.AddGroupForUser("u-b", "g-a")
+
+	book := func(user string, sh, sm, eh, em int) (Booking, error) {
+		return s.MakeBooking("sl-a", user, interval.Interval{
+			Start: time.Date(2022, 11, 5, sh, sm, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, eh, em, 0, 0, time.UTC),
+		})
+	}
+
+	_, err = book("u-a", 2, 0, 2, 20)
+	assert.NoError(t, err)
+
+	b, err := book("u-b", 3, 0, 3, 20)
+	assert.NoError(t, err)
+
+	// each user has only one booking, but the group has reached its limit
+	_, err = book("u-b", 4, 0, 4, 10)
+	assert.Error(t, err)
+	assert.Equal(t, "group g-a currently has 2 current/future bookings which is at or exceeds the group limit of 2", err.Error())
+
+	ps, err := s.GetPolicyStatusFor("u-a", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]GroupStatus{
+		"g-a": GroupStatus{
+			BookingsRemaining:  0,
+			CurrentBookings:    2,
+			EnforceMaxBookings: true,
+			EnforceMaxUsage:    true,
+			Usage:              40 * time.Minute,
+			UsageRemaining:     20 * time.Minute,
+		},
+	}, ps.Groups)
+
+	err = s.CancelBooking(b, "test")
+	assert.NoError(t, err)
+
+	// there is room for another booking, but not for the usage requested
+	_, err = book("u-b", 4, 0, 4, 50)
+	assert.Error(t, err)
+	assert.Equal(t, "requested duration of 50m0s exceeds remaining usage limit of 40m0s for group g-a", err.Error())
+
+	_, err = book("u-b", 4, 0, 4, 40)
+	assert.NoError(t, err)
+
+	ps, err = s.GetPolicyStatusFor("u-b", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), ps.Groups["g-a"].BookingsRemaining)
+	assert.Equal(t, time.Duration(0), ps.Groups["g-a"].UsageRemaining)
+
+	// groups without limits are not included in the status
+	s.Groups["g-a"] = GroupDescribed{Policies: g.Policies}
+
+	ps, err = s.GetPolicyStatusFor("u-b", "p-a")
+	assert.NoError(t, err)
+	assert.Nil(t, ps.Groups)
+}
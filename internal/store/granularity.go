@@ -0,0 +1,112 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+)
+
+// alignmentTolerance allows bookings to be checked against the start alignment and duration step of a policy
+// without requiring nanosecond precision, because bookings end just before the next one starts (intervals
+// are closed) and availability is sent with millisecond precision
+const alignmentTolerance = time.Second
+
+// aligned returns true if t is on a multiple of d from midnight UTC, within the alignment tolerance
+func aligned(t time.Time, d time.Duration) bool {
+	rem := t.Sub(t.Truncate(d))
+	return rem <= alignmentTolerance || d-rem <= alignmentTolerance
+}
+
+// wholeSteps returns true if the duration is a whole number (at least one) of steps, within the alignment tolerance
+func wholeSteps(duration, step time.Duration) bool {
+	if duration+alignmentTolerance < step {
+		return false
+	}
+	rem := duration % step
+	return rem <= alignmentTolerance || step-rem <= alignmentTolerance
+}
+
+// checkGranularity checks that a booking starts on the alignment, and lasts for a whole number of steps,
+// required by the policy, if enforced. The start is not checked if checkStart is false, e.g. when
+// changing only the end of an existing booking.
+func checkGranularity(p Policy, when interval.Interval, checkStart bool) error {
+
+	if checkStart && p.EnforceStartAlignment && p.StartAlignment > 0 {
+		if !aligned(when.Start, p.StartAlignment) {
+			return errors.New("booking must start on a multiple of " + HumaniseDuration(p.StartAlignment) + " from midnight UTC")
+		}
+	}
+
+	if p.EnforceDurationStep && p.DurationStep > 0 {
+		duration := when.End.Sub(when.Start)
+		if !wholeSteps(duration, p.DurationStep) {
+			return errors.New("requested duration of " +
+				HumaniseDuration(duration) +
+				" is not a multiple of " +
+				HumaniseDuration(p.DurationStep))
+		}
+	}
+
+	return nil
+}
+
+// alignAvailability moves the start of each available interval on to the next start allowed by the policy,
+// and removes intervals that are then too short to hold a booking of one duration step, so that users
+// are only offered times they can book
+func alignAvailability(a []interval.Interval, p Policy) []interval.Interval {
+
+	if !p.EnforceStartAlignment && !p.EnforceDurationStep {
+		return a
+	}
+
+	fa := []interval.Interval{}
+
+	for _, i := range a {
+
+		// an interval starting just after an allowed start is left alone, because moving it back might
+		// overlap the booking before, but otherwise it is moved on to the next allowed start
+		if p.EnforceStartAlignment && p.StartAlignment > 0 {
+			t := i.Start.Truncate(p.StartAlignment)
+			if i.Start.Sub(t) > alignmentTolerance {
+				i.Start = t.Add(p.StartAlignment)
+			}
+		}
+
+		if !i.End.After(i.Start) {
+			continue
+		}
+
+		if p.EnforceDurationStep && p.DurationStep > 0 && i.End.Sub(i.Start)+alignmentTolerance < p.DurationStep {
+			continue
+		}
+
+		fa = append(fa, i)
+	}
+
+	return fa
+}
+
+// checkGranularityPolicy returns a message for each granularity setting of the policy that cannot be enforced
+func checkGranularityPolicy(name string, p Policy) []string {
+
+	msg := []string{}
+
+	if p.EnforceStartAlignment {
+		if p.StartAlignment <= 0 {
+			msg = append(msg, "start_alignment must be positive in policy "+name)
+		} else if (24*time.Hour)%p.StartAlignment != 0 {
+			msg = append(msg, "start_alignment of "+p.StartAlignment.String()+" does not divide a day exactly in policy "+name)
+		}
+	}
+
+	if p.EnforceDurationStep {
+		if p.DurationStep <= 0 {
+			msg = append(msg, "duration_step must be positive in policy "+name)
+		} else if p.EnforceMaxDuration && p.MaxDuration < p.DurationStep {
+			msg = append(msg, "max_duration of "+p.MaxDuration.String()+" is shorter than duration_step of "+p.DurationStep.String()+" in policy "+name)
+		}
+	}
+
+	return msg
+}
@@ -0,0 +1,165 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestCheckManifestGranularity(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	p := m.Policies["p-a"]
+	p.EnforceStartAlignment = true
+	p.StartAlignment = 15 * time.Minute
+	p.EnforceDurationStep = true
+	p.DurationStep = 5 * time.Minute
+	m.Policies["p-a"] = p
+
+	err, msg := CheckManifest(m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	p.StartAlignment = 7 * time.Minute
+	m.Policies["p-a"] = p
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"start_alignment of 7m0s does not divide a day exactly in policy p-a"}, msg)
+
+	p.StartAlignment = 15 * time.Minute
+	p.DurationStep = 0
+	m.Policies["p-a"] = p
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"duration_step must be positive in policy p-a"}, msg)
+}
+
+func TestAlignAvailability(t *testing.T) {
+
+	p := Policy{
+		EnforceStartAlignment: true,
+		StartAlignment:        15 * time.Minute,
+		EnforceDurationStep:   true,
+		DurationStep:          10 * time.Minute,
+	}
+
+	a := []interval.Interval{
+		// starts just after a booking ending on the quarter hour, so is left alone
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 1, 0, 0, 1, time.UTC),
+			End:   time.Date(2022, 11, 5, 1, 7, 0, 0, time.UTC),
+		},
+		// moved on to the next quarter hour
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 1, 22, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		},
+		// too short for one step once moved on to the quarter hour
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 2, 3, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 2, 20, 0, 0, time.UTC),
+		},
+		// nothing left once moved on to the quarter hour
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 2, 31, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 2, 44, 59, 999e6, time.UTC),
+		},
+	}
+
+	exp := []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		},
+	}
+
+	// the first interval is too short for one step, even though it is aligned
+	assert.Equal(t, exp, alignAvailability(a, p))
+
+	p.EnforceDurationStep = false
+	assert.Equal(t, 3, len(alignAvailability(a, p)))
+
+	p.EnforceStartAlignment = false
+	assert.Equal(t, a, alignAvailability(a, p))
+}
+
+func TestGranularity(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	p := m.Policies["p-a"]
+	p.EnforceStartAlignment = true
+	p.StartAlignment = 15 * time.Minute
+	p.EnforceDurationStep = true
+	p.DurationStep = 5 * time.Minute
+	m.Policies["p-a"] = p
+
+	s := New()
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	user := "u-a"
+	s.AddGroupForUser(user, "g-a")
+
+	book := func(sh, sm, ss, eh, em, es int) error {
+		_, err := s.MakeBooking("sl-a", user, interval.Interval{
+			Start: time.Date(2022, 11, 5, sh, sm, ss, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, eh, em, es, 0, time.UTC),
+		})
+		return err
+	}
+
+	err = book(1, 7, 0, 1, 52, 0)
+	assert.Error(t, err)
+	assert.Equal(t, "booking must start on a multiple of 15m0s from midnight UTC", err.Error())
+
+	err = book(1, 15, 0, 1, 22, 0)
+	assert.Error(t, err)
+	assert.Equal(t, "requested duration of 7m0s is not a multiple of 5m0s", err.Error())
+
+	err = book(1, 15, 0, 1, 30, 0)
+	assert.NoError(t, err)
+
+	// bookings can end just before the next one starts
+	_, err = s.MakeBooking("sl-a", user, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 1, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 44, 59, 999e6, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	err = book(2, 0, 0, 2, 5, 0)
+	assert.NoError(t, err)
+
+	a, err := s.GetAvailabilityBetween("sl-a", time.Time{}, time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC), 0)
+	assert.NoError(t, err)
+
+	exp := []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 1, 45, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 1, 59, 59, 999999999, time.UTC),
+		},
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 2, 15, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC),
+		},
+	}
+
+	assert.Equal(t, exp, a)
+
+	// the limit applies after the availability is aligned
+	a, err = s.GetAvailabilityBetween("sl-a", time.Time{}, time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, exp[:1], a)
+}
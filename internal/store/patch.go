@@ -0,0 +1,203 @@
+package store
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/practable/book/internal/diary"
+	"github.com/practable/book/internal/history"
+	log "github.com/sirupsen/logrus"
+)
+
+// ManifestPatch represents changes to individual entities of the manifest, so that admins do not
+// have to export, edit and replace the whole manifest. The entities in Set are added, or replace
+// any entity of the same kind with the same name, and then the entities named in Delete are removed.
+type ManifestPatch struct {
+	Delete ManifestDeletions `json:"delete,omitempty" yaml:"delete,omitempty"`
+	Set    Manifest          `json:"set,omitempty" yaml:"set,omitempty"`
+}
+
+// ManifestDeletions represents the names of the entities of each kind to delete from the manifest
+type ManifestDeletions struct {
+	Blackouts     []string `json:"blackouts,omitempty" yaml:"blackouts,omitempty"`
+	Descriptions  []string `json:"descriptions,omitempty" yaml:"descriptions,omitempty"`
+	DisplayGuides []string `json:"display_guides,omitempty" yaml:"display_guides,omitempty"`
+	Groups        []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Policies      []string `json:"policies,omitempty" yaml:"policies,omitempty"`
+	Pools         []string `json:"pools,omitempty" yaml:"pools,omitempty"`
+	Resources     []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Slots         []string `json:"slots,omitempty" yaml:"slots,omitempty"`
+	Streams       []string `json:"streams,omitempty" yaml:"streams,omitempty"`
+	UIs           []string `json:"uis,omitempty" yaml:"uis,omitempty"`
+	UISets        []string `json:"ui_sets,omitempty" yaml:"ui_sets,omitempty"`
+	Windows       []string `json:"windows,omitempty" yaml:"windows,omitempty"`
+}
+
+// PatchManifest applies the patch to the current manifest, checking the resulting manifest
+// as for CheckManifest before making any change, and returning the reasons for any failed checks.
+// The patch is refused if it would affect any existing bookings, as reported by DiffManifest,
+// e.g. by deleting their slot, and the affected bookings are returned as the reasons.
+// Resources that are kept retain their diaries, so their bookings are unaffected.
+// The patched manifest is retained as a version with no author.
+func (s *Store) PatchManifest(p ManifestPatch) (error, []string) {
+	return s.PatchManifestWithAuthor(p, "")
+}
+
+// PatchManifestWithAuthor applies the patch as for PatchManifest, and retains the patched
+// manifest as a version applied by the author (e.g. the subject of the admin's token)
+func (s *Store) PatchManifestWithAuthor(p ManifestPatch, author string) (error, []string) {
+	where := "store.PatchManifestWithAuthor"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	err, msg := s.patchManifest(p)
+
+	if err == nil {
+		s.addManifestVersion(author, s.now())
+		s.recordPayload(history.Action{Do: history.PatchManifest, User: author}, p)
+	}
+
+	return err, msg
+}
+
+// patchManifest applies the patch to the current manifest
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) patchManifest(p ManifestPatch) (error, []string) {
+
+	c := s.exportManifest()
+
+	msg := []string{}
+	msg = append(msg, missingEntities("blackout", c.Blackouts, p.Delete.Blackouts)...)
+	msg = append(msg, missingEntities("description", c.Descriptions, p.Delete.Descriptions)...)
+	msg = append(msg, missingEntities("display_guide", c.DisplayGuides, p.Delete.DisplayGuides)...)
+	msg = append(msg, missingEntities("group", c.Groups, p.Delete.Groups)...)
+	msg = append(msg, missingEntities("policy", c.Policies, p.Delete.Policies)...)
+	msg = append(msg, missingEntities("pool", c.Pools, p.Delete.Pools)...)
+	msg = append(msg, missingEntities("resource", c.Resources, p.Delete.Resources)...)
+	msg = append(msg, missingEntities("slot", c.Slots, p.Delete.Slots)...)
+	msg = append(msg, missingEntities("stream", c.Streams, p.Delete.Streams)...)
+	msg = append(msg, missingEntities("ui", c.UIs, p.Delete.UIs)...)
+	msg = append(msg, missingEntities("ui_set", c.UISets, p.Delete.UISets)...)
+	msg = append(msg, missingEntities("window", c.Windows, p.Delete.Windows)...)
+
+	if len(msg) > 0 {
+		return errors.New("missing entity"), msg
+	}
+
+	m := patchedManifest(c, p)
+
+	// the manifest is checked as part of the diff
+	d, err, msg := s.diffManifest(m)
+
+	if err != nil {
+		return err, msg
+	}
+
+	// a patch does not change any bookings, so it must not leave any without their slot, policy,
+	// resource or group, or outside their window (ReconcileManifest changes the bookings too)
+	if len(d.Bookings) > 0 {
+
+		msg := []string{}
+
+		for _, bi := range d.Bookings {
+			msg = append(msg, "booking "+bi.Booking+" of user "+bi.User+" on slot "+bi.Slot+" would be affected because "+strings.Join(bi.Reasons, ", "))
+		}
+
+		return errors.New("patch would affect existing bookings"), msg
+	}
+
+	err = s.replaceManifestKeepingDiaries(m)
+
+	if err != nil {
+		return err, []string{}
+	}
+
+	return nil, []string{}
+}
+
+// replaceManifestKeepingDiaries replaces the manifest, keeping the diaries of the resources that
+// are still in the manifest, so that their bookings, and availability, are unaffected
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replaceManifestKeepingDiaries(m Manifest) error {
+
+	diaries := make(map[string]*diary.Diary)
+
+	for k, r := range s.Resources {
+		diaries[k] = r.Diary
+	}
+
+	err := s.replaceManifest(m)
+
+	if err != nil {
+		return err
+	}
+
+	for k, r := range s.Resources {
+		if d, ok := diaries[k]; ok && d != nil {
+			d.SetBuffer(r.Buffer)
+			r.Diary = d
+			s.Resources[k] = r
+		}
+	}
+
+	return nil
+}
+
+// patchedManifest returns a copy of the manifest with the patch applied, without modifying the original
+func patchedManifest(m Manifest, p ManifestPatch) Manifest {
+
+	return Manifest{
+		Blackouts:     patchEntities(m.Blackouts, p.Set.Blackouts, p.Delete.Blackouts),
+		Descriptions:  patchEntities(m.Descriptions, p.Set.Descriptions, p.Delete.Descriptions),
+		DisplayGuides: patchEntities(m.DisplayGuides, p.Set.DisplayGuides, p.Delete.DisplayGuides),
+		Groups:        patchEntities(m.Groups, p.Set.Groups, p.Delete.Groups),
+		Policies:      patchEntities(m.Policies, p.Set.Policies, p.Delete.Policies),
+		Pools:         patchEntities(m.Pools, p.Set.Pools, p.Delete.Pools),
+		Resources:     patchEntities(m.Resources, p.Set.Resources, p.Delete.Resources),
+		Slots:         patchEntities(m.Slots, p.Set.Slots, p.Delete.Slots),
+		Streams:       patchEntities(m.Streams, p.Set.Streams, p.Delete.Streams),
+		UIs:           patchEntities(m.UIs, p.Set.UIs, p.Delete.UIs),
+		UISets:        patchEntities(m.UISets, p.Set.UISets, p.Delete.UISets),
+		Windows:       patchEntities(m.Windows, p.Set.Windows, p.Delete.Windows),
+	}
+}
+
+// patchEntities returns a new map of the current entities, with those in set added or replaced,
+// and then those named in del removed
+func patchEntities[T any](current, set map[string]T, del []string) map[string]T {
+
+	pm := make(map[string]T)
+
+	for k, v := range current {
+		pm[k] = v
+	}
+
+	for k, v := range set {
+		pm[k] = v
+	}
+
+	for _, k := range del {
+		delete(pm, k)
+	}
+
+	return pm
+}
+
+// missingEntities returns a message for each name in del that is not one of the current entities of the kind
+func missingEntities[T any](kind string, current map[string]T, del []string) []string {
+
+	msg := []string{}
+
+	for _, k := range del {
+		if _, ok := current[k]; !ok {
+			msg = append(msg, "cannot delete non-existent "+kind+": "+k)
+		}
+	}
+
+	return msg
+}
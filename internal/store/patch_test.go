@@ -0,0 +1,151 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestPatchManifest(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	book := func(sh, sm, eh, em int) (Booking, error) {
+		return s.MakeBooking("sl-a", "u-a", interval.Interval{
+			Start: time.Date(2022, 11, 5, sh, sm, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, eh, em, 0, 0, time.UTC),
+		})
+	}
+
+	_, err = book(2, 0, 2, 30)
+	assert.NoError(t, err)
+
+	w := m.Windows["w-a"]
+	w.Denied = []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 4, 0, 0, 0, time.UTC),
+		},
+	}
+
+	rc := Resource{
+		Description: "d-r-a",
+		Streams:     []string{"st-a"},
+		TopicStub:   "cccc00",
+	}
+
+	// the policy that lists the slot must be updated when the slot is deleted
+	p := m.Policies["p-start-in-past"]
+	p.Slots = []string{"sl-a"}
+
+	err, msg := s.PatchManifest(ManifestPatch{
+		Delete: ManifestDeletions{
+			Slots: []string{"sl-start-in-past"},
+		},
+		Set: Manifest{
+			Policies:  map[string]Policy{"p-start-in-past": p},
+			Resources: map[string]Resource{"r-c": rc},
+			Windows:   map[string]Window{"w-a": w},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	em := s.ExportManifest()
+	assert.Equal(t, rc, em.Resources["r-c"])
+	assert.Equal(t, w, em.Windows["w-a"])
+	_, ok := em.Slots["sl-start-in-past"]
+	assert.False(t, ok)
+	assert.Equal(t, len(m.Slots)-1, len(em.Slots))
+
+	// the existing booking is still in the diary
+	_, err = book(2, 10, 2, 20)
+	assert.Error(t, err)
+
+	// the patched window denies the new period
+	_, err = book(3, 10, 3, 20)
+	assert.Error(t, err)
+
+	_, err = book(4, 10, 4, 20)
+	assert.NoError(t, err)
+
+	// the resulting manifest is checked before any change is made
+	err, msg = s.PatchManifest(ManifestPatch{
+		Delete: ManifestDeletions{
+			Slots: []string{"sl-starts-within"},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"policy p-starts-within references non-existent slot: sl-starts-within"}, msg)
+
+	err, msg = s.PatchManifest(ManifestPatch{
+		Delete: ManifestDeletions{
+			Slots: []string{"sl-x"},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"cannot delete non-existent slot: sl-x"}, msg)
+
+	_, ok = s.ExportManifest().Slots["sl-starts-within"]
+	assert.True(t, ok)
+
+	// patches that would leave bookings without their slot are refused
+	pa := m.Policies["p-a"]
+	pa.Slots = []string{}
+	p.Slots = []string{}
+
+	err, msg = s.PatchManifest(ManifestPatch{
+		Delete: ManifestDeletions{
+			Slots: []string{"sl-a"},
+		},
+		Set: Manifest{
+			Policies: map[string]Policy{"p-a": pa, "p-start-in-past": p},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "patch would affect existing bookings", err.Error())
+	assert.Equal(t, 2, len(msg))
+	for _, v := range msg {
+		assert.Contains(t, v, "of user u-a on slot sl-a would be affected because slot sl-a is removed")
+	}
+
+	_, ok = s.ExportManifest().Slots["sl-a"]
+	assert.True(t, ok)
+
+	// replaying the patch gives the same manifest, and keeps the bookings in the diaries
+	s2 := New().WithHistory(h)
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	err, msg = s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	ey, err := yaml.Marshal(s.ExportManifest())
+	assert.NoError(t, err)
+	ay, err := yaml.Marshal(s2.ExportManifest())
+	assert.NoError(t, err)
+	assert.Equal(t, string(ey), string(ay))
+
+	_, err = s2.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 20, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,444 @@
+package store
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// SnapshotFile is the name of the file that the store is persisted to, within the persist directory
+const SnapshotFile = "store.yaml"
+
+// ErrBookingsNotRestored is returned when the rest of a snapshot was restored, but some of its bookings were not
+var ErrBookingsNotRestored = errors.New("some bookings could not be restored")
+
+// ResourceStatus represents the availability of a resource, so that it can be persisted
+type ResourceStatus struct {
+	Available bool   `json:"available" yaml:"available"`
+	Reason    string `json:"reason" yaml:"reason"`
+}
+
+// Snapshot represents everything that is needed to restore the store after a restart
+// We use yaml.v2 for the file format because it round-trips time.Duration as human-readable
+// strings, whereas the JSON unmarshallers expect strings but the marshallers produce integers
+type Snapshot struct {
+	Bookings    map[string]Booking        `json:"bookings" yaml:"bookings"`
+	Locked      bool                      `json:"locked" yaml:"locked"`
+	Manifest    Manifest                  `json:"manifest" yaml:"manifest"`
+	Message     string                    `json:"message" yaml:"message"`
+	OldBookings map[string]Booking        `json:"old_bookings" yaml:"old_bookings"`
+	Resources   map[string]ResourceStatus `json:"resources" yaml:"resources"`
+	Users       map[string]UserSnapshot   `json:"users" yaml:"users"`
+	Waitlist    map[string]WaitlistEntry  `json:"waitlist" yaml:"waitlist"`
+	// Shortened holds when the relay access to started bookings that have been shortened expires
+	Shortened map[string]time.Time `json:"shortened,omitempty" yaml:"shortened,omitempty"`
+	// ManifestVersions are the retained versions of the manifest, the last of which is Manifest
+	ManifestVersions []ManifestVersion `json:"manifest_versions,omitempty" yaml:"manifest_versions,omitempty"`
+	// HistoryIndex is the number of actions in the history when the snapshot was taken,
+	// so that only the actions recorded after the snapshot are replayed when restoring
+	HistoryIndex int `json:"history_index" yaml:"history_index"`
+	// Written is when the snapshot was taken, for information only
+	Written time.Time `json:"written" yaml:"written"`
+}
+
+// UserSnapshot represents the parts of a User that are not held in the bookings.
+// Usage is kept exactly, rather than humanised as it is in UserExternal
+type UserSnapshot struct {
+	Groups []string                 `json:"groups" yaml:"groups"`
+	Usage  map[string]time.Duration `json:"usage" yaml:"usage"`
+}
+
+// WithPersistDir sets the directory that the store is persisted to
+// Persistence is disabled if the directory is an empty string
+func (s *Store) WithPersistDir(dir string) *Store {
+	s.Lock()
+	defer s.Unlock()
+	s.persistDir = dir
+	return s
+}
+
+// WithPersistEvery sets how often the store is persisted while running
+func (s *Store) WithPersistEvery(d time.Duration) *Store {
+	s.Lock()
+	defer s.Unlock()
+	s.persistEvery = d
+	return s
+}
+
+// ExportSnapshot returns a copy of the state of the store that is needed to restore it later
+func (s *Store) ExportSnapshot() Snapshot {
+	where := "store.ExportSnapshot"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	return s.exportSnapshot()
+}
+
+// exportSnapshot returns a copy of the state of the store
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) exportSnapshot() Snapshot {
+
+	bm := make(map[string]Booking)
+	for k, v := range s.Bookings {
+		bm[k] = *v
+	}
+
+	obm := make(map[string]Booking)
+	for k, v := range s.OldBookings {
+		obm[k] = *v
+	}
+
+	rm := make(map[string]ResourceStatus)
+	for k, v := range s.Resources {
+		ok, reason := v.Diary.IsAvailable()
+		if !ok {
+			// IsAvailable decorates the reason when unavailable, so use the undecorated version
+			reason = v.Diary.Status()
+		}
+		rm[k] = ResourceStatus{
+			Available: ok,
+			Reason:    reason,
+		}
+	}
+
+	um := make(map[string]UserSnapshot)
+	for k, v := range s.Users {
+		gs := []string{}
+		for g := range v.Groups {
+			gs = append(gs, g)
+		}
+		ud := make(map[string]time.Duration)
+		for p, d := range v.Usage {
+			ud[p] = *d
+		}
+		um[k] = UserSnapshot{
+			Groups: gs,
+			Usage:  ud,
+		}
+	}
+
+	wm := make(map[string]WaitlistEntry)
+	for k, v := range s.Waitlist {
+		wm[k] = *v
+	}
+
+	sm := make(map[string]time.Time)
+	for k, v := range s.shortened {
+		sm[k] = v
+	}
+
+	hi := 0
+
+	if s.history != nil {
+		hi = s.history.Len()
+	}
+
+	return Snapshot{
+		HistoryIndex: hi,
+		Bookings:     bm,
+		Locked:       s.Locked,
+		Manifest:     s.exportManifest(),
+		Message:      s.Message,
+		OldBookings:  obm,
+		Resources:    rm,
+		Shortened:    sm,
+		Users:        um,
+		Waitlist:     wm,
+		Written:      s.now(),
+
+		// copy, so that the snapshot does not change with the store
+		ManifestVersions: append([]ManifestVersion{}, s.ManifestVersions...),
+	}
+}
+
+// RestoreSnapshot replaces the manifest, bookings, old bookings and users with those in the snapshot
+// Bookings are restored as they were, without re-applying policy, because a live booking that was
+// valid when it was made (e.g. started in the past) may not pass the policy checks now.
+// Bookings that cannot be restored are reported in the messages, but do not prevent the
+// rest of the snapshot being restored.
+func (s *Store) RestoreSnapshot(sn Snapshot) (error, []string) {
+	where := "store.RestoreSnapshot"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	return s.restoreSnapshot(sn)
+}
+
+// restoreSnapshot replaces the state of the store with that in the snapshot
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) restoreSnapshot(sn Snapshot) (error, []string) {
+
+	err, msg := checkManifest(sn.Manifest)
+
+	if err != nil {
+		return err, msg
+	}
+
+	err = s.replaceManifest(sn.Manifest)
+
+	if err != nil {
+		return err, []string{}
+	}
+
+	msg = []string{}
+
+	s.Users = make(map[string]*User)
+
+	for k, v := range sn.Users {
+		u := NewUser()
+		for _, g := range v.Groups {
+			u.Groups[g] = true
+		}
+		for p, d := range v.Usage {
+			ud := d
+			u.Usage[p] = &ud
+		}
+		s.Users[k] = u
+	}
+
+	s.OldBookings = make(map[string]*Booking)
+
+	for k, v := range sn.OldBookings {
+		ob := v
+		s.OldBookings[k] = &ob
+		u := s.getOrCreateUser(ob.User)
+		u.OldBookings[k] = &ob
+	}
+
+	s.Bookings = make(map[string]*Booking)
+
+	for k, v := range sn.Bookings {
+
+		b := v
+
+		err, ms := s.checkBooking(b)
+
+		if err != nil {
+			msg = append(msg, ms...)
+			continue
+		}
+
+		p := s.Policies[b.Policy]
+
+		if !p.EnforceUnlimitedUsers {
+			r := s.Resources[s.bookingResource(b)]
+			err := r.Diary.Request(b.When, b.Name)
+			if err != nil {
+				msg = append(msg, "booking "+k+" could not be restored because "+err.Error())
+				continue
+			}
+		}
+
+		s.Bookings[k] = &b
+		u := s.getOrCreateUser(b.User)
+		u.Bookings[k] = &b
+	}
+
+	// availability is set after the bookings are restored, because
+	// unavailable diaries do not accept bookings
+	for k, v := range sn.Resources {
+		r, ok := s.Resources[k]
+		if !ok {
+			continue
+		}
+		if v.Available {
+			r.Diary.SetAvailable(v.Reason)
+		} else {
+			r.Diary.SetUnavailable(v.Reason)
+		}
+	}
+
+	s.Waitlist = make(map[string]*WaitlistEntry)
+
+	for k, v := range sn.Waitlist {
+		w := v
+		s.Waitlist[k] = &w
+	}
+
+	s.shortened = make(map[string]time.Time)
+
+	for k, v := range sn.Shortened {
+		s.shortened[k] = v
+	}
+
+	s.ManifestVersions = append([]ManifestVersion{}, sn.ManifestVersions...)
+	s.trimManifestVersions()
+
+	s.Locked = sn.Locked
+	s.Message = sn.Message
+
+	// bookings are restored from the snapshot, so replace any pending grace checks
+	s.requestGraceChecks()
+
+	if len(msg) > 0 {
+		return ErrBookingsNotRestored, msg
+	}
+
+	return nil, []string{}
+}
+
+// Persist writes a snapshot of the store to the persist directory, if one is set
+// The snapshot is written to a temporary file first, and then renamed,
+// so that a crash during writing does not destroy the previous snapshot
+func (s *Store) Persist() error {
+	where := "store.Persist"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	return s.persist()
+}
+
+// persist writes a snapshot of the store to the persist directory
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) persist() error {
+
+	if s.persistDir == "" {
+		return nil
+	}
+
+	d, err := yaml.Marshal(s.exportSnapshot())
+
+	if err != nil {
+		return errors.New("could not marshal snapshot because " + err.Error())
+	}
+
+	err = os.MkdirAll(s.persistDir, 0700)
+
+	if err != nil {
+		return errors.New("could not create persist directory because " + err.Error())
+	}
+
+	f, err := ioutil.TempFile(s.persistDir, SnapshotFile+".*.tmp")
+
+	if err != nil {
+		return errors.New("could not create temporary snapshot file because " + err.Error())
+	}
+
+	_, err = f.Write(d)
+
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+		return errors.New("could not write snapshot because " + err.Error())
+	}
+
+	err = os.Rename(f.Name(), filepath.Join(s.persistDir, SnapshotFile))
+
+	if err != nil {
+		os.Remove(f.Name())
+		return errors.New("could not replace snapshot because " + err.Error())
+	}
+
+	log.Debugf("store persisted to %s", s.persistDir)
+
+	return nil
+}
+
+// Restore loads the snapshot in the persist directory, if there is one,
+// and then replays any actions recorded in the history since the snapshot was written.
+// It is not an error for there to be no snapshot, because that is the
+// case the first time that the store is run. If some bookings in the snapshot cannot be restored,
+// the history is still replayed, and ErrBookingsNotRestored is returned with the messages.
+func (s *Store) Restore() (error, []string) {
+	where := "store.Restore"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	if s.persistDir == "" {
+		return nil, []string{}
+	}
+
+	fn := filepath.Join(s.persistDir, SnapshotFile)
+
+	d, err := ioutil.ReadFile(fn)
+
+	if os.IsNotExist(err) {
+		log.Infof("no snapshot found at %s, starting with empty store", fn)
+		return s.replayHistory(0)
+	}
+
+	if err != nil {
+		return errors.New("could not read snapshot because " + err.Error()), []string{}
+	}
+
+	sn := Snapshot{}
+
+	err = yaml.Unmarshal(d, &sn)
+
+	if err != nil {
+		return errors.New("could not unmarshal snapshot because " + err.Error()), []string{}
+	}
+
+	err, msg := s.restoreSnapshot(sn)
+
+	if err != nil && err != ErrBookingsNotRestored {
+		return err, msg
+	}
+
+	// the journal must be replayed even if some bookings were not restored, otherwise the actions
+	// taken since the snapshot would be lost when the next snapshot moves the history index on
+	rerr, rmsg := s.replayHistory(sn.HistoryIndex)
+
+	msg = append(msg, rmsg...)
+
+	if rerr != nil {
+		return rerr, msg
+	}
+
+	return err, msg
+}
+
+// replayHistory replays the actions recorded in the history after the given index, if there is a history,
+// so as to bring the store up to date with the actions taken since the snapshot was written
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replayHistory(index int) (error, []string) {
+
+	if s.history == nil {
+		return nil, []string{}
+	}
+
+	return s.replay(s.history.NewReplayFrom(index))
+}
+
+// getOrCreateUser returns the user, creating them if they do not exist
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) getOrCreateUser(user string) *User {
+	u, ok := s.Users[user]
+	if !ok {
+		u = NewUser()
+		s.Users[user] = u
+	}
+	return u
+}
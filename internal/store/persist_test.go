@@ -0,0 +1,203 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestPersistRestore(t *testing.T) {
+
+	dir := t.TempDir()
+
+	s := New().WithPersistDir(dir)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	err = s.AddGroupForUser("user1", "g-b")
+	assert.NoError(t, err)
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk0", true)
+	assert.NoError(t, err)
+
+	b1, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 7, 0, 0, time.UTC),
+	}, "bk1", true)
+	assert.NoError(t, err)
+
+	err = s.CancelBooking(b0, "user")
+	assert.NoError(t, err)
+
+	err = s.SetResourceIsAvailable("r-a", false, "broken")
+	assert.NoError(t, err)
+
+	s.Locked = true
+	s.Message = "down for maintenance"
+
+	err = s.Persist()
+	assert.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, SnapshotFile))
+	assert.NoError(t, err)
+
+	// restore into a fresh store, e.g. after a restart
+	s2 := New().WithPersistDir(dir)
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 5, 0, 0, time.UTC) })
+
+	err, msg := s2.Restore()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	// nil slices become empty slices in the round trip, so compare the yaml
+	em, err := yaml.Marshal(s.ExportManifest())
+	assert.NoError(t, err)
+	am, err := yaml.Marshal(s2.ExportManifest())
+	assert.NoError(t, err)
+	assert.Equal(t, string(em), string(am))
+
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportOldBookings(), s2.ExportOldBookings())
+
+	// cancelled bookings are only moved to a user's old bookings when pruned
+	s.PruneAll()
+	assert.Equal(t, s.ExportUsers(), s2.ExportUsers())
+	assert.True(t, s2.Locked)
+	assert.Equal(t, "down for maintenance", s2.Message)
+
+	ok, reason, err := s2.GetResourceIsAvailable("r-a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "unavailable because broken", reason)
+
+	// usage is restored exactly, not humanised
+	assert.Equal(t, 7*time.Minute, *s2.Users["user1"].Usage["p-b"])
+
+	// live booking is in the diary, so the slot cannot be double booked
+	_, err = s2.MakeBookingWithName("sl-b", "user2", b1.When, "bk2", false)
+	assert.Error(t, err)
+
+	// restored booking can be cancelled, refunding the user
+	err = s2.CancelBooking(b1, "user")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), *s2.Users["user1"].Usage["p-b"])
+
+}
+
+func TestRestoreWithoutSnapshot(t *testing.T) {
+
+	s := New().WithPersistDir(t.TempDir())
+
+	err, msg := s.Restore()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, 0, len(s.Bookings))
+}
+
+func TestRestoreSnapshotReportsBadBookings(t *testing.T) {
+
+	s := New()
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+	}
+
+	sn := Snapshot{
+		Bookings: map[string]Booking{
+			"bk0": Booking{Name: "bk0", Policy: "p-a", Slot: "sl-a", User: "u", When: when},
+			"bk1": Booking{Name: "bk1", Policy: "p-a", Slot: "sl-missing", User: "u", When: when},
+		},
+		Manifest: m,
+	}
+
+	err, msg := s.RestoreSnapshot(sn)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"bk1 slot sl-missing not found"}, msg)
+
+	_, err = s.GetBooking("bk0")
+	assert.NoError(t, err)
+}
+
+func TestRestoreReplaysJournalAfterBadBookings(t *testing.T) {
+
+	dir := t.TempDir()
+
+	h := history.New("test")
+
+	s := New().WithPersistDir(dir).WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	err = s.Persist()
+	assert.NoError(t, err)
+
+	// add a booking that cannot be restored to the snapshot
+	fn := filepath.Join(dir, SnapshotFile)
+	d, err := ioutil.ReadFile(fn)
+	assert.NoError(t, err)
+
+	sn := Snapshot{}
+	err = yaml.Unmarshal(d, &sn)
+	assert.NoError(t, err)
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+	}
+
+	sn.Bookings = map[string]Booking{
+		"bk-bad": Booking{Name: "bk-bad", Policy: "p-a", Slot: "sl-missing", User: "u", When: when},
+	}
+
+	d, err = yaml.Marshal(sn)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(fn, d, 0600)
+	assert.NoError(t, err)
+
+	// action recorded in the journal after the snapshot was taken
+	_, err = s.MakeBookingWithName("sl-a", "u", when, "bk-after", false)
+	assert.NoError(t, err)
+
+	s2 := New().WithPersistDir(dir).WithHistory(h)
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	err, msg := s2.Restore()
+	assert.Equal(t, ErrBookingsNotRestored, err)
+	assert.Equal(t, []string{"bk-bad slot sl-missing not found"}, msg)
+
+	_, err = s2.GetBooking("bk-after")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/practable/book/internal/history"
+	log "github.com/sirupsen/logrus"
+)
+
+// bookingResource returns the name of the resource that holds the booking in its diary,
+// which is the slot's resource, unless another resource has been substituted for it
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) bookingResource(b Booking) string {
+
+	if b.Resource != "" {
+		return b.Resource
+	}
+
+	return s.Slots[b.Slot].Resource
+}
+
+// getPoolFor returns the other resources in the same pool as the resource, in order of name
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) getPoolFor(resource string) []string {
+
+	rs := []string{}
+
+	for _, p := range s.Pools {
+
+		found := false
+
+		for _, r := range p.Resources {
+			if r == resource {
+				found = true
+			}
+		}
+
+		if !found {
+			continue
+		}
+
+		for _, r := range p.Resources {
+			if r != resource {
+				rs = append(rs, r)
+			}
+		}
+	}
+
+	sort.Strings(rs)
+
+	return rs
+}
+
+// substituteBookings moves the bookings on a resource that has gone offline onto other resources in
+// the same pool that are available and free at the time of the booking. Only bookings that have not
+// yet ended or been started are moved, in order of their start time. Each substitution is recorded,
+// rather than being repeated during replay, because the choice of resource depends on the time it
+// was made. Bookings that cannot be moved are left where they are, so that they remain unfulfilled.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) substituteBookings(resource string) []Booking {
+
+	moved := []Booking{}
+
+	if s.replaying {
+		return moved
+	}
+
+	pool := s.getPoolFor(resource)
+
+	if len(pool) == 0 {
+		return moved
+	}
+
+	bs := []*Booking{}
+
+	for _, b := range s.Bookings {
+
+		if s.bookingResource(*b) != resource || b.Started || !b.When.End.After(s.now()) {
+			continue
+		}
+
+		if p, ok := s.Policies[b.Policy]; !ok || p.EnforceUnlimitedUsers {
+			continue // not in the diary, so there is nothing to move
+		}
+
+		bs = append(bs, b)
+	}
+
+	sort.Slice(bs, func(i, j int) bool {
+		if bs[i].When.Start.Equal(bs[j].When.Start) {
+			return bs[i].Name < bs[j].Name
+		}
+		return bs[i].When.Start.Before(bs[j].When.Start)
+	})
+
+	for _, b := range bs {
+
+		for _, rn := range pool {
+
+			err := s.substituteBooking(b.Name, rn)
+
+			if err != nil {
+				log.WithFields(log.Fields{"booking": b.Name, "resource": rn}).Debugf("substitute resource not used because %s", err.Error())
+				continue
+			}
+
+			s.record(history.Action{
+				Do:       history.SubstituteBooking,
+				Booking:  b.Name,
+				Resource: rn,
+			})
+
+			log.WithFields(log.Fields{"booking": b.Name, "from": resource, "to": rn}).Info("substituted resource for booking")
+
+			moved = append(moved, *b)
+
+			break
+		}
+	}
+
+	return moved
+}
+
+// substituteBooking moves a booking into the diary of another resource, which must be available and free
+// at the time of the booking. Moving the booking back to the slot's own resource clears the substitution.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) substituteBooking(name, resource string) error {
+
+	b, ok := s.Bookings[name]
+
+	if !ok {
+		return errors.New("booking " + name + " not found")
+	}
+
+	to, ok := s.Resources[resource]
+
+	if !ok {
+		return errors.New("resource " + resource + " not found")
+	}
+
+	from, ok := s.Resources[s.bookingResource(*b)]
+
+	if !ok {
+		return errors.New("resource " + s.bookingResource(*b) + " not found")
+	}
+
+	// unavailable diaries do not accept bookings, so this also checks the availability
+	err := to.Diary.Request(b.When, b.Name)
+
+	if err != nil {
+		return err
+	}
+
+	err = from.Diary.Delete(b.Name)
+
+	if err != nil {
+		to.Diary.Delete(b.Name)
+		return err
+	}
+
+	if resource == s.Slots[b.Slot].Resource {
+		b.Resource = ""
+	} else {
+		b.Resource = resource
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestCheckManifestPools(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	m.Pools = map[string]Pool{
+		"pl-ab": Pool{Description: "d-r-a", Resources: []string{"r-a", "r-b"}},
+	}
+
+	err, msg := CheckManifest(m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	m.Pools["pl-x"] = Pool{Description: "d-r-b", Resources: []string{"r-x"}}
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"pool pl-x references non-existent resource: r-x"}, msg)
+
+	m.Pools["pl-x"] = Pool{Description: "d-r-b", Resources: []string{"r-b", "r-simulation"}}
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, 1, len(msg))
+}
+
+func TestSubstituteBookings(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	m.Pools = map[string]Pool{
+		"pl-ab": Pool{Description: "d-r-a", Resources: []string{"r-a", "r-b"}},
+	}
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	err = s.AddGroupForUser("user1", "g-a")
+	assert.NoError(t, err)
+	err = s.AddGroupForUser("user2", "g-b")
+	assert.NoError(t, err)
+
+	w0 := interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}
+	w1 := interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+	}
+
+	_, err = s.MakeBookingWithName("sl-a", "user1", w0, "bk0", true)
+	assert.NoError(t, err)
+	_, err = s.MakeBookingWithName("sl-a", "user1", w1, "bk1", true)
+	assert.NoError(t, err)
+
+	// r-b is already booked when bk1 is, so bk1 cannot be moved
+	_, err = s.MakeBookingWithName("sl-b", "user2", w1, "bk2", true)
+	assert.NoError(t, err)
+
+	err = s.SetResourceIsAvailable("r-a", false, "broken")
+	assert.NoError(t, err)
+
+	b0, err := s.GetBooking("bk0")
+	assert.NoError(t, err)
+	assert.Equal(t, "r-b", b0.Resource)
+	b1, err := s.GetBooking("bk1")
+	assert.NoError(t, err)
+	assert.Equal(t, "", b1.Resource)
+
+	bs, err := s.GetDiaryFor("r-b")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(bs))
+	assert.Equal(t, "bk0", bs[0].Name)
+	assert.Equal(t, "bk2", bs[1].Name)
+
+	// the substitute resource is booked, so the time is not free on the slot
+	_, err = s.MakeBookingWithName("sl-b", "user2", w0, "bk3", true)
+	assert.Error(t, err)
+
+	// substitutions are replayed from the history
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 1, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+
+	// cancelling frees the time on the substitute resource
+	err = s.CancelBooking(b0, "user1")
+	assert.NoError(t, err)
+
+	bs, err = s.GetDiaryFor("r-b")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(bs))
+
+	_, err = s.MakeBookingWithName("sl-b", "user2", w0, "bk3", true)
+	assert.NoError(t, err)
+
+	// the activity is on the substitute resource
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 31, 0, 0, time.UTC) })
+
+	a, err := s2.GetActivity(b0)
+	assert.NoError(t, err)
+	assert.Equal(t, "bbbb00-st-a", a.Streams["st-a"].Topic)
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// Bumped is the CancelledBy reason given to bookings displaced by a booking under a policy of higher priority
+const Bumped = "bumped"
+
+// bumpLowerPriority cancels the bookings in the resource's diary that conflict with a booking requested under
+// the policy, if every one of them is under a policy of lower priority, and can be cancelled. Otherwise, nothing
+// is cancelled, and the conflict is left for the diary to reject. Bookings that have started are cancelled at the
+// relay(s) too, as for any other cancellation. If any cancellation fails, those already made are reversed, so
+// that either every conflicting booking is bumped, or none are. The bumped bookings are returned, so that
+// the caller can restore them with restoreBumped if the new booking cannot be made after all.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) bumpLowerPriority(r Resource, p Policy, when interval.Interval, name string) ([]*Booking, error) {
+
+	if p.Priority <= 0 || p.EnforceUnlimitedUsers || r.Diary == nil {
+		return []*Booking{}, nil
+	}
+
+	// don't bump bookings for a request that the diary will reject anyway
+	if ok, _ := r.Diary.IsAvailable(); !ok {
+		return []*Booking{}, nil
+	}
+
+	conflicts := r.Diary.GetBookingsOverlapping(r.Diary.Occupied(when))
+
+	victims := []Booking{}
+	var started *Booking
+
+	for _, c := range conflicts {
+
+		b, ok := s.Bookings[c.Name]
+
+		if !ok {
+			return []*Booking{}, nil
+		}
+
+		if bp, ok := s.Policies[b.Policy]; ok && bp.Priority >= p.Priority {
+			return []*Booking{}, nil
+		}
+
+		if b.Started && !s.replaying {
+
+			// a started booking cannot be cancelled, so the conflict stands
+			if s.DisableCancelAfterUse {
+				return []*Booking{}, nil
+			}
+
+			// there can only be one booking in progress on a resource
+			sb := *b
+			started = &sb
+			continue
+		}
+
+		victims = append(victims, *b)
+	}
+
+	// the cancellation of a started booking cannot be reversed at the relay, so
+	// it goes last, after every cancellation that could need reversing has succeeded
+	if started != nil {
+		victims = append(victims, *started)
+	}
+
+	bumped := []*Booking{}
+
+	for _, b := range victims {
+
+		err := s.cancelBooking(b, Bumped)
+
+		if err != nil {
+			s.restoreBumped(bumped)
+			return []*Booking{}, errors.New("could not bump booking " + b.Name + " because " + err.Error())
+		}
+
+		bumped = append(bumped, s.OldBookings[b.Name])
+	}
+
+	for _, b := range bumped {
+		log.WithFields(log.Fields{"user": b.User, "booking": b.Name, "by": name}).Info("booking bumped by higher priority booking")
+	}
+
+	return bumped, nil
+}
+
+// restoreBumped reverses the cancellation of bookings that were bumped, e.g. because the booking
+// that bumped them could not be made after all
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) restoreBumped(bumped []*Booking) {
+
+	for _, b := range bumped {
+
+		err := s.uncancelBooking(b)
+
+		if err != nil {
+			// should not happen, because the diary and usage have only just been freed
+			log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Errorf("could not restore bumped booking because %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/deny"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+// priorityManifest adds a high priority policy for staff, with a slot on the same resource as sl-a
+func priorityManifest(t *testing.T) Manifest {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	m.Policies["p-demo"] = Policy{
+		Description: "d-p-a",
+		Priority:    1,
+		Slots:       []string{"sl-demo"},
+	}
+
+	m.Slots["sl-demo"] = Slot{
+		Description: "d-sl-a",
+		Policy:      "p-demo",
+		Resource:    "r-a",
+		UISet:       "us-a",
+		Window:      "w-a",
+	}
+
+	m.Groups["g-staff"] = Group{
+		Description: "d-g-a",
+		Policies:    []string{"p-demo"},
+	}
+
+	return m
+}
+
+func TestBumpLowerPriority(t *testing.T) {
+
+	s := New()
+	err := s.ReplaceManifest(priorityManifest(t))
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+	s.AddGroupForUser("u-s", "g-staff")
+
+	book := func(slot, user string, sh, sm, eh, em int) (Booking, error) {
+		return s.MakeBooking(slot, user, interval.Interval{
+			Start: time.Date(2022, 11, 5, sh, sm, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, eh, em, 0, 0, time.UTC),
+		})
+	}
+
+	b0, err := book("sl-a", "u-a", 2, 0, 2, 30)
+	assert.NoError(t, err)
+
+	b1, err := book("sl-a", "u-a", 3, 0, 3, 30)
+	assert.NoError(t, err)
+
+	b2, err := book("sl-a", "u-a", 4, 0, 4, 30)
+	assert.NoError(t, err)
+
+	ps, err := s.GetPolicyStatusFor("u-a", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, ps.Usage)
+
+	// the demo overlaps the first two bookings
+	d, err := book("sl-demo", "u-s", 2, 15, 3, 10)
+	assert.NoError(t, err)
+
+	for _, b := range []Booking{b0, b1} {
+		_, ok := s.Bookings[b.Name]
+		assert.False(t, ok)
+		ob, ok := s.OldBookings[b.Name]
+		assert.True(t, ok)
+		assert.True(t, ob.Cancelled)
+		assert.Equal(t, Bumped, ob.CancelledBy)
+	}
+
+	_, ok := s.Bookings[b2.Name]
+	assert.True(t, ok)
+
+	// bumped bookings that had not started are refunded in full
+	ps, err = s.GetPolicyStatusFor("u-a", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, ps.Usage)
+
+	// lower priority bookings cannot bump higher priority bookings
+	_, err = book("sl-a", "u-a", 2, 45, 3, 0)
+	assert.Error(t, err)
+
+	// nor can bookings of the same priority bump each other
+	_, err = book("sl-demo", "u-s", 3, 0, 3, 20)
+	assert.Error(t, err)
+
+	_, ok = s.Bookings[d.Name]
+	assert.True(t, ok)
+}
+
+func TestBumpStartedBooking(t *testing.T) {
+
+	drc := make(chan deny.Request, 2)
+
+	req := []deny.Request{}
+
+	closed := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-closed:
+				return
+			case r, ok := <-drc:
+				if ok {
+					req = append(req, r)
+					r.Result <- "ok" //mock successful denial
+				}
+			}
+		}
+	}()
+
+	s := New().
+		WithRequestTimeout(time.Second).
+		WithDisableCancelAfterUse(false).
+		WithDenyRequests(drc)
+
+	err := s.ReplaceManifest(priorityManifest(t))
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+	s.AddGroupForUser("u-s", "g-staff")
+
+	b, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 5, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 35, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC) })
+
+	_, err = s.GetActivity(b)
+	assert.NoError(t, err)
+
+	_, err = s.MakeBooking("sl-demo", "u-s", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 45, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	// one deny request for each relay used by the resource's streams
+	assert.Equal(t, 2, len(req))
+	assert.Equal(t, b.Name, req[0].BookingID)
+
+	// charged only for the time used before being bumped
+	ps, err := s.GetPolicyStatusFor("u-a", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, ps.Usage)
+
+	close(closed)
+}
+
+func TestBumpIsAllOrNothing(t *testing.T) {
+
+	drc := make(chan deny.Request, 2)
+
+	closed := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-closed:
+				return
+			case r, ok := <-drc:
+				if ok {
+					r.Result <- "failed" //mock failed denial
+				}
+			}
+		}
+	}()
+
+	s := New().
+		WithRequestTimeout(time.Second).
+		WithDisableCancelAfterUse(false).
+		WithDenyRequests(drc)
+
+	err := s.ReplaceManifest(priorityManifest(t))
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+	s.AddGroupForUser("u-s", "g-staff")
+
+	b0, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 5, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 35, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	b1, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC) })
+
+	_, err = s.GetActivity(b0)
+	assert.NoError(t, err)
+
+	ps, err := s.GetPolicyStatusFor("u-a", "p-a")
+	assert.NoError(t, err)
+
+	// the started booking cannot be cancelled at the relay, so neither booking is bumped
+	_, err = s.MakeBooking("sl-demo", "u-s", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 55, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	for _, b := range []Booking{b0, b1} {
+		_, ok := s.Bookings[b.Name]
+		assert.True(t, ok)
+		_, ok = s.OldBookings[b.Name]
+		assert.False(t, ok)
+	}
+
+	ps2, err := s.GetPolicyStatusFor("u-a", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, ps.Usage, ps2.Usage)
+
+	// a started booking that cannot be cancelled after use is not bumped either, nor are any others
+	s.DisableCancelAfterUse = true
+
+	_, err = s.MakeBooking("sl-demo", "u-s", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 55, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	_, ok := s.Bookings[b1.Name]
+	assert.True(t, ok)
+
+	close(closed)
+}
+
+func TestBumpAnySlotOnlyIfNoSlotIsFree(t *testing.T) {
+
+	m := priorityManifest(t)
+
+	p := m.Policies["p-demo"]
+	p.Slots = []string{"sl-demo", "sl-demo2"}
+	m.Policies["p-demo"] = p
+
+	m.Slots["sl-demo2"] = Slot{
+		Description: "d-sl-a",
+		Policy:      "p-demo",
+		Resource:    "r-b",
+		UISet:       "us-a",
+		Window:      "w-a",
+	}
+
+	s := New()
+	err := s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+	s.AddGroupForUser("u-s", "g-staff")
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 30, 0, 0, time.UTC),
+	}
+
+	b0, err := s.MakeBooking("sl-a", "u-a", when)
+	assert.NoError(t, err)
+
+	// sl-demo is tried first, but conflicts with b0, so the free slot sl-demo2 is booked instead
+	d0, err := s.MakeBookingAnySlot("p-demo", "", "u-s", when)
+	assert.NoError(t, err)
+	assert.Equal(t, "sl-demo2", d0.Slot)
+
+	_, ok := s.Bookings[b0.Name]
+	assert.True(t, ok)
+
+	// with no slot free, b0 is bumped
+	d1, err := s.MakeBookingAnySlot("p-demo", "", "u-s", when)
+	assert.NoError(t, err)
+	assert.Equal(t, "sl-demo", d1.Slot)
+
+	_, ok = s.Bookings[b0.Name]
+	assert.False(t, ok)
+	assert.Equal(t, Bumped, s.OldBookings[b0.Name].CancelledBy)
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+)
+
+// Termly is the usage period for policies that limit usage per term, where the
+// terms are listed in the policy. Daily and weekly usage periods use the same
+// names as the recurrence frequencies.
+const Termly = "termly"
+
+// usagePeriod returns the usage period of the policy that contains t, and false if there is none,
+// e.g. because t is between terms. Periods run from the start of one day, week or term up to,
+// but not including, the start of the next. Weeks start on Monday, in UTC.
+func (p Policy) usagePeriod(t time.Time) (interval.Interval, bool) {
+
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch p.UsagePeriod {
+
+	case Daily:
+		return interval.Interval{Start: day, End: day.AddDate(0, 0, 1)}, true
+
+	case Weekly:
+		start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return interval.Interval{Start: start, End: start.AddDate(0, 0, 7)}, true
+
+	case Termly:
+		for _, term := range p.UsageTerms {
+			if !t.Before(term.Start) && t.Before(term.End) {
+				return term, true
+			}
+		}
+	}
+
+	return interval.Interval{}, false
+}
+
+// periodUsage returns the usage charged to the user under the policy for bookings starting in the period,
+// excluding the booking with the name given in exclude (if any)
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) periodUsage(user, policy string, p Policy, period interval.Interval, exclude string) (time.Duration, error) {
+
+	usage := time.Duration(0)
+
+	u, ok := s.Users[user]
+
+	if !ok {
+		return usage, nil
+	}
+
+	// a booking is in both maps while it is being moved from one to the other, so only count it once
+	counted := make(map[string]bool)
+
+	for _, bm := range []map[string]*Booking{u.Bookings, u.OldBookings} {
+
+		for k, b := range bm {
+
+			if k == exclude || counted[k] || b.Policy != policy {
+				continue
+			}
+
+			if b.When.Start.Before(period.Start) || !b.When.Start.Before(period.End) {
+				continue
+			}
+
+			d, err := calculateUsage(*b, p)
+
+			if err != nil {
+				return usage, err
+			}
+
+			usage += d
+			counted[k] = true
+		}
+	}
+
+	return usage, nil
+}
+
+// checkPeriodUsage checks that the booking would not take the user over the maximum usage allowed in the
+// usage period in which it starts, if enforced by the policy. The booking with the name given in exclude
+// is not counted, so that an existing booking can be checked as if it were being made again.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) checkPeriodUsage(user, policy string, p Policy, when interval.Interval, exclude string) error {
+
+	if !p.EnforceMaxUsagePerPeriod {
+		return nil
+	}
+
+	period, ok := p.usagePeriod(when.Start)
+
+	if !ok {
+		return nil
+	}
+
+	usage, err := s.periodUsage(user, policy, p, period, exclude)
+
+	if err != nil {
+		return err
+	}
+
+	duration := when.End.Sub(when.Start)
+
+	if usage+duration > p.MaxUsagePerPeriod {
+		remaining := p.MaxUsagePerPeriod - usage
+		if remaining < 0 {
+			remaining = 0
+		}
+		return errors.New("requested duration of " +
+			HumaniseDuration(duration) +
+			" exceeds remaining usage limit of " +
+			HumaniseDuration(remaining) +
+			" for the " + p.UsagePeriod + " period starting " +
+			period.Start.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// currentPeriodStatus adds the usage and remaining allowance in the current usage period to the policy status,
+// if the policy enforces a maximum usage per period
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) currentPeriodStatus(user, policy string, p Policy, ps PolicyStatus) (PolicyStatus, error) {
+
+	if !p.EnforceMaxUsagePerPeriod {
+		return ps, nil
+	}
+
+	period, ok := p.usagePeriod(s.now())
+
+	if !ok {
+		return ps, nil
+	}
+
+	usage, err := s.periodUsage(user, policy, p, period, "")
+
+	if err != nil {
+		return ps, err
+	}
+
+	remaining := p.MaxUsagePerPeriod - usage
+
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	ps.PeriodStart = period.Start
+	ps.PeriodEnd = period.End
+	ps.PeriodUsage = usage
+	ps.PeriodRemaining = remaining
+
+	return ps, nil
+}
+
+// checkQuotaPolicy returns a message for each usage period setting of the policy that cannot be enforced
+func checkQuotaPolicy(name string, p Policy) []string {
+
+	msg := []string{}
+
+	if !p.EnforceMaxUsagePerPeriod {
+		return msg
+	}
+
+	if p.MaxUsagePerPeriod <= 0 {
+		msg = append(msg, "max_usage_per_period must be positive in policy "+name)
+	}
+
+	switch p.UsagePeriod {
+
+	case Daily, Weekly:
+
+	case Termly:
+
+		if len(p.UsageTerms) == 0 {
+			msg = append(msg, "missing usage_terms for termly usage_period in policy "+name)
+		}
+
+		terms := make([]interval.Interval, len(p.UsageTerms))
+		copy(terms, p.UsageTerms)
+
+		sort.Slice(terms, func(i, j int) bool { return terms[i].Start.Before(terms[j].Start) })
+
+		for i, term := range terms {
+			if !term.End.After(term.Start) {
+				msg = append(msg, "usage term starting "+term.Start.Format(time.RFC3339)+" does not end after it starts in policy "+name)
+			}
+			if i > 0 && terms[i-1].End.After(term.Start) {
+				msg = append(msg, "usage term starting "+term.Start.Format(time.RFC3339)+" overlaps the term before in policy "+name)
+			}
+		}
+
+	default:
+		msg = append(msg, "usage_period must be daily, weekly or termly in policy "+name)
+	}
+
+	return msg
+}
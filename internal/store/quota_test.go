@@ -0,0 +1,178 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestUsagePeriod(t *testing.T) {
+
+	sat := time.Date(2022, 11, 5, 13, 3, 0, 0, time.UTC)
+
+	p := Policy{UsagePeriod: Daily}
+
+	period, ok := p.usagePeriod(sat)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC), period.Start)
+	assert.Equal(t, time.Date(2022, 11, 6, 0, 0, 0, 0, time.UTC), period.End)
+
+	p.UsagePeriod = Weekly
+
+	period, ok = p.usagePeriod(sat)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2022, 10, 31, 0, 0, 0, 0, time.UTC), period.Start)
+	assert.Equal(t, time.Date(2022, 11, 7, 0, 0, 0, 0, time.UTC), period.End)
+
+	// a Monday starts its own week
+	period, ok = p.usagePeriod(time.Date(2022, 11, 7, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2022, 11, 7, 0, 0, 0, 0, time.UTC), period.Start)
+
+	p.UsagePeriod = Termly
+	p.UsageTerms = []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 9, 19, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 12, 10, 0, 0, 0, 0, time.UTC),
+		},
+		interval.Interval{
+			Start: time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	period, ok = p.usagePeriod(sat)
+	assert.True(t, ok)
+	assert.Equal(t, p.UsageTerms[0], period)
+
+	// between terms
+	_, ok = p.usagePeriod(time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestCheckManifestQuota(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	p := m.Policies["p-a"]
+	p.EnforceMaxUsagePerPeriod = true
+	p.MaxUsagePerPeriod = time.Hour
+	p.UsagePeriod = Weekly
+	m.Policies["p-a"] = p
+
+	err, msg := CheckManifest(m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	p.UsagePeriod = "monthly"
+	m.Policies["p-a"] = p
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"usage_period must be daily, weekly or termly in policy p-a"}, msg)
+
+	p.UsagePeriod = Termly
+	m.Policies["p-a"] = p
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"missing usage_terms for termly usage_period in policy p-a"}, msg)
+
+	p.UsageTerms = []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		interval.Interval{
+			Start: time.Date(2022, 9, 19, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	m.Policies["p-a"] = p
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"usage term starting 2023-01-09T00:00:00Z overlaps the term before in policy p-a"}, msg)
+}
+
+func TestPeriodUsage(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	p := m.Policies["p-a"]
+	p.EnforceMaxUsagePerPeriod = true
+	p.MaxUsagePerPeriod = time.Hour
+	p.UsagePeriod = Daily
+	m.Policies["p-a"] = p
+
+	w := m.Windows["w-a"]
+	w.Allowed = []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 4, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 8, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	m.Windows["w-a"] = w
+
+	s := New()
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	user := "u-a"
+	s.AddGroupForUser(user, "g-a")
+
+	book := func(day, sh, sm, eh, em int) (Booking, error) {
+		return s.MakeBooking("sl-a", user, interval.Interval{
+			Start: time.Date(2022, 11, day, sh, sm, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, day, eh, em, 0, 0, time.UTC),
+		})
+	}
+
+	_, err = book(5, 2, 0, 2, 40)
+	assert.NoError(t, err)
+
+	_, err = book(5, 3, 0, 3, 30)
+	assert.Error(t, err)
+	assert.Equal(t, "requested duration of 30m0s exceeds remaining usage limit of 20m0s for the daily period starting 2022-11-05T00:00:00Z", err.Error())
+
+	b, err := book(5, 3, 0, 3, 20)
+	assert.NoError(t, err)
+
+	// the allowance resets the next day
+	_, err = book(6, 2, 0, 3, 0)
+	assert.NoError(t, err)
+
+	ps, err := s.GetPolicyStatusFor(user, "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC), ps.PeriodStart)
+	assert.Equal(t, time.Date(2022, 11, 6, 0, 0, 0, 0, time.UTC), ps.PeriodEnd)
+	assert.Equal(t, time.Hour, ps.PeriodUsage)
+	assert.Equal(t, time.Duration(0), ps.PeriodRemaining)
+	assert.Equal(t, 2*time.Hour, ps.Usage)
+
+	// cancelling before the booking starts gives back the allowance
+	err = s.CancelBooking(b, "test")
+	assert.NoError(t, err)
+
+	ps, err = s.GetPolicyStatusFor(user, "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, 40*time.Minute, ps.PeriodUsage)
+	assert.Equal(t, 20*time.Minute, ps.PeriodRemaining)
+
+	// a user with no bookings still sees the allowance for the current period
+	s.AddGroupForUser("u-b", "g-a")
+
+	ps, err = s.GetPolicyStatusFor("u-b", "p-a")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), ps.PeriodUsage)
+	assert.Equal(t, time.Hour, ps.PeriodRemaining)
+}
@@ -0,0 +1,238 @@
+package store
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// ManifestChanged is the CancelledBy reason given to bookings cancelled because the manifest
+// was replaced with one that no longer supports them
+const ManifestChanged = "manifestChanged"
+
+// Modes of reconciling the existing bookings that are affected by a new manifest
+const (
+	ReconcileKeep   = "keep"   // leave the bookings as they are
+	ReconcileCancel = "cancel" // cancel the bookings, refunding the usage
+	ReconcileMove   = "move"   // move the bookings to an equivalent slot, cancelling them if there is none
+)
+
+// What was done to each affected booking when reconciling
+const (
+	ReconciledCancelled = "cancelled"
+	ReconciledKept      = "kept"
+	ReconciledMoved     = "moved"
+)
+
+// ReconciledBooking represents an existing booking that was affected by a new manifest, and what was done to it
+type ReconciledBooking struct {
+	Action  string            `json:"action" yaml:"action"`
+	Booking string            `json:"booking" yaml:"booking"`
+	NewSlot string            `json:"new_slot,omitempty" yaml:"new_slot,omitempty"`
+	Policy  string            `json:"policy" yaml:"policy"`
+	Reasons []string          `json:"reasons" yaml:"reasons"`
+	Slot    string            `json:"slot" yaml:"slot"`
+	User    string            `json:"user" yaml:"user"`
+	When    interval.Interval `json:"when" yaml:"when"`
+}
+
+// ReconcileReport represents the outcome of reconciling the existing bookings with a new manifest
+type ReconcileReport struct {
+	Bookings []ReconciledBooking `json:"bookings" yaml:"bookings"`
+	Mode     string              `json:"mode" yaml:"mode"`
+}
+
+// ReconcileManifest replaces the manifest, and then reconciles the existing bookings that would reference
+// a missing slot, policy, resource or group, or fall outside the periods allowed by their window, according
+// to the mode (keep, cancel or move). Cancelled bookings have CancelledBy set to ManifestChanged, and are only
+// charged for any time already used. Bookings are moved to the first slot listed in their policy that allows the
+// booking, and is free at the time, starting with their own slot. Bookings that have started, or cannot be moved,
+// are cancelled. Resources that are kept retain their diaries, so bookings that are unaffected stay in place.
+// The manifest is checked as for CheckManifest, and the reasons for any failed checks are returned.
+// The new manifest is retained as a version with no author.
+func (s *Store) ReconcileManifest(m Manifest, mode string) (ReconcileReport, error, []string) {
+	return s.ReconcileManifestWithAuthor(m, mode, "")
+}
+
+// ReconcileManifestWithAuthor replaces the manifest and reconciles the bookings as for ReconcileManifest,
+// and retains the new manifest as a version applied by the author (e.g. the subject of the admin's token)
+func (s *Store) ReconcileManifestWithAuthor(m Manifest, mode, author string) (ReconcileReport, error, []string) {
+	where := "store.ReconcileManifestWithAuthor"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	r, err, msg := s.reconcileManifest(m, mode)
+
+	if err == nil {
+		s.addManifestVersion(author, s.now())
+		s.recordPayload(history.Action{Do: history.ReconcileManifest, Reason: mode, User: author}, m)
+		s.offerFreedTime()
+	}
+
+	return r, err, msg
+}
+
+// reconcileManifest replaces the manifest, and then reconciles the affected bookings
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) reconcileManifest(m Manifest, mode string) (ReconcileReport, error, []string) {
+
+	r := ReconcileReport{
+		Bookings: []ReconciledBooking{},
+		Mode:     mode,
+	}
+
+	switch mode {
+	case ReconcileKeep, ReconcileCancel, ReconcileMove:
+	default:
+		return r, errors.New("unknown reconcile mode " + mode), []string{"mode must be one of keep, cancel or move"}
+	}
+
+	d, err, msg := s.diffManifest(m)
+
+	if err != nil {
+		return r, err, msg
+	}
+
+	// cancel the affected bookings while the current manifest still has their slots and resources,
+	// keeping hold of them so that those that can be moved are restored after the manifest is replaced
+	cancelled := make(map[string]*Booking)
+
+	// bookings allowed by the user's groups can only be moved if the user still has the policy afterwards
+	grouped := make(map[string]bool)
+
+	if mode != ReconcileKeep {
+		for _, bi := range d.Bookings {
+
+			b := s.Bookings[bi.Booking]
+
+			grouped[b.Name] = s.checkUserHasPolicy(b.User, b.Policy) == nil
+
+			err := s.cancelBooking(*b, ManifestChanged)
+
+			if err != nil {
+				log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Errorf("could not cancel booking affected by new manifest because %s", err.Error())
+				continue
+			}
+
+			cancelled[b.Name] = b
+		}
+	}
+
+	err = s.replaceManifestKeepingDiaries(m)
+
+	if err != nil {
+		return r, err, []string{}
+	}
+
+	for _, bi := range d.Bookings {
+
+		rb := ReconciledBooking{
+			Action:  ReconciledKept,
+			Booking: bi.Booking,
+			Policy:  bi.Policy,
+			Reasons: bi.Reasons,
+			Slot:    bi.Slot,
+			User:    bi.User,
+			When:    bi.When,
+		}
+
+		if b, ok := cancelled[bi.Booking]; ok {
+
+			rb.Action = ReconciledCancelled
+
+			if mode == ReconcileMove {
+				if slot, err := s.moveBooking(b, grouped[b.Name]); err == nil {
+					rb.Action = ReconciledMoved
+					rb.NewSlot = slot
+				} else {
+					log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Infof("cancelled booking affected by new manifest because %s", err.Error())
+				}
+			}
+		}
+
+		r.Bookings = append(r.Bookings, rb)
+	}
+
+	return r, nil, []string{}
+}
+
+// moveBooking restores a cancelled booking onto the first slot listed in its policy that allows the booking,
+// and is free at the time, starting with its own slot, and returns the name of the slot. If grouped is true,
+// the booking was allowed by the user's groups, so it is only moved if they still allow the policy.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) moveBooking(b *Booking, grouped bool) (string, error) {
+
+	if b.Started {
+		return "", errors.New("booking has started")
+	}
+
+	p, ok := s.Policies[b.Policy]
+
+	if !ok {
+		return "", errors.New("policy " + b.Policy + " not found")
+	}
+
+	if grouped {
+		if err := s.checkUserHasPolicy(b.User, b.Policy); err != nil {
+			return "", err
+		}
+	}
+
+	slots := []string{}
+
+	for _, k := range p.Slots {
+		if k != b.Slot {
+			slots = append(slots, k)
+		}
+	}
+
+	sort.Strings(slots)
+
+	if p.SlotMap[b.Slot] {
+		slots = append([]string{b.Slot}, slots...)
+	}
+
+	slot := b.Slot
+
+	for _, k := range slots {
+
+		sl, ok := s.Slots[k]
+
+		if !ok {
+			continue
+		}
+
+		if f, ok := s.Filters[sl.Window]; !ok || !f.Allowed(b.When) {
+			continue
+		}
+
+		res, ok := s.Resources[sl.Resource]
+
+		if !ok || res.Diary == nil {
+			continue
+		}
+
+		if ok, _ := res.Diary.IsAvailable(); !ok {
+			continue
+		}
+
+		b.Slot = k
+		b.Resource = ""
+
+		if err := s.uncancelBooking(b); err == nil {
+			return k, nil
+		}
+	}
+
+	b.Slot = slot
+
+	return "", errors.New("no equivalent slot is free")
+}
@@ -0,0 +1,165 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+// reconcileStore returns a store with two bookings on sl-a, one of which is denied by the
+// manifest that is also returned, which adds an equivalent slot sl-aa on r-b to policy p-a
+func reconcileStore(t *testing.T, h *history.History) (*Store, Booking, Booking, Manifest) {
+
+	s := New().WithHistory(h)
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	b1, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 30, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	b2, err := s.MakeBooking("sl-a", "u-a", interval.Interval{
+		Start: time.Date(2022, 11, 5, 3, 10, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 3, 20, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	c := Manifest{}
+	err = yaml.Unmarshal(manifestYAML, &c)
+	assert.NoError(t, err)
+
+	w := c.Windows["w-a"]
+	w.Denied = []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 4, 0, 0, 0, time.UTC),
+		},
+	}
+	c.Windows["w-a"] = w
+
+	sl := c.Slots["sl-a"]
+	sl.Resource = "r-b"
+	sl.Window = "w-b"
+	c.Slots["sl-aa"] = sl
+
+	p := c.Policies["p-a"]
+	p.Slots = []string{"sl-a", "sl-aa"}
+	c.Policies["p-a"] = p
+
+	return s, b1, b2, c
+}
+
+func TestReconcileManifest(t *testing.T) {
+
+	reasons := []string{"outside the periods allowed by window w-a"}
+
+	// keep
+	s, b1, b2, c := reconcileStore(t, history.New("test"))
+
+	r, err, msg := s.ReconcileManifest(c, ReconcileKeep)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, ReconcileReport{
+		Bookings: []ReconciledBooking{
+			ReconciledBooking{
+				Action:  ReconciledKept,
+				Booking: b2.Name,
+				Policy:  "p-a",
+				Reasons: reasons,
+				Slot:    "sl-a",
+				User:    "u-a",
+				When:    b2.When,
+			},
+		},
+		Mode: ReconcileKeep,
+	}, r)
+	_, ok := s.Bookings[b2.Name]
+	assert.True(t, ok)
+
+	// the unaffected booking is still in the diary
+	_, err = s.MakeBooking("sl-a", "u-a", b1.When)
+	assert.Error(t, err)
+
+	// cancel
+	s, _, b2, c = reconcileStore(t, history.New("test"))
+
+	r, err, _ = s.ReconcileManifest(c, ReconcileCancel)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r.Bookings))
+	assert.Equal(t, ReconciledCancelled, r.Bookings[0].Action)
+	_, ok = s.Bookings[b2.Name]
+	assert.False(t, ok)
+	assert.Equal(t, ManifestChanged, s.OldBookings[b2.Name].CancelledBy)
+	assert.Equal(t, 30*time.Minute, *s.Users["u-a"].Usage["p-a"])
+
+	// move
+	h := history.New("test")
+	s, _, b2, c = reconcileStore(t, h)
+
+	r, err, _ = s.ReconcileManifest(c, ReconcileMove)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r.Bookings))
+	assert.Equal(t, ReconciledMoved, r.Bookings[0].Action)
+	assert.Equal(t, "sl-aa", r.Bookings[0].NewSlot)
+	assert.Equal(t, "sl-aa", s.Bookings[b2.Name].Slot)
+	_, ok = s.OldBookings[b2.Name]
+	assert.False(t, ok)
+	assert.Equal(t, 40*time.Minute, *s.Users["u-a"].Usage["p-a"])
+
+	// the moved booking is in the diary of the resource of the new slot
+	_, err = s.MakeBooking("sl-aa", "u-a", b2.When)
+	assert.Error(t, err)
+
+	// replaying the reconciliation moves the booking again
+	s2 := New().WithHistory(h)
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	err, msg = s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, "sl-aa", s2.Bookings[b2.Name].Slot)
+
+	_, err, msg = s.ReconcileManifest(c, "ignore")
+	assert.Error(t, err)
+	assert.Equal(t, "unknown reconcile mode ignore", err.Error())
+	assert.Equal(t, []string{"mode must be one of keep, cancel or move"}, msg)
+}
+
+func TestReconcileManifestGroupRemoved(t *testing.T) {
+
+	s, b1, b2, c := reconcileStore(t, history.New("test"))
+
+	delete(c.Groups, "g-a")
+
+	// the bookings could be moved to sl-aa, but the user no longer has the policy
+	r, err, msg := s.ReconcileManifest(c, ReconcileMove)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, 2, len(r.Bookings))
+
+	for _, rb := range r.Bookings {
+		assert.Equal(t, ReconciledCancelled, rb.Action)
+		assert.Contains(t, rb.Reasons, "group g-a is removed")
+	}
+
+	for _, b := range []Booking{b1, b2} {
+		_, ok := s.Bookings[b.Name]
+		assert.False(t, ok)
+		assert.Equal(t, ManifestChanged, s.OldBookings[b.Name].CancelledBy)
+	}
+}
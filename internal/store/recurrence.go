@@ -0,0 +1,254 @@
+package store
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// Recurrence frequencies, following the names used in iCalendar RRULEs
+const (
+	Daily  = "daily"
+	Weekly = "weekly"
+)
+
+// maxOccurrences limits the number of bookings that a recurrence can make, so that
+// a mistake in the rule cannot fill the diary
+const maxOccurrences = 1000
+
+// Recurrence is a rule for repeating a booking, similar to an iCalendar RRULE, e.g. every Tuesday for a term.
+// The occurrences start at the same time of day as the first booking, in the TimeZone (UTC if not given),
+// every Interval days or weeks, until either Count occurrences have been generated, or the next one would
+// start after Until, so a weekly class at 10:00 Europe/London stays at 10:00 across changes to daylight
+// saving time. Occurrences on the same date as any of the Except times, in the TimeZone, are skipped,
+// but still count towards Count.
+type Recurrence struct {
+	Frequency string      `json:"frequency" yaml:"frequency"`
+	Interval  int         `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Count     int         `json:"count,omitempty" yaml:"count,omitempty"`
+	Until     time.Time   `json:"until,omitempty" yaml:"until,omitempty"`
+	Except    []time.Time `json:"except,omitempty" yaml:"except,omitempty"`
+	TimeZone  string      `json:"time_zone,omitempty" yaml:"time_zone,omitempty"`
+}
+
+// MakeRecurringBooking makes a booking for each occurrence of the interval under the recurrence rule,
+// for a user on a slot, checking the policy for each occurrence as for MakeBookingWithName, but not the user's groups.
+// The bookings are made atomically - if any occurrence cannot be booked, e.g. because it clashes with
+// an existing booking in the diary, then none are made, and the reason for each failed occurrence is
+// returned in the messages. This version should only be called by Admin users
+func (s *Store) MakeRecurringBooking(slot, user string, when interval.Interval, r Recurrence) ([]Booking, error, []string) {
+	where := "store.MakeRecurringBooking"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	bs, err, msg := s.makeRecurringBooking(slot, user, when, r)
+
+	lm := "successful recurring booking"
+
+	if err != nil {
+		lm = "failed recurring booking because " + err.Error()
+	}
+
+	// each occurrence is recorded as an ordinary booking, so that replay does not depend on the rule
+	for _, b := range bs {
+		s.record(history.Action{
+			Do:      history.RequestBooking,
+			Slot:    b.Slot,
+			User:    b.User,
+			When:    b.When,
+			Booking: b.Name,
+			Flag:    false,
+		})
+	}
+
+	log.WithFields(log.Fields{"slot": slot, "user": user, "start": when.Start.String(), "end": when.End.String(), "frequency": r.Frequency, "bookings": len(bs)}).Info(lm)
+
+	return bs, err, msg
+}
+
+// makeRecurringBooking makes a booking for every occurrence, or none at all
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) makeRecurringBooking(slot, user string, when interval.Interval, r Recurrence) ([]Booking, error, []string) {
+
+	if _, ok := s.Slots[slot]; !ok {
+		return []Booking{}, errors.New("slot " + slot + " not found"), []string{}
+	}
+
+	ws, err := r.occurrences(when)
+
+	if err != nil {
+		return []Booking{}, err, []string{}
+	}
+
+	bs := []Booking{}
+	msg := []string{}
+
+	// try every occurrence, so that all the clashes are reported at once
+	for _, w := range ws {
+
+		b, err := s.makeBookingWithName(slot, user, w, uuid.New().String(), false)
+
+		if err != nil {
+			msg = append(msg, "occurrence from "+w.Start.Format(time.RFC3339)+" to "+w.End.Format(time.RFC3339)+" could not be booked because "+err.Error())
+			continue
+		}
+
+		bs = append(bs, b)
+	}
+
+	if len(msg) == 0 {
+		return bs, nil, msg
+	}
+
+	for _, b := range bs {
+		err := s.unmakeBooking(b)
+		if err != nil {
+			// should not happen, because the booking has only just been made
+			log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Errorf("recurring booking failed to remove booking because %s", err.Error())
+		}
+	}
+
+	return []Booking{}, errors.New(strconv.Itoa(len(msg)) + " of " + strconv.Itoa(len(ws)) + " occurrences could not be booked"), msg
+}
+
+// unmakeBooking removes a booking that has only just been made, as if it had never been requested,
+// by deleting it from the diary and current bookings, and refunding its usage in full. Unlike
+// cancelBooking, it does not keep the booking in the old bookings.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) unmakeBooking(b Booking) error {
+
+	p, err := s.getPolicy(b.Policy)
+
+	if err != nil {
+		return err
+	}
+
+	if !p.EnforceUnlimitedUsers {
+
+		r, ok := s.Resources[s.bookingResource(b)]
+
+		if !ok {
+			return errors.New("resource " + s.bookingResource(b) + " not found")
+		}
+
+		err := r.Diary.Delete(b.Name)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	delete(s.Bookings, b.Name)
+
+	if u, ok := s.Users[b.User]; ok {
+
+		delete(u.Bookings, b.Name)
+
+		if ut, ok := u.Usage[b.Policy]; ok {
+			*ut = *ut - b.When.End.Sub(b.When.Start)
+		}
+	}
+
+	return nil
+}
+
+// occurrences returns the intervals at which the booking recurs, starting with the interval given
+func (r Recurrence) occurrences(when interval.Interval) ([]interval.Interval, error) {
+
+	var days int
+
+	switch r.Frequency {
+	case Daily:
+		days = 1
+	case Weekly:
+		days = 7
+	default:
+		return []interval.Interval{}, errors.New("frequency must be " + Daily + " or " + Weekly + " but was " + r.Frequency)
+	}
+
+	if r.Interval < 0 {
+		return []interval.Interval{}, errors.New("interval cannot be negative")
+	}
+
+	if r.Interval > 0 {
+		days = days * r.Interval
+	}
+
+	if r.Count < 0 {
+		return []interval.Interval{}, errors.New("count cannot be negative")
+	}
+
+	if r.Count == 0 && r.Until.IsZero() {
+		return []interval.Interval{}, errors.New("recurrence must have a count or an until")
+	}
+
+	if !when.End.After(when.Start) {
+		return []interval.Interval{}, errors.New("booking must end after it starts")
+	}
+
+	loc := time.UTC
+
+	if r.TimeZone != "" {
+		l, err := time.LoadLocation(r.TimeZone)
+		if err != nil {
+			return []interval.Interval{}, errors.New("unknown time_zone " + r.TimeZone)
+		}
+		loc = l
+	}
+
+	duration := when.End.Sub(when.Start)
+
+	// the time of day is kept in the time zone, rather than adding whole days to the instant
+	first := when.Start.In(loc)
+	y, m, d := first.Date()
+
+	ws := []interval.Interval{}
+
+	for k := 0; r.Count == 0 || k < r.Count; k++ {
+
+		start := time.Date(y, m, d+k*days, first.Hour(), first.Minute(), first.Second(), first.Nanosecond(), loc)
+
+		if !r.Until.IsZero() && start.After(r.Until) {
+			break
+		}
+
+		if k >= maxOccurrences {
+			return []interval.Interval{}, errors.New("recurrence has more than " + strconv.Itoa(maxOccurrences) + " occurrences")
+		}
+
+		if r.excepts(start) {
+			continue
+		}
+
+		ws = append(ws, interval.Interval{
+			Start: start.UTC(),
+			End:   start.Add(duration).UTC(),
+		})
+	}
+
+	return ws, nil
+}
+
+// excepts returns true if the start is on the same date as any of the exceptions
+func (r Recurrence) excepts(start time.Time) bool {
+
+	date := start.Format("2006-01-02")
+
+	for _, e := range r.Except {
+		if e.In(start.Location()).Format("2006-01-02") == date {
+			return true
+		}
+	}
+
+	return false
+}
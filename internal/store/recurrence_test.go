@@ -0,0 +1,154 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestOccurrences(t *testing.T) {
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 8, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 8, 12, 0, 0, 0, time.UTC),
+	}
+
+	_, err := Recurrence{Frequency: "monthly", Count: 2}.occurrences(when)
+	assert.Error(t, err)
+
+	_, err = Recurrence{Frequency: Weekly}.occurrences(when)
+	assert.Error(t, err)
+
+	_, err = Recurrence{Frequency: Daily, Until: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}.occurrences(when)
+	assert.Error(t, err)
+
+	ws, err := Recurrence{Frequency: Weekly, Count: 3}.occurrences(when)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(ws))
+	assert.Equal(t, time.Date(2022, 11, 22, 10, 0, 0, 0, time.UTC), ws[2].Start)
+	assert.Equal(t, time.Date(2022, 11, 22, 12, 0, 0, 0, time.UTC), ws[2].End)
+
+	// exceptions count towards the count, and until is inclusive of the start
+	ws, err = Recurrence{
+		Frequency: Daily,
+		Interval:  2,
+		Until:     time.Date(2022, 11, 14, 10, 0, 0, 0, time.UTC),
+		Except:    []time.Time{time.Date(2022, 11, 10, 0, 0, 0, 0, time.UTC)},
+	}.occurrences(when)
+	assert.NoError(t, err)
+	exp := []time.Time{
+		time.Date(2022, 11, 8, 10, 0, 0, 0, time.UTC),
+		time.Date(2022, 11, 12, 10, 0, 0, 0, time.UTC),
+		time.Date(2022, 11, 14, 10, 0, 0, 0, time.UTC),
+	}
+	for i, w := range ws {
+		assert.Equal(t, exp[i], w.Start)
+	}
+	assert.Equal(t, len(exp), len(ws))
+
+	// occurrences keep their time of day in the time zone across the end of daylight saving time,
+	// which was on 30 October 2022 in Europe/London
+	ws, err = Recurrence{
+		Frequency: Weekly,
+		Count:     2,
+		TimeZone:  "Europe/London",
+	}.occurrences(interval.Interval{
+		Start: time.Date(2022, 10, 25, 9, 0, 0, 0, time.UTC), // 10:00 BST
+		End:   time.Date(2022, 10, 25, 11, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(ws))
+	assert.Equal(t, time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC), ws[1].Start) // 10:00 GMT
+	assert.Equal(t, time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC), ws[1].End)
+
+	_, err = Recurrence{Frequency: Weekly, Count: 2, TimeZone: "Europe/Nowhere"}.occurrences(when)
+	assert.Error(t, err)
+	assert.Equal(t, "unknown time_zone Europe/Nowhere", err.Error())
+}
+
+func TestMakeRecurringBooking(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	// a policy for staff booking a whole term
+	p := m.Policies["p-b"]
+	p.EnforceBookAhead = false
+	p.EnforceMaxBookings = false
+	p.EnforceMaxUsage = false
+	m.Policies["p-b"] = p
+
+	m.Windows["w-b"] = Window{
+		Allowed: []interval.Interval{
+			interval.Interval{
+				Start: time.Date(2022, 11, 4, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		Denied: []interval.Interval{},
+	}
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	_, err = s.MakeBookingWithName("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 22, 10, 5, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 22, 10, 15, 0, 0, time.UTC),
+	}, "bk-user2", false)
+	assert.NoError(t, err)
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 8, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 8, 10, 10, 0, 0, time.UTC),
+	}
+
+	r := Recurrence{
+		Frequency: Weekly,
+		Count:     4,
+	}
+
+	// one occurrence clashes, so none are booked
+	bs, err, msg := s.MakeRecurringBooking("sl-b", "staff", when, r)
+	assert.Error(t, err)
+	assert.Equal(t, []Booking{}, bs)
+	assert.Equal(t, []string{"occurrence from 2022-11-22T10:00:00Z to 2022-11-22T10:10:00Z could not be booked because conflict with existing"}, msg)
+
+	bs, err = s.GetBookingsFor("staff")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(bs))
+	assert.Equal(t, time.Duration(0), *s.Users["staff"].Usage["p-b"])
+	assert.Equal(t, 1, len(s.Bookings))
+
+	r.Except = []time.Time{time.Date(2022, 11, 22, 0, 0, 0, 0, time.UTC)}
+
+	bs, err, msg = s.MakeRecurringBooking("sl-b", "staff", when, r)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, 3, len(bs))
+	assert.Equal(t, time.Date(2022, 11, 29, 10, 0, 0, 0, time.UTC), bs[2].When.Start)
+	assert.Equal(t, 30*time.Minute, *s.Users["staff"].Usage["p-b"])
+
+	// the occurrences are ordinary bookings, so can be cancelled individually
+	err = s.CancelBooking(bs[1], "staff")
+	assert.NoError(t, err)
+
+	// bookings are replayed without reference to the recurrence
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	err, msg = s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+}
@@ -0,0 +1,355 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// JournalFile is the name of the file that the history is appended to, within the persist directory
+const JournalFile = "journal.jsonl"
+
+// WithHistory sets the history that every successful mutation of the store is recorded in
+// Recording is disabled if the history is nil
+func (s *Store) WithHistory(h *history.History) *Store {
+	s.Lock()
+	defer s.Unlock()
+	s.history = h
+	return s
+}
+
+// NewFromHistory returns a new store that is rebuilt by replaying the actions in the
+// history that were issued before until, i.e. the store as it was at that instant.
+// The store's time is set to until, so that it can be inspected as if it were live.
+// The store is not run, so no grace checks or pruning take place after the replay.
+func NewFromHistory(h *history.History, until time.Time) (*Store, error, []string) {
+
+	now := func() time.Time { return until }
+
+	s := New().SetNow(now)
+
+	h.WithNow(now)
+
+	err, msg := s.Replay(h.NewReplayAllUntilNow())
+
+	return s, err, msg
+}
+
+// Replay applies the actions from the replay to the store, in order, at the time they were
+// originally issued. Actions that fail are reported in the messages, but do not stop the replay.
+// Replayed actions are not recorded again, and do not contact the relay(s).
+func (s *Store) Replay(r *history.Replay) (error, []string) {
+	where := "store.Replay"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	return s.replay(r)
+}
+
+// replay applies the actions from the replay to the store
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replay(r *history.Replay) (error, []string) {
+
+	msg := []string{}
+
+	now := s.now
+	s.replaying = true
+
+	defer func() {
+		s.now = now
+		s.replaying = false
+	}()
+
+	count := 0
+
+	for {
+		a, err := r.GetNext()
+
+		if err != nil {
+			break
+		}
+
+		issuedAt := a.IssuedAt
+		s.now = func() time.Time { return issuedAt }
+
+		err = s.apply(*a)
+
+		if err != nil {
+			msg = append(msg, a.Do+" at "+a.IssuedAt.Format(time.RFC3339Nano)+" failed because "+err.Error())
+		}
+
+		count++
+	}
+
+	s.now = now
+
+	// grace checks are not requested during replay, because they are
+	// usually in the past by then, so request them now
+	s.requestGraceChecks()
+
+	log.Infof("replayed %d actions with %d errors", count, len(msg))
+
+	if len(msg) > 0 {
+		return errors.New("some actions could not be replayed"), msg
+	}
+
+	return nil, []string{}
+}
+
+// apply performs a single action from the history
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) apply(a history.Action) error {
+
+	switch a.Do {
+
+	case history.AddGroupForUser:
+		return s.addGroupForUser(a.User, a.Group)
+
+	case history.CancelBooking:
+		b, ok := s.Bookings[a.Booking]
+		if !ok {
+			return errors.New("booking " + a.Booking + " not found")
+		}
+		return s.cancelBooking(*b, a.Reason)
+
+	case history.CollectBooking:
+		b, ok := s.Bookings[a.Booking]
+		if !ok {
+			return errors.New("booking " + a.Booking + " not found")
+		}
+		b.Started = true
+		return nil
+
+	case history.DeleteGroupForUser:
+		return s.deleteGroupFor(a.User, a.Group)
+
+	case history.JoinWaitlist:
+		_, err := s.joinWaitlist(a.Slot, a.User, a.When, a.Entry)
+		return err
+
+	case history.LeaveWaitlist:
+		return s.leaveWaitlist(a.User, a.Entry)
+
+	case history.OfferWaitlist:
+		return s.offerWaitlist(a.Entry, a.When)
+
+	case history.PatchManifest:
+		p := ManifestPatch{}
+		err := yaml.Unmarshal([]byte(a.Payload), &p)
+		if err != nil {
+			return err
+		}
+		err, _ = s.patchManifest(p)
+		if err == nil {
+			s.addManifestVersion(a.User, a.IssuedAt)
+		}
+		return err
+
+	case history.ReconcileManifest:
+		m := Manifest{}
+		err := yaml.Unmarshal([]byte(a.Payload), &m)
+		if err != nil {
+			return err
+		}
+		_, err, _ = s.reconcileManifest(m, a.Reason)
+		if err == nil {
+			s.addManifestVersion(a.User, a.IssuedAt)
+		}
+		return err
+
+	case history.ReplaceBookings:
+		bm := make(map[string]Booking)
+		err := yaml.Unmarshal([]byte(a.Payload), &bm)
+		if err != nil {
+			return err
+		}
+		err, _ = s.replaceBookings(bm)
+		return err
+
+	case history.ReplaceManifest:
+		m := Manifest{}
+		err := yaml.Unmarshal([]byte(a.Payload), &m)
+		if err != nil {
+			return err
+		}
+		err = s.replaceManifest(m)
+		if err == nil {
+			s.addManifestVersion(a.User, a.IssuedAt)
+		}
+		return err
+
+	case history.ReplaceOldBookings:
+		bm := make(map[string]Booking)
+		err := yaml.Unmarshal([]byte(a.Payload), &bm)
+		if err != nil {
+			return err
+		}
+		err, _ = s.replaceOldBookings(bm)
+		return err
+
+	case history.ReplaceUserGroups:
+		ug := make(map[string][]string)
+		err := yaml.Unmarshal([]byte(a.Payload), &ug)
+		if err != nil {
+			return err
+		}
+		err, _ = s.replaceUserGroups(ug)
+		return err
+
+	case history.RequestBooking:
+		_, err := s.makeBookingWithName(a.Slot, a.User, a.When, a.Booking, a.Flag)
+		return err
+
+	case history.ResizeBooking:
+		b, ok := s.Bookings[a.Booking]
+		if !ok {
+			return errors.New("booking " + a.Booking + " not found")
+		}
+		_, err := s.resizeBooking(*b, a.When)
+		return err
+
+	case history.SetLock:
+		s.Locked = a.Flag
+		return nil
+
+	case history.SetMessage:
+		s.Message = a.Reason
+		return nil
+
+	case history.SetResourceIsAvailable:
+		return s.setResourceIsAvailable(a.Resource, a.Flag, a.Reason)
+
+	case history.SubstituteBooking:
+		return s.substituteBooking(a.Booking, a.Resource)
+
+	case history.SwapBooking:
+		b, ok := s.Bookings[a.Booking]
+		if !ok {
+			return errors.New("booking " + a.Booking + " not found")
+		}
+		_, err := s.swapBooking(*b, a.Slot, a.When, a.NewBooking, a.Reason)
+		return err
+
+	}
+
+	return errors.New("unknown action " + a.Do)
+}
+
+// record adds an action to the history, if there is one, unless we are replaying the history
+// The action is stamped with the current time, so that replays happen at the original time
+// If the action cannot be journalled, a snapshot is persisted instead, so that the change
+// that has already been made to the store is not lost if the store is restarted.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) record(a history.Action) {
+
+	if s.history == nil || s.replaying {
+		return
+	}
+
+	a.IssuedAt = s.now()
+
+	err := s.history.Add(a)
+
+	if err == nil {
+		return
+	}
+
+	log.WithFields(log.Fields{"do": a.Do}).Errorf("failed to record action in history because %s", err.Error())
+
+	if s.persistDir == "" {
+		log.WithFields(log.Fields{"do": a.Do}).Error("action will be lost on restart because there is no persist directory")
+		return
+	}
+
+	err = s.persist()
+
+	if err != nil {
+		log.WithFields(log.Fields{"do": a.Do}).Errorf("action will be lost on restart because snapshot failed: %s", err.Error())
+	}
+}
+
+// recordPayload adds an action with a YAML-encoded payload to the history
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) recordPayload(a history.Action, payload interface{}) {
+
+	if s.history == nil || s.replaying {
+		return
+	}
+
+	d, err := yaml.Marshal(payload)
+
+	if err != nil {
+		log.WithFields(log.Fields{"do": a.Do}).Errorf("failed to record action in history because %s", err.Error())
+		return
+	}
+
+	a.Payload = string(d)
+
+	s.record(a)
+}
+
+// requestGraceChecks cleans the grace checker, and requests a grace check for
+// every current booking that has not started, under a policy that enforces a grace period,
+// and for the end of every started booking that has been shortened, so that access can be denied.
+// Checks that are already overdue are requested after the grace rebound.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) requestGraceChecks() {
+
+	s.Checker.Clean()
+
+	for _, b := range s.Bookings {
+
+		p, ok := s.Policies[b.Policy]
+
+		if !ok || !p.EnforceGracePeriod || b.Started {
+			continue
+		}
+
+		checkTime := b.When.Start.Add(p.GracePeriod)
+
+		if checkTime.Before(s.now()) {
+			// overdue, so check as soon as the rebound allows
+			checkTime = s.now().Add(s.GraceRebound)
+		}
+
+		err := s.Checker.Push(checkTime, b.Name)
+
+		if err != nil {
+			log.Errorf("failed to request grace check for %s at %s because %s", b.Name, checkTime.String(), err.Error())
+		}
+	}
+
+	for k := range s.shortened {
+
+		b, ok := s.Bookings[k]
+
+		if !ok {
+			b, ok = s.OldBookings[k]
+		}
+
+		if !ok {
+			delete(s.shortened, k)
+			continue
+		}
+
+		checkTime := b.When.End
+
+		if checkTime.Before(s.now()) {
+			checkTime = s.now().Add(s.GraceRebound)
+		}
+
+		err := s.Checker.Push(checkTime, b.Name)
+
+		if err != nil {
+			log.Errorf("failed to request end of access check for %s at %s because %s", b.Name, checkTime.String(), err.Error())
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+// recordActions performs a sequence of actions on a store, calling
+// snapshot part way through, e.g. to persist the store
+func recordActions(t *testing.T, s *Store, snapshot func()) {
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	err = s.AddGroupForUser("user1", "g-b")
+	assert.NoError(t, err)
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk0", true)
+	assert.NoError(t, err)
+
+	snapshot()
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 10, 0, 0, time.UTC) })
+
+	_, err = s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 7, 0, 0, time.UTC),
+	}, "bk1", true)
+	assert.NoError(t, err)
+
+	// failed actions are not recorded
+	_, err = s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 7, 0, 0, time.UTC),
+	}, "bk2", false)
+	assert.Error(t, err)
+
+	err = s.CancelBooking(b0, "user")
+	assert.NoError(t, err)
+
+	err = s.SetSlotIsAvailable("sl-a", false, "broken")
+	assert.NoError(t, err)
+
+	s.SetLock(true)
+	s.SetMessage("down for maintenance")
+}
+
+// assertSameStore checks that the replayed store matches the original
+func assertSameStore(t *testing.T, s, s2 *Store) {
+
+	em, err := yaml.Marshal(s.ExportManifest())
+	assert.NoError(t, err)
+	am, err := yaml.Marshal(s2.ExportManifest())
+	assert.NoError(t, err)
+	assert.Equal(t, string(em), string(am))
+
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportOldBookings(), s2.ExportOldBookings())
+
+	s.PruneAll()
+	s2.PruneAll()
+	assert.Equal(t, s.ExportUsers(), s2.ExportUsers())
+
+	assert.True(t, s2.Locked)
+	assert.Equal(t, "down for maintenance", s2.Message)
+
+	ok, reason, err := s2.GetResourceIsAvailable("r-a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "unavailable because broken", reason)
+
+	// replay restores the time of the store
+	assert.Equal(t, time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC), s2.now())
+}
+
+func TestReplayAll(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	recordActions(t, s, func() {})
+
+	assert.Equal(t, 8, h.Len())
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC) })
+
+	// replaying into a fresh store does not record the actions again
+	s2 := New().WithHistory(h)
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, 8, h.Len())
+
+	assertSameStore(t, s, s2)
+}
+
+func TestRestoreReplaysJournal(t *testing.T) {
+
+	dir := t.TempDir()
+
+	h := history.New("test")
+
+	s := New().WithPersistDir(dir).WithHistory(h)
+
+	recordActions(t, s, func() {
+		err := s.Persist()
+		assert.NoError(t, err)
+	})
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC) })
+
+	// restart without taking a final snapshot, e.g. after a crash
+	s2 := New().WithPersistDir(dir).WithHistory(h)
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC) })
+
+	err, msg := s2.Restore()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	assertSameStore(t, s, s2)
+}
+
+func TestReplayReportsFailedActions(t *testing.T) {
+
+	h := history.New("test")
+
+	h.Add(history.Action{
+		IssuedAt: time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC),
+		Do:       history.CancelBooking,
+		Booking:  "bk-missing",
+	})
+	h.Add(history.Action{
+		IssuedAt: time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC),
+		Do:       "doSomethingElse",
+	})
+
+	s := New()
+
+	err, msg := s.Replay(h.NewReplayAll())
+	assert.Error(t, err)
+	assert.Equal(t, []string{
+		"cancelBooking at 2022-11-05T00:00:00Z failed because booking bk-missing not found",
+		"doSomethingElse at 2022-11-05T00:00:00Z failed because unknown action doSomethingElse",
+	}, msg)
+}
+
+func TestNewFromHistory(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	recordActions(t, s, func() {})
+
+	// before bk0 was cancelled
+	s2, err, msg := NewFromHistory(h, time.Date(2022, 11, 5, 1, 5, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, time.Date(2022, 11, 5, 1, 5, 0, 0, time.UTC), s2.Now())
+
+	bm := s2.ExportBookings()
+	assert.Equal(t, 1, len(bm))
+	_, ok := bm["bk0"]
+	assert.True(t, ok)
+	assert.False(t, s2.Locked)
+
+	// after bk0 was cancelled
+	s3, err, msg := NewFromHistory(h, time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	bm = s3.ExportBookings()
+	assert.Equal(t, 1, len(bm))
+	_, ok = bm["bk1"]
+	assert.True(t, ok)
+
+	d, err := s3.GetDiaryFor("r-b")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(d))
+	assert.Equal(t, "bk1", d[0].Name)
+
+	_, err = s3.GetDiaryFor("r-missing")
+	assert.Error(t, err)
+
+	obm := s3.ExportOldBookings()
+	assert.True(t, obm["bk0"].Cancelled)
+	assert.Equal(t, "user", obm["bk0"].CancelledBy)
+	assert.Equal(t, time.Date(2022, 11, 5, 1, 10, 0, 0, time.UTC), obm["bk0"].CancelledAt)
+	assert.True(t, s3.Locked)
+}
@@ -0,0 +1,313 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// ResizeBooking changes the interval of an existing booking in place, keeping its name, e.g. to extend it.
+// The new interval is checked against the window for the slot, and the policy's limits on book ahead,
+// duration and usage, in the same way as a new booking, except that the booking does not count
+// against the maximum number of bookings. A booking that has started can only have its end changed.
+// If it is shortened, it is charged as if it had been cancelled at its new end, without being
+// checked against the policy again, and the relay is asked to deny access at the new end.
+// The usage charged for the booking is adjusted to match the new interval.
+func (s *Store) ResizeBooking(booking Booking, when interval.Interval) (Booking, error) {
+	where := "store.ResizeBooking"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	b, err := s.resizeBooking(booking, when)
+
+	msg := "successful resize"
+
+	if err != nil {
+		msg = "failed resize because " + err.Error()
+	} else {
+		s.record(history.Action{
+			Do:      history.ResizeBooking,
+			Booking: booking.Name,
+			User:    booking.User,
+			When:    when,
+		})
+
+		s.offerFreedTime()
+	}
+
+	log.WithFields(log.Fields{"booking": booking.Name, "user": booking.User, "start": when.Start.String(), "end": when.End.String()}).Info(msg)
+
+	return b, err
+}
+
+// resizeBooking changes the interval of an existing booking
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) resizeBooking(booking Booking, when interval.Interval) (Booking, error) {
+
+	b, ok := s.Bookings[booking.Name]
+
+	if !ok {
+		return Booking{}, errors.New("not found")
+	}
+
+	// compare the externally relevant fields of the booking, as for cancellation
+	t1 := Booking{
+		Name:   b.Name,
+		Policy: b.Policy,
+		Slot:   b.Slot,
+		User:   b.User,
+		When:   b.When,
+	}
+	t2 := Booking{
+		Name:   booking.Name,
+		Policy: booking.Policy,
+		Slot:   booking.Slot,
+		User:   booking.User,
+		When:   booking.When,
+	}
+
+	if t1 != t2 {
+		return Booking{}, errors.New("could not verify booking details")
+	}
+
+	if b.When.End.Before(s.now()) {
+		return Booking{}, errors.New("cannot resize booking that has already ended")
+	}
+
+	if !when.End.After(when.Start) {
+		return Booking{}, errors.New("booking must end after it starts")
+	}
+
+	if !when.End.After(s.now()) {
+		return Booking{}, errors.New("booking cannot end in the past")
+	}
+
+	startChanged := !when.Start.Equal(b.When.Start)
+
+	if b.Started && startChanged {
+		return Booking{}, errors.New("cannot change the start of a booking that has already been used")
+	}
+
+	shorten := b.Started && when.End.Before(b.When.End)
+
+	// access can only be ended at the new end if the relay can be asked to deny it, as for cancellation
+	// (the history is replayed as it was)
+	if shorten && s.DisableCancelAfterUse && !s.replaying {
+		return Booking{}, errors.New("cannot shorten a booking that has already been used")
+	}
+
+	sl, ok := s.Slots[b.Slot]
+
+	if !ok {
+		return Booking{}, errors.New("slot " + b.Slot + " not found")
+	}
+
+	p, ok := s.Policies[b.Policy]
+
+	if !ok {
+		return Booking{}, errors.New("policy " + b.Policy + " not found")
+	}
+
+	u, ok := s.Users[b.User]
+
+	if !ok {
+		return Booking{}, errors.New("user " + b.User + " not found")
+	}
+
+	if _, ok := u.Usage[b.Policy]; !ok {
+		return Booking{}, errors.New("no usage found for user " + b.User + " under policy " + b.Policy)
+	}
+
+	// the booking was charged for its whole duration when it was made, so remove that
+	// from the usage, to check the new interval as if it were a new booking
+	currentUsage := *u.Usage[b.Policy] - b.When.End.Sub(b.When.Start)
+
+	// shortening a started booking is like cancelling it at the new end, which is not checked against the policy
+	if !shorten {
+
+		err := s.checkWhen(b.Slot, sl, p, when, currentUsage, startChanged)
+
+		if err != nil {
+			return Booking{}, err
+		}
+
+		err = s.checkPeriodUsage(b.User, b.Policy, p, when, b.Name)
+
+		if err != nil {
+			return Booking{}, err
+		}
+
+		err = s.checkGroupLimits(b.User, b.Policy, when.End.Sub(when.Start)-b.When.End.Sub(b.When.Start), false)
+
+		if err != nil {
+			return Booking{}, err
+		}
+	}
+
+	if !p.EnforceUnlimitedUsers {
+
+		rn := s.bookingResource(*b)
+
+		r, ok := s.Resources[rn]
+
+		if !ok {
+			return Booking{}, errors.New("resource " + rn + " not found")
+		}
+
+		// check first, so that we don't have to put the booking back into an unavailable diary
+		if ok, msg := r.Diary.IsAvailable(); !ok {
+			return Booking{}, errors.New(msg)
+		}
+
+		// a started booking that is shortened does not take any time that could be held
+		if !s.replaying && !shorten {
+			err := s.checkWaitlistHolds(rn, b.User, when)
+
+			if err != nil {
+				return Booking{}, err
+			}
+		}
+
+		err := r.Diary.Delete(b.Name)
+
+		if err != nil {
+			return Booking{}, errors.New("could not remove booking from diary because " + err.Error())
+		}
+
+		err = r.Diary.Request(when, b.Name)
+
+		if err != nil {
+
+			// put the booking back where it was, which cannot clash because it was just removed
+			rerr := r.Diary.Request(b.When, b.Name)
+
+			if rerr != nil {
+				log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Errorf("resize failed to restore booking to diary because %s", rerr.Error())
+			}
+
+			return Booking{}, err
+		}
+
+		// only the parts of the old interval that are not in the new interval are offered
+		s.freeTime(rn, b.When)
+	}
+
+	// a started booking that is shortened is charged from its start to its new end, as for a
+	// cancellation at the new end
+	newUsage := currentUsage + when.End.Sub(when.Start)
+	u.Usage[b.Policy] = &newUsage
+
+	old := b.When
+
+	b.When = when
+
+	if b.Started {
+		s.requestEndOfAccess(b, old)
+	}
+
+	// a grace check for the old start is ignored by GraceCheck if the start has moved later
+	if startChanged && p.EnforceGracePeriod && !s.replaying {
+		checkTime := when.Start.Add(p.GracePeriod)
+		err := s.Checker.Push(checkTime, b.Name)
+		if err != nil {
+			log.Errorf("resize failed to request grace check for %s at %s because %s", b.Name, checkTime.String(), err.Error())
+		}
+	}
+
+	return *b, nil
+}
+
+// requestEndOfAccess keeps track of when the relay access granted to a started booking expires,
+// if the booking now ends before that, and requests a check at the new end so that access can be denied.
+// Access granted before the booking was resized expires at the old end, or a later end that was
+// shortened before.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) requestEndOfAccess(b *Booking, old interval.Interval) {
+
+	exp, ok := s.shortened[b.Name]
+
+	if !ok || old.End.After(exp) {
+		exp = old.End
+	}
+
+	if !b.When.End.Before(exp) {
+		delete(s.shortened, b.Name)
+		return
+	}
+
+	s.shortened[b.Name] = exp
+
+	// checks are requested after replaying the history, because the end may have passed by then
+	if s.replaying {
+		return
+	}
+
+	err := s.Checker.Push(b.When.End, b.Name)
+
+	if err != nil {
+		log.Errorf("resize failed to request end of access check for %s at %s because %s", b.Name, b.When.End.String(), err.Error())
+	}
+}
+
+// EndShortenedAccess asks the relay to deny access to a started booking that has been shortened,
+// once it has reached its new end, because access granted before it was shortened would otherwise
+// continue until the old end. It does nothing for other bookings, or before the new end.
+func (s *Store) EndShortenedAccess(booking string) {
+	where := "store.EndShortenedAccess"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	exp, ok := s.shortened[booking]
+
+	if !ok {
+		return
+	}
+
+	b, ok := s.Bookings[booking]
+
+	if !ok {
+		b, ok = s.OldBookings[booking]
+	}
+
+	// the relay was asked to deny access when a started booking was cancelled
+	if !ok || b.Cancelled {
+		delete(s.shortened, booking)
+		return
+	}
+
+	// there is a check for the new end, if it is still to come
+	if s.now().Before(b.When.End) {
+		return
+	}
+
+	delete(s.shortened, booking)
+
+	if !s.now().Before(exp) {
+		return // access has expired anyway
+	}
+
+	r, ok := s.Resources[s.bookingResource(*b)]
+
+	if !ok {
+		log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error("could not end access to shortened booking because resource " + s.bookingResource(*b) + " not found")
+		return
+	}
+
+	err := s.denyAccess(*b, r, exp, "ending access to a shortened booking failed because ")
+
+	if err != nil {
+		log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(err.Error())
+	}
+}
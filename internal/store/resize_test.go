@@ -0,0 +1,244 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/deny"
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestResizeBooking(t *testing.T) {
+
+	h := history.New("test")
+
+	drc := make(chan deny.Request)
+
+	req := []deny.Request{}
+
+	closed := make(chan struct{})
+	defer close(closed)
+
+	go func() {
+		for {
+			select {
+			case <-closed:
+				return
+			case r := <-drc:
+				req = append(req, r)
+				r.Result <- "ok"
+			}
+		}
+	}()
+
+	s := New().WithHistory(h).WithDenyRequests(drc)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	err = s.AddGroupForUser("user1", "g-b")
+	assert.NoError(t, err)
+	err = s.AddGroupForUser("user2", "g-b")
+	assert.NoError(t, err)
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk0", true)
+	assert.NoError(t, err)
+
+	b1, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 7, 0, 0, time.UTC),
+	}, "bk1", true)
+	assert.NoError(t, err)
+
+	_, err = s.MakeBookingWithName("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 45, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 55, 0, 0, time.UTC),
+	}, "bk2", true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 17*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// user1 is at max_bookings, but resizing does not make a new booking
+	b1, err = s.ResizeBooking(b1, interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "bk1", b1.Name)
+	assert.Equal(t, 20*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// longer than max_duration
+	_, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 41, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	// clashes with bk2
+	_, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	// bk0 is unchanged, and still in the diary
+	b, err := s.GetBooking("bk0")
+	assert.NoError(t, err)
+	assert.Equal(t, b0.When, b.When)
+	assert.Equal(t, 20*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	_, err = s.MakeBookingWithName("sl-b", "user2", b0.When, "bk3", true)
+	assert.Error(t, err)
+
+	// shorten, freeing the end of the booking for others
+	b0, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 35, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// started bookings can have their end changed, but not their start
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 31, 0, 0, time.UTC) })
+
+	_, err = s.GetActivity(b0)
+	assert.NoError(t, err)
+
+	b0, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.True(t, b0.Started)
+	assert.Equal(t, 20*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// shortening needs the relay to end access, so is refused if cancelling after use is
+	s.WithDisableCancelAfterUse(true)
+
+	_, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 33, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "cannot shorten a booking that has already been used", err.Error())
+	assert.Equal(t, 20*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	s.WithDisableCancelAfterUse(false)
+
+	// shortened below min_duration, because it is charged as if cancelled at the new end
+	b0, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 33, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.True(t, b0.Started)
+	assert.Equal(t, 13*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// the end of the booking is free for others
+	_, err = s.MakeBookingWithName("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 34, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk4", true)
+	assert.NoError(t, err)
+
+	// the booking cannot end in the past
+	_, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 31, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	// access granted before shortening lasts until the old end, so it is only denied at the new end
+	s.EndShortenedAccess("bk0")
+	assert.Equal(t, 0, len(req))
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 33, 0, 0, time.UTC) })
+
+	s.EndShortenedAccess("bk0")
+	assert.Equal(t, 2, len(req)) // a relay for each of the streams
+	for _, r := range req {
+		assert.Equal(t, "bk0", r.BookingID)
+		assert.Equal(t, time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC).Unix(), r.ExpiresAt)
+	}
+
+	// only once
+	s.EndShortenedAccess("bk0")
+	assert.Equal(t, 2, len(req))
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 31, 0, 0, time.UTC) })
+
+	_, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 32, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	// resizes are replayed from the history
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 31, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC), s2.shortened["bk0"])
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportUsers()["user1"].Usage, s2.ExportUsers()["user1"].Usage)
+	assert.Equal(t, s.ExportUsers()["user2"].Usage, s2.ExportUsers()["user2"].Usage)
+}
+
+func TestResizeBookingGraceCheck(t *testing.T) {
+
+	s := New()
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	p := m.Policies["p-b"]
+	p.EnforceGracePeriod = true
+	p.GracePeriod = time.Minute
+	m.Policies["p-b"] = p
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk0", false)
+	assert.NoError(t, err)
+
+	_, err = s.ResizeBooking(b0, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 55, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	// the grace check for the original start does not cancel the moved booking
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 32, 0, 0, time.UTC) })
+	s.GraceCheck([]string{"bk0"})
+
+	_, err = s.GetBooking("bk0")
+	assert.NoError(t, err)
+
+	// but the grace check for the new start does
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 52, 0, 0, time.UTC) })
+	s.GraceCheck([]string{"bk0"})
+
+	_, err = s.GetBooking("bk0")
+	assert.Error(t, err)
+}
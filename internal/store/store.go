@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/practable/book/internal/deny"
 	"github.com/practable/book/internal/diary"
 	"github.com/practable/book/internal/filter"
+	"github.com/practable/book/internal/history"
 	"github.com/practable/book/internal/interval"
 	"github.com/rs/xid"
 	log "github.com/sirupsen/logrus"
@@ -47,6 +49,15 @@ type Activity struct {
 	ExpiresAt   time.Time         `json:"exp" yaml:"exp"`
 }
 
+// Blackout represents times when nothing can be booked, such as university closures or maintenance
+// days, that are shared by all the windows that reference the blackout, instead of being copied
+// into the denied periods of each window
+type Blackout struct {
+	Denied []interval.Interval `json:"denied"  yaml:"denied"`
+	// Description is a reference to a named description of the blackout
+	Description string `json:"description"  yaml:"description"`
+}
+
 // Booking represents a promise to access an equipment that
 // provided by the pool referenced in the resource of the slot
 type Booking struct {
@@ -62,6 +73,9 @@ type Booking struct {
 	Name string `json:"name" yaml:"name"`
 	// reference to policy it was booked under
 	Policy string `json:"policy" yaml:"policy"`
+	// Resource is the resource that was substituted for the slot's resource, because that went
+	// offline (empty if the booking is on the slot's own resource)
+	Resource string `json:"resource,omitempty" yaml:"resource,omitempty"`
 	// slot name
 	Slot    string `json:"slot" yaml:"slot"`
 	Started bool   `json:"started" yaml:"started"`
@@ -107,9 +121,29 @@ type DisplayGuide struct {
 // Group represents a list of policies for ease of sharing multiple policies with users
 // and being able to change the policies that are supplied to a user without having to
 // update all the links the user has (important if user is a course organiser on a large course!)
+// The group can also limit the bookings and usage of all its members taken together, under its
+// policies, so that a large group cannot take all the slots that it shares with other groups.
+// remember to update UnmarshalJSON if adding fields
 type Group struct {
-	Description string   `json:"description" yaml:"description"`
-	Policies    []string `json:"policies" yaml:"policies"`
+	Description string `json:"description" yaml:"description"`
+	// EnforceMaxBookings limits the number of current/future bookings held by all members of the group
+	EnforceMaxBookings bool `json:"enforce_max_bookings,omitempty" yaml:"enforce_max_bookings,omitempty"`
+	// EnforceMaxUsage limits the usage of all members of the group
+	EnforceMaxUsage bool          `json:"enforce_max_usage,omitempty" yaml:"enforce_max_usage,omitempty"`
+	MaxBookings     int64         `json:"max_bookings,omitempty" yaml:"max_bookings,omitempty"`
+	MaxUsage        time.Duration `json:"max_usage,omitempty" yaml:"max_usage,omitempty"`
+	Policies        []string      `json:"policies" yaml:"policies"`
+}
+
+// GroupStatus represents the bookings and usage of all members of a group, under the group's policies,
+// with the allowance remaining for the group, where the group enforces a limit
+type GroupStatus struct {
+	BookingsRemaining  int64         `json:"bookings_remaining"  yaml:"bookings_remaining"`
+	CurrentBookings    int64         `json:"current_bookings"  yaml:"current_bookings"`
+	EnforceMaxBookings bool          `json:"enforce_max_bookings"  yaml:"enforce_max_bookings"`
+	EnforceMaxUsage    bool          `json:"enforce_max_usage"  yaml:"enforce_max_usage"`
+	Usage              time.Duration `json:"usage"  yaml:"usage"`
+	UsageRemaining     time.Duration `json:"usage_remaining"  yaml:"usage_remaining"`
 }
 
 // GroupDescribed includes the description to save some overhead, since it will always be
@@ -117,17 +151,23 @@ type Group struct {
 type GroupDescribed struct {
 	Description Description `json:"description"  yaml:"description"`
 	// keep track of the description reference, needed for manifest export
-	DescriptionReference string   `json:"-" yaml:"-"`
-	Policies             []string `json:"policies" yaml:"policies"`
+	DescriptionReference string        `json:"-" yaml:"-"`
+	EnforceMaxBookings   bool          `json:"enforce_max_bookings,omitempty" yaml:"enforce_max_bookings,omitempty"`
+	EnforceMaxUsage      bool          `json:"enforce_max_usage,omitempty" yaml:"enforce_max_usage,omitempty"`
+	MaxBookings          int64         `json:"max_bookings,omitempty" yaml:"max_bookings,omitempty"`
+	MaxUsage             time.Duration `json:"max_usage,omitempty" yaml:"max_usage,omitempty"`
+	Policies             []string      `json:"policies" yaml:"policies"`
 }
 
 // Manifest represents all the available equipment and how to access it
 // Slots are the primary entities, so reference checking starts with them
 type Manifest struct {
+	Blackouts     map[string]Blackout     `json:"blackouts,omitempty" yaml:"blackouts,omitempty"`
 	Descriptions  map[string]Description  `json:"descriptions" yaml:"descriptions"`
 	DisplayGuides map[string]DisplayGuide `json:"display_guides" yaml:"display_guides"`
 	Groups        map[string]Group        `json:"groups" yaml:"groups"`
 	Policies      map[string]Policy       `json:"policies" yaml:"policies"`
+	Pools         map[string]Pool         `json:"pools,omitempty" yaml:"pools,omitempty"`
 	Resources     map[string]Resource     `json:"resources" yaml:"resources"`
 	Slots         map[string]Slot         `json:"slots" yaml:"slots"`
 	Streams       map[string]Stream       `json:"streams" yaml:"streams"`
@@ -142,6 +182,9 @@ type Manifest struct {
 type Policy struct {
 	// AllowStartInPastWithin gives some latitude to accept a booking starting now that gets delayed on the way to the server. A bookng at minimum acceptable duration will be reduced to as much as this duration, so that there is no need to include logic about how to handle a shift in the end time. Typically values might be 10s or 1m.
 	AllowStartInPastWithin time.Duration `json:"allow_start_in_past_within"  yaml:"allow_start_in_past_within"`
+	// AutoBookWaitlist, if true, books freed time (e.g. from a cancellation) for the first user on the waitlist for it,
+	// instead of offering it to them (either way, only if the booking would be within this policy)
+	AutoBookWaitlist bool `json:"auto_book_waitlist"  yaml:"auto_book_waitlist"`
 	//booking must finish within the book_ahead duration, if enforced
 	BookAhead     time.Duration `json:"book_ahead"  yaml:"book_ahead"`
 	Description   string        `json:"description"  yaml:"description"`
@@ -151,16 +194,23 @@ type Policy struct {
 	// so store a local copy of the displayguides to ease the process of fulfilling GET policy_name requests
 	// but don't include this local copy of information in any manifests
 	DisplayGuidesMap map[string]DisplayGuide `json:"-"  yaml:"-"` //local copy so that exported policies are complete but exclude from json/yaml so not duplicated in manifests
+	// DurationStep, if enforced, requires the duration of a booking to be a whole number of steps, e.g. 5m,
+	// so that the diary does not fill with odd fragments of time that nobody else can use
+	DurationStep time.Duration `json:"duration_step"  yaml:"duration_step"`
 	// EnforceAllowStartInPast lets a request starting before now (e.g. due to delayed communication of request) be accepted if other policies are still met.
 	EnforceAllowStartInPast bool `json:"enforce_allow_start_in_past"  yaml:"enforce_allow_start_in_past"`
 	EnforceBookAhead        bool `json:"enforce_book_ahead"  yaml:"enforce_book_ahead"`
+	EnforceDurationStep     bool `json:"enforce_duration_step"  yaml:"enforce_duration_step"`
 	EnforceGracePeriod      bool `json:"enforce_grace_period"  yaml:"enforce_grace_period"`
 	EnforceMaxBookings      bool `json:"enforce_max_bookings"  yaml:"enforce_max_bookings"`
 	EnforceMaxDuration      bool `json:"enforce_max_duration"  yaml:"enforce_max_duration"`
 	EnforceMinDuration      bool `json:"enforce_min_duration"  yaml:"enforce_min_duration"`
 	EnforceMaxUsage         bool `json:"enforce_max_usage"  yaml:"enforce_max_usage"`
-	EnforceNextAvailable    bool `json:"enforce_next_available"  yaml:"enforce_next_available"`
-	EnforceStartsWithin     bool `json:"enforce_starts_within"  yaml:"enforce_starts_within"`
+	// EnforceMaxUsagePerPeriod limits usage in each usage period (e.g. per day or week), as well as overall
+	EnforceMaxUsagePerPeriod bool `json:"enforce_max_usage_per_period"  yaml:"enforce_max_usage_per_period"`
+	EnforceNextAvailable     bool `json:"enforce_next_available"  yaml:"enforce_next_available"`
+	EnforceStartAlignment    bool `json:"enforce_start_alignment"  yaml:"enforce_start_alignment"`
+	EnforceStartsWithin      bool `json:"enforce_starts_within"  yaml:"enforce_starts_within"`
 	//EnforceUnlimitedUsers if true, bookings are not checked, and the token is granted if otherwise within policy. This supports hardware-less simulations to be
 	// included without needing to specify multiple slots. We don't set a finite limit here to avoid having to track multiple overlapping bookings when usually simulations
 	// run entirely in client-side code - if a simulation has a resource limit e.g. due to using some central heavyweight server to crunch data, then slots should be specified
@@ -174,6 +224,9 @@ type Policy struct {
 	MaxDuration  time.Duration `json:"max_duration"  yaml:"max_duration"`
 	MinDuration  time.Duration `json:"min_duration"  yaml:"min_duration"`
 	MaxUsage     time.Duration `json:"max_usage"  yaml:"max_usage"`
+	// MaxUsagePerPeriod is the usage allowed in each usage period, if enforced. Usage is counted in the period
+	// in which the booking starts, and the allowance resets at the start of each period.
+	MaxUsagePerPeriod time.Duration `json:"max_usage_per_period"  yaml:"max_usage_per_period"`
 	// NextAvailable allows for a small gap in bookings to give some flex in case the availability windows are presented with reduced resolution at some point in the system
 	// i.e. set to 2min to allow a request that is rounded up to start at the next minute after the last booking ends, instead of expecting ms precision from everyone
 	// Leaving this to default to zero requires the booking UI to return the exact figure given in the availability list, which probably works for now but might not later when other developers
@@ -181,22 +234,57 @@ type Policy struct {
 	// exact value that the system would expect due to loss of precision - resulting in a rejected booking that is otherwise within the spirit of the policy.
 	// Also, some use cases might actually let this be say 15min or 30min - we can't predict the use cases, but can expect them to vary within the same booking system,
 	// so don't make this a system-wide parameter.
-	NextAvailable time.Duration   `json:"next_available"  yaml:"next_available"`
-	Slots         []string        `json:"slots" yaml:"slots"`
-	SlotMap       map[string]bool `json:"-" yaml:"-"` // internal usage, do not populate from file
+	NextAvailable time.Duration `json:"next_available"  yaml:"next_available"`
+	// Priority lets bookings under this policy displace conflicting bookings under policies of lower priority,
+	// e.g. for staff needing kit for a live demo at short notice. The displaced bookings are cancelled as bumped.
+	// Only bookings checked against the user's groups can bump, not those made by admin without the group check.
+	Priority int64           `json:"priority,omitempty"  yaml:"priority,omitempty"`
+	Slots    []string        `json:"slots" yaml:"slots"`
+	SlotMap  map[string]bool `json:"-" yaml:"-"` // internal usage, do not populate from file
+	// StartAlignment, if enforced, requires bookings to start on a multiple of this duration from midnight UTC,
+	// e.g. 15m for the quarter hour, so it must divide a day exactly
+	StartAlignment time.Duration `json:"start_alignment"  yaml:"start_alignment"`
 	// booking must start within this duration from now, if enforced
 	StartsWithin time.Duration `json:"starts_within"  yaml:"starts_within"`
+	// UsagePeriod is daily, weekly (starting Monday) or termly, in UTC, for MaxUsagePerPeriod
+	UsagePeriod string `json:"usage_period"  yaml:"usage_period"`
+	// UsageTerms are the terms for a termly usage period; bookings outside all terms are not limited per period
+	UsageTerms []interval.Interval `json:"usage_terms"  yaml:"usage_terms"`
 }
 
 type PolicyStatus struct {
-	CurrentBookings int64         `json:"current_bookings"  yaml:"current_bookings"`
-	OldBookings     int64         `json:"old_bookings"  yaml:"old_bookings"`
+	CurrentBookings int64 `json:"current_bookings"  yaml:"current_bookings"`
+	// Groups has the status of each of the user's groups that include the policy and limit bookings or usage
+	Groups      map[string]GroupStatus `json:"groups"  yaml:"groups"`
+	OldBookings int64                  `json:"old_bookings"  yaml:"old_bookings"`
+	// PeriodEnd, PeriodStart, PeriodRemaining and PeriodUsage describe the current usage period,
+	// if the policy enforces a maximum usage per period, and the current time is in a usage period
+	PeriodEnd       time.Time     `json:"period_end"  yaml:"period_end"`
+	PeriodRemaining time.Duration `json:"period_remaining"  yaml:"period_remaining"`
+	PeriodStart     time.Time     `json:"period_start"  yaml:"period_start"`
+	PeriodUsage     time.Duration `json:"period_usage"  yaml:"period_usage"`
 	Usage           time.Duration `json:"usage"  yaml:"usage"`
 }
 
+// Pool represents a set of interchangeable resources, so that bookings on a resource
+// that goes offline can be moved to another resource in the same pool
+type Pool struct {
+	// Description is a reference to a named description of the pool
+	Description string `json:"description"  yaml:"description"`
+
+	// Resources lists the resources in the pool (a resource can only be in one pool)
+	Resources []string `json:"resources"  yaml:"resources"`
+}
+
 // Resource represents a physical entity that can be booked
+// remember to update UnmarshalJSON if adding fields
 type Resource struct {
 
+	// Buffer is the turnaround time that must be left between bookings, e.g. for heated rigs to cool
+	// or robots to re-home after each session. It is kept free before and after each booking, but is
+	// not charged to the users.
+	Buffer time.Duration `json:"buffer,omitempty"  yaml:"buffer,omitempty"`
+
 	// ConfigURL represents a hardware configuration file URL
 	// that may be useful to a UI
 	ConfigURL string `json:"config_url,omitempty"  yaml:"config_url,omitempty"`
@@ -240,6 +328,9 @@ type Slot struct {
 type Store struct {
 	*sync.RWMutex `json:"-"`
 
+	// Blackouts represent periods that are denied in every window that references them
+	Blackouts map[string]Blackout
+
 	// Checker does grace checking on bookings
 	Checker *check.Checker
 
@@ -260,9 +351,15 @@ type Store struct {
 	// Filters are how the windows are checked, mapped by window name (populated after loading window info from manifest)
 	Filters map[string]*filter.Filter
 
+	// freed holds the time freed on resources, e.g. by cancellations, that is yet to be offered to the waitlist
+	freed []freedTime
+
 	// Groups represent groups of policies - we bake in the description to reduce overhead on this common operation
 	Groups map[string]GroupDescribed
 
+	// history records every successful mutation, so that the store can be rebuilt by replaying it (disabled if nil)
+	history *history.History
+
 	// Locked is true when we want to stop making bookings or getting info while we do uploads/maintenance
 	// The API handler has to check this, e.g. if locked, do not make bookings or check availability on
 	// behalf of users. We can't do this automatically in the methods because then we'd need some sort
@@ -274,9 +371,21 @@ type Store struct {
 
 	Locked bool
 
+	// ManifestVersions represents the most recently applied manifests, oldest first, so that an earlier one can be reapplied
+	ManifestVersions []ManifestVersion
+
+	// MaxManifestVersions is how many of the most recently applied manifests are retained
+	MaxManifestVersions int
+
 	// Message represents our message of the day, to send to users (e.g. to explain system is locked)
 	Message string
 
+	// persistDir is where snapshots of the store are written (persistence is disabled if empty)
+	persistDir string
+
+	// persistEvery is how often to write a snapshot when running
+	persistEvery time.Duration
+
 	// now is a function for getting the time - useful for mocking in test
 	// to avoid races, we must use a setter and a getter with a mutex
 	now func() time.Time `json:"-" yaml:"-"`
@@ -288,6 +397,13 @@ type Store struct {
 	// TimePolicies represents all the TimePolicy(ies) in use
 	Policies map[string]Policy
 
+	// Pools represent sets of interchangeable resources, for substituting resources that go offline
+	Pools map[string]Pool
+
+	// replaying is true while actions from the history are being applied, so that they are not recorded
+	// again, and so that relays are not contacted about bookings that were cancelled in the past
+	replaying bool
+
 	// relaySecret holds the secret for the relays (all relays served by a book instance must share the same secret)
 	// Don't expose secret unnecessarily, so don't include when serialising (not that we currently serialise the store anyway)
 	relaySecret string `json:"-" yaml:"-"`
@@ -298,6 +414,10 @@ type Store struct {
 	// Resources represent all the actual physical experiments, indexed by name
 	Resources map[string]Resource
 
+	// shortened holds when the relay access granted to started bookings that have since been shortened
+	// expires, indexed by booking name, so that access can be denied at the new end of the booking
+	shortened map[string]time.Time
+
 	// Slots represent the combinations of virtual equipments and booking policies that apply to them
 	Slots map[string]Slot
 
@@ -312,6 +432,12 @@ type Store struct {
 	// Users maps all users.
 	Users map[string]*User
 
+	// Waitlist represents users waiting for time on slots that are already booked, indexed by entry name
+	Waitlist map[string]*WaitlistEntry
+
+	// waitlistOfferHold is how long freed time offered to a waiting user is held for them
+	waitlistOfferHold time.Duration
+
 	// Window represents allowed and denied time periods for slots
 	Windows map[string]Window
 }
@@ -413,7 +539,13 @@ type UserExternal struct {
 // Window represents allowed and denied periods for slots
 type Window struct {
 	Allowed []interval.Interval `json:"allowed"  yaml:"allowed"`
-	Denied  []interval.Interval `json:"denied"  yaml:"denied"`
+	// AllowedRules are recurring periods that are allowed, as well as those in Allowed
+	AllowedRules []WindowRule `json:"allowed_rules,omitempty"  yaml:"allowed_rules,omitempty"`
+	// Blackouts are the names of blackouts whose periods are denied, as well as those in Denied
+	Blackouts []string            `json:"blackouts,omitempty"  yaml:"blackouts,omitempty"`
+	Denied    []interval.Interval `json:"denied"  yaml:"denied"`
+	// DeniedRules are recurring periods that are denied, as well as those in Denied
+	DeniedRules []WindowRule `json:"denied_rules,omitempty"  yaml:"denied_rules,omitempty"`
 }
 
 // New returns an empty store
@@ -421,6 +553,7 @@ func New() *Store {
 	denyClient := deny.New()
 	return &Store{
 		&sync.RWMutex{},
+		make(map[string]Blackout),
 		check.New().WithNow(func() time.Time { return time.Now() }).WithName("forStore"),
 		make(map[string]*Booking),
 		denyClient,
@@ -429,21 +562,32 @@ func New() *Store {
 		false,
 		make(map[string]DisplayGuide),
 		make(map[string]*filter.Filter),
+		[]freedTime{},
 		make(map[string]GroupDescribed),
+		nil,
 		time.Duration(time.Minute),
 		false,
+		[]ManifestVersion{},
+		DefaultMaxManifestVersions,
 		"Welcome to the interval booking store",
+		"",
+		time.Minute,
 		func() time.Time { return time.Now() },
 		make(map[string]*Booking),
 		make(map[string]Policy),
+		make(map[string]Pool),
+		false,
 		"replaceme",
 		time.Second,
 		make(map[string]Resource),
+		make(map[string]time.Time),
 		make(map[string]Slot),
 		make(map[string]Stream),
 		make(map[string]UIDescribed),
 		make(map[string]UISet),
 		make(map[string]*User),
+		make(map[string]*WaitlistEntry),
+		DefaultWaitlistOfferHold,
 		make(map[string]Window),
 	}
 }
@@ -517,8 +661,8 @@ func (s *Store) WithRequestTimeout(timeout time.Duration) *Store {
 // don't use in internal functions because it will hang waiting for lock
 // just use s.relaySecret directly in internal functions
 func (s *Store) RelaySecret() string {
-	s.Lock()
-	defer s.Unlock()
+	s.RLock()
+	defer s.RUnlock()
 	return s.relaySecret
 }
 
@@ -535,8 +679,8 @@ func (s *Store) SetNow(now func() time.Time) *Store {
 }
 
 func (s *Store) Now() time.Time {
-	s.Lock()
-	defer s.Unlock()
+	s.RLock()
+	defer s.RUnlock()
 	return s.now()
 }
 
@@ -571,6 +715,25 @@ func (s *Store) AddGroupForUser(user, group string) error {
 		log.Trace(where + " released lock")
 	}()
 
+	err := s.addGroupForUser(user, group)
+
+	if err != nil {
+		return err
+	}
+
+	s.record(history.Action{
+		Do:    history.AddGroupForUser,
+		User:  user,
+		Group: group,
+	})
+
+	return nil
+}
+
+// addGroupForUser adds a group for a user
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) addGroupForUser(user, group string) error {
+
 	_, ok := s.Groups[group]
 
 	if !ok {
@@ -614,7 +777,22 @@ func (s *Store) CancelBooking(booking Booking, cancelledBy string) error {
 		log.Trace(where + " released lock")
 	}()
 
-	return s.cancelBooking(booking, cancelledBy)
+	err := s.cancelBooking(booking, cancelledBy)
+
+	if err != nil {
+		return err
+	}
+
+	s.record(history.Action{
+		Do:      history.CancelBooking,
+		Booking: booking.Name,
+		User:    booking.User,
+		Reason:  cancelledBy,
+	})
+
+	s.offerFreedTime()
+
+	return nil
 
 }
 
@@ -656,78 +834,29 @@ func (s *Store) cancelBooking(booking Booking, cancelledBy string) error {
 
 	msg := "cancelling a started booking failed because "
 
-	sl, ok := s.Slots[b.Slot]
-
-	if !ok { //won't happen unless manifest and bookings out of sync
+	if _, ok := s.Slots[b.Slot]; !ok { //won't happen unless manifest and bookings out of sync
 		return errors.New(msg + "slot " + b.Slot + " not found")
 	}
 
-	r, ok := s.Resources[sl.Resource]
+	rn := s.bookingResource(*b)
+
+	r, ok := s.Resources[rn]
 
 	if !ok { //won't happen unless manifest and bookings out of sync
-		return errors.New(msg + "resource " + sl.Resource + " not found")
+		return errors.New(msg + "resource " + rn + " not found")
 	}
 
-	if b.Started {
+	if b.Started && !s.replaying { // the relay was told about the cancellation when it first happened
 
 		if s.DisableCancelAfterUse {
 			return errors.New("cannot cancel booking that has already been used")
 		}
 
 		// Booking has started so we will need to POST a deny request to the relay(s)
-		// assume a manifest may have more than one relay
-		// and that therefore even an experiment may have more than one relay
-		// although that is more of an edge case.
-		// task: map all the relay urls being used
-		// slot -> resource -> streams -> url
-
-		um := make(map[string]bool) //map of URLs from streams (this de-duplicates urls)
-
-		// streams
-		for _, k := range r.Streams {
-			st, ok := s.Streams[k]
-			if !ok { //won't happen unless manifest and bookings out of sync
-				return errors.New(msg + "stream " + k + " not found")
-			}
-
-			um[st.URL] = true
-		}
-
-		for URL := range um {
-
-			if s.denyRequests == nil {
-				msg = msg + "deny requests channel is nil"
-				log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(msg)
-				return errors.New(msg)
-			}
-			c := make(chan string)
-			s.denyRequests <- deny.Request{
-				Result:    c,
-				URL:       strings.TrimPrefix(URL, "http://"), //deny.Client scheme must be http
-				BookingID: b.Name,
-				ExpiresAt: b.When.End.Unix(),
-			}
-
-		DONE:
-			for {
-				select {
-				case result, ok := <-c:
-					if ok && result == "ok" {
-						// deny request was successful
-						log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Info("access cancelled at relay")
-						break DONE
-					} else {
-						msg = msg + " error cancelling access at relay " + result
-						log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(msg)
-						return errors.New(msg)
-					}
-				case <-time.After(s.requestTimeout):
-					msg = msg + " timed out cancelling access at relay " + URL
-					log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(msg)
-					return errors.New(msg)
-				}
-			}
+		err := s.denyAccess(*b, r, b.When.End, msg)
 
+		if err != nil {
+			return err
 		}
 
 		// ok to cancel if get to here
@@ -744,6 +873,7 @@ func (s *Store) cancelBooking(booking Booking, cancelledBy string) error {
 		if err != nil {
 			return errors.New(msg + "could not delete resource booking " + err.Error())
 		}
+		s.freeTime(rn, b.When)
 	}
 
 	delete(s.Bookings, b.Name)
@@ -793,6 +923,69 @@ func (s *Store) cancelBooking(booking Booking, cancelledBy string) error {
 
 }
 
+// denyAccess asks the relay(s) used by the resource to deny access to the booking until expiresAt,
+// starting immediately. Errors are prefixed with msg.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) denyAccess(b Booking, r Resource, expiresAt time.Time, msg string) error {
+
+	// assume a manifest may have more than one relay
+	// and that therefore even an experiment may have more than one relay
+	// although that is more of an edge case.
+	// task: map all the relay urls being used
+	// slot -> resource -> streams -> url
+
+	um := make(map[string]bool) //map of URLs from streams (this de-duplicates urls)
+
+	// streams
+	for _, k := range r.Streams {
+		st, ok := s.Streams[k]
+		if !ok { //won't happen unless manifest and bookings out of sync
+			return errors.New(msg + "stream " + k + " not found")
+		}
+
+		um[st.URL] = true
+	}
+
+	for URL := range um {
+
+		if s.denyRequests == nil {
+			msg = msg + "deny requests channel is nil"
+			log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(msg)
+			return errors.New(msg)
+		}
+		c := make(chan string)
+		s.denyRequests <- deny.Request{
+			Result:    c,
+			URL:       strings.TrimPrefix(URL, "http://"), //deny.Client scheme must be http
+			BookingID: b.Name,
+			ExpiresAt: expiresAt.Unix(),
+		}
+
+	DONE:
+		for {
+			select {
+			case result, ok := <-c:
+				if ok && result == "ok" {
+					// deny request was successful
+					log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Info("access cancelled at relay")
+					break DONE
+				} else {
+					msg = msg + " error cancelling access at relay " + result
+					log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(msg)
+					return errors.New(msg)
+				}
+			case <-time.After(s.requestTimeout):
+				msg = msg + " timed out cancelling access at relay " + URL
+				log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Error(msg)
+				return errors.New(msg)
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // CheckBooking returns nil error if booking is ok, or an error and a slice of messages describing issues
 // doesn't need a mutex, as is a support function
 func (s *Store) checkBooking(b Booking) (error, []string) {
@@ -826,6 +1019,9 @@ func (s *Store) checkBooking(b Booking) (error, []string) {
 	if _, ok := s.Slots[b.Slot]; !ok {
 		msg = append(msg, b.Name+" slot "+b.Slot+" not found")
 	}
+	if _, ok := s.Resources[b.Resource]; b.Resource != "" && !ok {
+		msg = append(msg, b.Name+" resource "+b.Resource+" not found")
+	}
 
 	// we don't check whether user exists, because we create them as needed
 
@@ -849,6 +1045,27 @@ func (s *Store) DeleteGroupFor(user, group string) error {
 		log.Trace(where + " released lock")
 	}()
 
+	err := s.deleteGroupFor(user, group)
+
+	if err != nil {
+		return err
+	}
+
+	s.record(history.Action{
+		Do:    history.DeleteGroupForUser,
+		User:  user,
+		Group: group,
+	})
+
+	s.offerFreedTime()
+
+	return nil
+}
+
+// deleteGroupFor removes the group from the user
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) deleteGroupFor(user, group string) error {
+
 	u, ok := s.Users[user]
 
 	if !ok {
@@ -908,10 +1125,10 @@ func (s *Store) DeleteGroupFor(user, group string) error {
 func (s *Store) ExportBookings() map[string]Booking {
 	where := "store.ExportBookings"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -929,13 +1146,20 @@ func (s *Store) ExportManifest() Manifest {
 
 	where := "store.ExportManifest"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
+	return s.exportManifest()
+}
+
+// exportManifest returns the manifest from the store
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) exportManifest() Manifest {
+
 	// We store the full description in the store for convenience
 	// but the manifest only has the name of the description in the Group
 	// as a reference to the description elsewhere in the manifest
@@ -944,8 +1168,12 @@ func (s *Store) ExportManifest() Manifest {
 	gm := make(map[string]Group)
 	for k, v := range s.Groups {
 		gm[k] = Group{
-			Description: v.DescriptionReference,
-			Policies:    v.Policies,
+			Description:        v.DescriptionReference,
+			EnforceMaxBookings: v.EnforceMaxBookings,
+			EnforceMaxUsage:    v.EnforceMaxUsage,
+			MaxBookings:        v.MaxBookings,
+			MaxUsage:           v.MaxUsage,
+			Policies:           v.Policies,
 		}
 	}
 
@@ -967,6 +1195,7 @@ func (s *Store) ExportManifest() Manifest {
 	rm := make(map[string]Resource)
 	for k, v := range s.Resources {
 		rm[k] = Resource{
+			Buffer:      v.Buffer,
 			ConfigURL:   v.ConfigURL,
 			Description: v.Description,
 			Streams:     v.Streams,
@@ -976,10 +1205,12 @@ func (s *Store) ExportManifest() Manifest {
 	}
 
 	return Manifest{
+		Blackouts:     s.Blackouts,
 		Descriptions:  s.Descriptions,
 		DisplayGuides: s.DisplayGuides,
 		Groups:        gm,
 		Policies:      s.Policies,
+		Pools:         s.Pools,
 		Resources:     rm,
 		Slots:         s.Slots,
 		Streams:       s.Streams,
@@ -994,10 +1225,10 @@ func (s *Store) ExportOldBookings() map[string]Booking {
 
 	where := "store.ExportOldBookings"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1016,10 +1247,10 @@ func (s *Store) ExportUsers() map[string]UserExternal {
 
 	where := "store.ExportUsers"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1084,6 +1315,14 @@ func (s *Store) GetActivity(booking Booking) (Activity, error) {
 		return Activity{}, errors.New("not found")
 	}
 
+	if !b.Started {
+		s.record(history.Action{
+			Do:      history.CollectBooking,
+			Booking: b.Name,
+			User:    b.User,
+		})
+	}
+
 	b.Started = true
 
 	s.Bookings[booking.Name] = b
@@ -1100,10 +1339,12 @@ func (s *Store) GetActivity(booking Booking) (Activity, error) {
 		return Activity{}, errors.New("description " + sl.Description + " not found")
 	}
 
-	r, ok := s.Resources[sl.Resource]
+	rn := s.bookingResource(*b)
+
+	r, ok := s.Resources[rn]
 
 	if !ok {
-		return Activity{}, errors.New("resource " + sl.Resource + " not found")
+		return Activity{}, errors.New("resource " + rn + " not found")
 	}
 
 	a := Activity{
@@ -1155,19 +1396,40 @@ func (s *Store) GetAvailability(slot string) ([]interval.Interval, error) {
 
 	where := "store.GetAvailability"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	return s.getAvailability(slot, time.Time{}, time.Time{}, 0)
+
+}
+
+// GetAvailabilityBetween returns the availability of a slot as for GetAvailability, but only between from and to,
+// either of which can be zero to leave that end of the window set by the policy, and only the first limit intervals,
+// if limit is greater than zero. This is for UIs that only show a week at a time, so that the availability for the
+// rest of the book-ahead period is neither computed nor returned.
+func (s *Store) GetAvailabilityBetween(slot string, from, to time.Time, limit int) ([]interval.Interval, error) {
+
+	where := "store.GetAvailabilityBetween"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
-	return s.getAvailability(slot)
+	return s.getAvailability(slot, from, to, limit)
 
 }
 
 // getAvailability is for internal use only (e.g. in MakeBooking)
-func (s *Store) getAvailability(slot string) ([]interval.Interval, error) {
+// from and to narrow the window of availability set by the policy, unless zero, and limit
+// restricts the number of intervals returned, if greater than zero
+func (s *Store) getAvailability(slot string, from, to time.Time, limit int) ([]interval.Interval, error) {
 
 	sl, ok := s.Slots[slot]
 
@@ -1180,17 +1442,51 @@ func (s *Store) getAvailability(slot string) ([]interval.Interval, error) {
 		return []interval.Interval{}, errors.New("policy " + sl.Policy + " not found")
 	}
 
-	bk, err := s.getSlotBookings(slot)
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return []interval.Interval{}, errors.New("to must not be before from")
+	}
+
+	start := s.now()
+
+	end := interval.DistantFuture //interval.Infinity causes parsing problems in API, so choose something saner (from a parsing point of view)
+
+	if p.EnforceBookAhead {
+		fmt.Println()
+		end = start.Add(p.BookAhead)
+	}
+
+	if from.After(start) {
+		start = from
+	}
+
+	if !to.IsZero() && to.Before(end) {
+		end = to
+	}
+
+	if !end.After(start) { // the requested window is in the past, or beyond the book ahead limit
+		return []interval.Interval{}, nil
+	}
+
+	r, ok := s.Resources[sl.Resource]
+
+	if !ok {
+		return []interval.Interval{}, errors.New("resource " + sl.Resource + " not found")
+	}
+
+	// bookings just outside the window can still keep the start of it free, if the resource needs turnaround time
+	buffer := r.Diary.Buffer()
+
+	bk, err := s.getSlotBookings(slot, interval.Interval{Start: start.Add(-buffer), End: end.Add(buffer)})
 
 	if err != nil {
 		return []interval.Interval{}, err
 	}
 
-	// strip the intervals from the bookings
+	// strip the intervals from the bookings, including any turnaround time around them
 	bi := []interval.Interval{}
 
 	for _, b := range bk {
-		bi = append(bi, b.When)
+		bi = append(bi, r.Diary.Occupied(b.When))
 	}
 
 	// get pointer to filter for policy
@@ -1205,15 +1501,6 @@ func (s *Store) getAvailability(slot string) ([]interval.Interval, error) {
 	// merge bookings with the times that are blocked by the policy
 	unavailable := interval.Merge(append(bi, dl...))
 
-	start := s.now()
-
-	end := interval.DistantFuture //interval.Infinity causes parsing problems in API, so choose something saner (from a parsing point of view)
-
-	if p.EnforceBookAhead {
-		fmt.Println()
-		end = start.Add(p.BookAhead)
-	}
-
 	if len(unavailable) == 0 { // no bookings, no blocked periods
 		a := []interval.Interval{
 			interval.Interval{
@@ -1222,10 +1509,22 @@ func (s *Store) getAvailability(slot string) ([]interval.Interval, error) {
 			},
 		}
 
-		return a, nil
+		return alignAvailability(a, p), nil
+	}
+
+	// aligning the availability to the policy can remove intervals, so apply the limit afterwards
+	if p.EnforceStartAlignment || p.EnforceDurationStep {
+
+		fa := alignAvailability(availability(unavailable, start, end, 0), p)
+
+		if limit > 0 && len(fa) > limit {
+			fa = fa[:limit]
+		}
+
+		return fa, nil
 	}
 
-	fa := availability(unavailable, start, end)
+	fa := availability(unavailable, start, end, limit)
 
 	return fa, nil
 
@@ -1235,10 +1534,10 @@ func (s *Store) getAvailability(slot string) ([]interval.Interval, error) {
 func (s *Store) GetBooking(booking string) (Booking, error) {
 	where := "store.GetBooking"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1256,10 +1555,10 @@ func (s *Store) GetBooking(booking string) (Booking, error) {
 func (s *Store) GetBookingsFor(user string) ([]Booking, error) {
 	where := "store.GetBookingsFor"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 	return s.getBookingsFor(user)
@@ -1267,15 +1566,14 @@ func (s *Store) GetBookingsFor(user string) ([]Booking, error) {
 
 // getBookingsFor returns a slice of all the current bookings for the given user
 // don't use mutex because called from functions that do
-// for internal use - calling function must take the lock
+// for internal use - calling function must take the lock (a read lock is sufficient,
+// because the bookings are found in the store's bookings, not the user's, so there is no need to prune)
 func (s *Store) getBookingsFor(user string) ([]Booking, error) {
 
 	if _, ok := s.Users[user]; !ok {
 		return []Booking{}, errors.New("user not found")
 	}
 
-	s.pruneUserBookings(user)
-
 	b := []Booking{}
 
 	for _, v := range s.Bookings {
@@ -1293,10 +1591,10 @@ func (s *Store) GetDescription(name string) (Description, error) {
 
 	where := "store.GetDescription"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1322,10 +1620,10 @@ func (s *Store) GetDisplayGuide(name string) (DisplayGuide, error) {
 
 	where := "store.GetDisplayGuide"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1362,10 +1660,10 @@ func (s *Store) GetGroup(name string) (GroupDescribed, error) {
 
 	where := "store.GetGroup"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1377,10 +1675,10 @@ func (s *Store) GetGroup(name string) (GroupDescribed, error) {
 func (s *Store) GetOldBookingsFor(user string) ([]Booking, error) {
 	where := "store.GetOldBookingsFor"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 	return s.getOldBookingsFor(user)
@@ -1388,15 +1686,13 @@ func (s *Store) GetOldBookingsFor(user string) ([]Booking, error) {
 
 // getOldBookingsFor returns a slice of all the old bookings for the given user
 // don't use mutex because called from functions that do
-// internal use only - calling function must handle taking the lock
+// internal use only - calling function must handle taking the lock (a read lock is sufficient)
 func (s *Store) getOldBookingsFor(user string) ([]Booking, error) {
 
 	if _, ok := s.Users[user]; !ok {
 		return []Booking{}, errors.New("user not found")
 	}
 
-	s.pruneUserBookings(user)
-
 	b := []Booking{}
 
 	for _, v := range s.OldBookings {
@@ -1432,10 +1728,10 @@ func (s *Store) GetPolicy(name string) (Policy, error) {
 
 	where := "store.GetPolicy"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1447,10 +1743,10 @@ func (s *Store) GetGroupsFor(user string) ([]string, error) {
 
 	where := "store.GetGroupsFor"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1466,17 +1762,18 @@ func (s *Store) GetGroupsFor(user string) ([]string, error) {
 	return g, nil
 }
 
-// GetPolicyStatusFor returns usage, and counts of current and old bookings
-// Needs a write lock because it prunes
+// GetPolicyStatusFor returns usage, and counts of current and old bookings, as well as usage
+// and remaining allowance in the current usage period, if the policy has one, and for each of
+// the user's groups that limits bookings or usage under the policy
 func (s *Store) GetPolicyStatusFor(user, policy string) (PolicyStatus, error) {
 
 	where := "store.GetPolicyStatusFor"
-	log.Trace(where + " awaiting lock")
-	s.Lock()
-	log.Trace(where + " has lock")
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
-		log.Trace(where + " released lock")
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
 	}()
 
 	if _, ok := s.Users[user]; !ok {
@@ -1502,7 +1799,7 @@ func (s *Store) GetPolicyStatusFor(user, policy string) (PolicyStatus, error) {
 		// status without having permission to book, because they rightly assume a GET must not
 		// mutate state, so we don't want to create a privilege escalation by creating a usage tracker
 		// that imples permission to book was once held, when perhaps it wasn't.
-		return PolicyStatus{Usage: ut}, nil
+		return s.currentPeriodStatus(user, policy, s.Policies[policy], PolicyStatus{Groups: s.groupStatusFor(user, policy), Usage: ut})
 
 	}
 
@@ -1532,10 +1829,11 @@ func (s *Store) GetPolicyStatusFor(user, policy string) (PolicyStatus, error) {
 
 	ps := PolicyStatus{
 		CurrentBookings: int64(len(bp)),
+		Groups:          s.groupStatusFor(user, policy),
 		OldBookings:     int64(len(obp)),
 		Usage:           *(s.Users[user].Usage[policy]),
 	}
-	return ps, nil
+	return s.currentPeriodStatus(user, policy, s.Policies[policy], ps)
 }
 
 // GetSlot returns a slot if found
@@ -1543,10 +1841,10 @@ func (s *Store) GetSlot(name string) (Slot, error) {
 
 	where := "store.GetSlot"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1559,10 +1857,10 @@ func (s *Store) GetResources() map[string]Resource {
 
 	where := "store.GetResources"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1595,11 +1893,12 @@ func (s *Store) getSlot(name string) (Slot, error) {
 	return d, nil
 }
 
-// GetSlotBookings gets bookings as far as ahead as the diary holds them
+// GetSlotBookings gets the bookings that overlap the interval, e.g. from now until as far ahead as the policy allows,
+// so that the cost does not grow with the number of bookings held in the diary for the rest of the year.
 // It's up to the caller to handle any pagination that might be required
 // Does not take a lock because the calling function(s) handles that
 // for interal use only - calling function must take the lock
-func (s *Store) getSlotBookings(slot string) ([]diary.Booking, error) {
+func (s *Store) getSlotBookings(slot string, when interval.Interval) ([]diary.Booking, error) {
 
 	sl, ok := s.Slots[slot]
 
@@ -1613,11 +1912,7 @@ func (s *Store) getSlotBookings(slot string) ([]diary.Booking, error) {
 		return []diary.Booking{}, errors.New("resource " + sl.Resource + " not found")
 	}
 
-	b, err := r.Diary.GetBookings()
-
-	if err != nil {
-		return []diary.Booking{}, err
-	}
+	b := r.Diary.GetBookingsOverlapping(when)
 
 	// if unavailable, return bookings with error to indicate requests will be unsuccessful
 	ok, reason := r.Diary.IsAvailable()
@@ -1648,15 +1943,46 @@ func (s *Store) getResourceIsAvailable(resource string) (bool, string, error) {
 
 }
 
+// GetDiaryFor returns the current bookings for a resource, in order of their start time
+func (s *Store) GetDiaryFor(resource string) ([]Booking, error) {
+	where := "store.GetDiaryFor"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	if _, ok := s.Resources[resource]; !ok {
+		return []Booking{}, errors.New("resource " + resource + " not found")
+	}
+
+	bs := []Booking{}
+
+	for _, b := range s.Bookings {
+		if s.bookingResource(*b) != resource {
+			continue
+		}
+		bs = append(bs, *b)
+	}
+
+	sort.Slice(bs, func(i, j int) bool {
+		return bs[i].When.Start.Before(bs[j].When.Start)
+	})
+
+	return bs, nil
+}
+
 // GetResourceIsAvailable checks the underlying resource's availability
 // Use this version when calling externally
 func (s *Store) GetResourceIsAvailable(resource string) (bool, string, error) {
 	where := "store.GetResourceIsAvailable"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1690,10 +2016,10 @@ func (s *Store) getSlotIsAvailable(slot string) (bool, string, error) {
 func (s *Store) GetSlotIsAvailable(slot string) (bool, string, error) {
 	where := "store.GetSlotIsAvailable"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1705,10 +2031,10 @@ func (s *Store) GetStoreStatusAdmin() StoreStatusAdmin {
 
 	where := "store.GetStoreStatusAdmin"
 	log.Trace(where + " awaiting Rlock")
-	s.Lock()
+	s.RLock()
 	log.Trace(where + " has Rlock")
 	defer func() {
-		s.Unlock()
+		s.RUnlock()
 		log.Trace(where + " released Rlock")
 	}()
 
@@ -1731,32 +2057,70 @@ func (s *Store) GetStoreStatusAdmin() StoreStatusAdmin {
 	}
 }
 
-// GetStoreStatusUser returns the store status without entity counts
-func (s *Store) GetStoreStatusUser() StoreStatusUser {
-
-	where := "store.GetStoreStatusUser"
-	log.Trace(where + " awaiting Rlock")
+// SetLock locks or unlocks the store, so that users can or cannot make bookings
+func (s *Store) SetLock(locked bool) {
+	where := "store.SetLock"
+	log.Trace(where + " awaiting lock")
 	s.Lock()
-	log.Trace(where + " has Rlock")
+	log.Trace(where + " has lock")
 	defer func() {
 		s.Unlock()
-		log.Trace(where + " released Rlock")
+		log.Trace(where + " released lock")
 	}()
 
-	return StoreStatusUser{
-		Locked:  s.Locked,
-		Message: s.Message,
-		Now:     s.now(),
-	}
-}
+	s.Locked = locked
 
-func (s *Store) GraceCheck(bookings []string) {
+	s.record(history.Action{
+		Do:   history.SetLock,
+		Flag: locked,
+	})
+}
 
-	if s.Locked {
-		// don't affect bookings but equally, don't do someone out of
-		// the autocancellation's lower usage charge compared to just
-		// not taking up the booking. So push bookings back, for processing later.
-		later := time.Now().Add(s.GraceRebound)
+// SetMessage sets the message of the day that is shown to users
+func (s *Store) SetMessage(msg string) {
+	where := "store.SetMessage"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	s.Message = msg
+
+	s.record(history.Action{
+		Do:     history.SetMessage,
+		Reason: msg,
+	})
+}
+
+// GetStoreStatusUser returns the store status without entity counts
+func (s *Store) GetStoreStatusUser() StoreStatusUser {
+
+	where := "store.GetStoreStatusUser"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	return StoreStatusUser{
+		Locked:  s.Locked,
+		Message: s.Message,
+		Now:     s.now(),
+	}
+}
+
+func (s *Store) GraceCheck(bookings []string) {
+
+	if s.Locked {
+		// don't affect bookings but equally, don't do someone out of
+		// the autocancellation's lower usage charge compared to just
+		// not taking up the booking. So push bookings back, for processing later.
+		later := time.Now().Add(s.GraceRebound)
 		for _, b := range bookings {
 			if s.Checker != nil { //incase checker is being refreshed
 				s.Checker.Push(later, b)
@@ -1766,6 +2130,7 @@ func (s *Store) GraceCheck(bookings []string) {
 	}
 
 	for _, name := range bookings {
+		s.EndShortenedAccess(name)
 		b, err := s.GetBooking(name)
 		if err != nil {
 			continue //skip this booking - probably cancelled
@@ -1777,6 +2142,9 @@ func (s *Store) GraceCheck(bookings []string) {
 		if !p.EnforceGracePeriod {
 			continue
 		}
+		if s.Now().Before(b.When.Start.Add(p.GracePeriod)) {
+			continue // booking was moved later, and has its own grace check
+		}
 		if !b.Started {
 			s.CancelBooking(b, "auto-grace-check")
 		}
@@ -1811,6 +2179,15 @@ func (s *Store) MakeBooking(slot, user string, when interval.Interval) (Booking,
 
 	if err != nil {
 		msg = "failed booking because " + err.Error()
+	} else {
+		s.record(history.Action{
+			Do:      history.RequestBooking,
+			Slot:    slot,
+			User:    user,
+			When:    when,
+			Booking: name,
+			Flag:    true,
+		})
 	}
 
 	log.WithFields(log.Fields{"slot": slot, "user": user, "start": when.Start.String(), "end": when.End.String(), "name": name}).Info(msg)
@@ -1839,6 +2216,15 @@ func (s *Store) MakeBookingWithName(slot, user string, when interval.Interval, n
 
 	if err != nil {
 		msg = "failed booking because " + err.Error()
+	} else {
+		s.record(history.Action{
+			Do:      history.RequestBooking,
+			Slot:    slot,
+			User:    user,
+			When:    when,
+			Booking: name,
+			Flag:    checkGroup,
+		})
 	}
 
 	log.WithFields(log.Fields{"slot": slot, "user": user, "start": when.Start.String(), "end": when.End.String(), "name": name}).Info(msg)
@@ -1856,6 +2242,14 @@ func (s *Store) MakeBookingWithName(slot, user string, when interval.Interval, n
 // e.g. for pre-making identities that can't be operated by the user, there is no need for groups because that would allow other bookings to be made
 // by the student, potentially
 func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, name string, checkGroup bool) (Booking, error) {
+	return s.makeBookingOnResource(slot, "", user, when, name, checkGroup, true)
+}
+
+// makeBookingOnResource makes a booking as for makeBookingWithName, but in the diary of the resource
+// given, as a substitute for the slot's resource (or of the slot's own resource, if resource is empty).
+// If bump is false, conflicting bookings are not bumped, even under a policy of higher priority.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) makeBookingOnResource(slot, resource, user string, when interval.Interval, name string, checkGroup, bump bool) (Booking, error) {
 
 	sl, ok := s.Slots[slot]
 
@@ -1877,10 +2271,20 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 		return Booking{}, errors.New("slot " + slot + " not in policy " + sl.Policy)
 	}
 
-	r, ok := s.Resources[sl.Resource]
+	if resource == sl.Resource {
+		resource = ""
+	}
+
+	rn := sl.Resource
+
+	if resource != "" {
+		rn = resource
+	}
+
+	r, ok := s.Resources[rn]
 
 	if !ok {
-		return Booking{}, errors.New("resource " + sl.Resource + " not found")
+		return Booking{}, errors.New("resource " + rn + " not found")
 	}
 
 	// to avoid replay of policies known to user, that we've revoked, but that still exist,
@@ -1956,75 +2360,54 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 
 	}
 
-	// check if booking is within slot window
-	fp, ok := s.Filters[sl.Window]
+	currentUsage := time.Duration(0)
 
-	if !ok {
-		return Booking{}, errors.New("window filter " + sl.Window + " not found")
+	if ut, ok := u.Usage[sl.Policy]; ok {
+		currentUsage = *ut
 	}
 
-	if !fp.Allowed(when) {
-		return Booking{}, errors.New("bookings cannot be made outside the window for the slot")
-	}
+	err := s.checkWhen(slot, sl, p, when, currentUsage, true)
 
-	// check if booking is within bookahead window
-	if p.EnforceBookAhead {
-		if when.End.After(s.now().Add(p.BookAhead)) {
-			return Booking{}, errors.New("bookings cannot be made more than " +
-				HumaniseDuration(p.BookAhead) +
-				" ahead of the current time")
-		}
+	if err != nil {
+		return Booking{}, err
 	}
 
-	// check if booking requested starts in past
-
-	now := s.now()
+	err = s.checkPeriodUsage(user, sl.Policy, p, when, "")
 
-	if p.EnforceAllowStartInPast { //make allowance for delays in receiving request, if policy permits
-		now = now.Add(-1 * p.AllowStartInPastWithin) //adjust the now value to perform the check required by the policy
-	}
-
-	if when.Start.Before(now) {
-		if p.EnforceAllowStartInPast {
-			return Booking{}, errors.New("booking cannot start more than " + HumaniseDuration(p.AllowStartInPastWithin) + " in the past")
-		} else {
-			return Booking{}, errors.New("booking cannot start in the past (start: " + when.Start.String() + ", now:" + now.String() + ")")
-		}
+	if err != nil {
+		return Booking{}, err
 	}
 
-	// check if booking is starting soon enough, if policy enforces StartsWithin
-	if p.EnforceStartsWithin {
+	bumped := []*Booking{}
 
-		now = s.now().Add(p.StartsWithin) //get fresh, undjusted, value of now to avoid incorrect policy decisions, and adjust as required to make the check
+	// group limits and priority are not applied to admin tasks, for the same reason as group membership is not checked
+	if checkGroup {
+		err = s.checkGroupLimits(user, sl.Policy, when.End.Sub(when.Start), true)
 
-		if when.Start.After(now) {
-			return Booking{}, errors.New("booking cannot start more than " + HumaniseDuration(p.StartsWithin) + " in the future")
+		if err != nil {
+			return Booking{}, err
 		}
 	}
 
-	if p.EnforceNextAvailable {
-
-		// check if booking is starting soon enough after the earliest current booking, or now, if there is no booking, if NextAvailable is enforced
-		a, err := s.getAvailability(slot)
+	// holds are not checked when replaying, because the booking was made when the hold was checked
+	if checkGroup && !p.EnforceUnlimitedUsers && !s.replaying {
+		err = s.checkWaitlistHolds(rn, user, when)
 
 		if err != nil {
-			return Booking{}, errors.New("enforcing next available policy setting failed because " + err.Error())
-		}
-
-		if len(a) < 1 {
-			return Booking{}, errors.New("enforcing next available policy setting because availability list was empty")
+			return Booking{}, err
 		}
+	}
 
-		latest := a[0].Start.Add(p.NextAvailable)
+	if checkGroup && bump {
+		// the limits above are checked against the usage before bumping, but the new usage is
+		// calculated after bumping, so that a refund for the user's own bumped booking is kept
+		bumped, err = s.bumpLowerPriority(r, p, when, name)
 
-		if when.Start.After(latest) {
-			return Booking{}, errors.New("due to next available policy setting, booking cannot start more than " + HumaniseDuration(p.NextAvailable) + " after the last booking ends, i.e. " + latest.String())
+		if err != nil {
+			return Booking{}, err
 		}
-
 	}
 
-	now = s.now() // return now to the current value in case we use it again and overlook that we have adjusted it in the checks above
-
 	// check for existing usage tracker for this policy?
 	_, ok = u.Usage[sl.Policy]
 
@@ -2039,35 +2422,7 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 		u.Usage[sl.Policy] = &ut
 	}
 
-	duration := when.End.Sub(when.Start)
-
-	currentUsage := *u.Usage[sl.Policy]
-	newUsage := currentUsage + duration
-
-	// Check if usage allowance sufficient
-	if p.EnforceMaxUsage && (newUsage > p.MaxUsage) {
-		remaining := p.MaxUsage - currentUsage
-		return Booking{}, errors.New("requested duration of " +
-			HumaniseDuration(duration) +
-			" exceeds remaining usage limit of " +
-			HumaniseDuration(remaining))
-	}
-
-	// Check minimum duration is ok
-	if p.EnforceMinDuration && (duration < p.MinDuration) {
-		return Booking{}, errors.New("requested duration of " +
-			HumaniseDuration(duration) +
-			" shorter than minimum permitted duration of " +
-			HumaniseDuration(p.MinDuration))
-	}
-
-	// check maximum duration is ok
-	if p.EnforceMaxDuration && (duration > p.MaxDuration) {
-		return Booking{}, errors.New("requested duration of " +
-			HumaniseDuration(duration) +
-			" longer than maximum permitted duration of " +
-			HumaniseDuration(p.MaxDuration))
-	}
+	newUsage := *u.Usage[sl.Policy] + when.End.Sub(when.Start)
 
 	// If this is a simulation with no hardware or other resource constraints, we don't make bookings in the diary, we just grant access
 	// seeing as other policy aspects have been satisfied
@@ -2078,6 +2433,7 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 		err := r.Diary.Request(when, name)
 
 		if err != nil {
+			s.restoreBumped(bumped)
 			return Booking{}, err
 		}
 	}
@@ -2089,6 +2445,7 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 		Cancelled:   false,
 		Name:        name,
 		Policy:      sl.Policy,
+		Resource:    resource,
 		Slot:        slot,
 		Started:     false,
 		Unfulfilled: false,
@@ -2099,15 +2456,20 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 	s.Bookings[name] = &booking
 	s.Users[user].Bookings[name] = &booking
 
+	if !p.EnforceUnlimitedUsers {
+		s.takeWaitlistOffers(rn, user, when)
+	}
+
 	// register for autocancellation if required by policy
-	if p.EnforceGracePeriod {
+	// (when replaying, the checks are requested after the replay has finished)
+	if p.EnforceGracePeriod && !s.replaying {
 		checkTime := when.Start.Add(p.GracePeriod)
 		log.Debugf("makebooking: requesting grace check %s at %s", name, checkTime.String())
 		err := s.Checker.Push(checkTime, name)
 		if err != nil {
 			log.Errorf("makebooking failed to request grace check for %s at %s because %s", name, checkTime.String(), err.Error())
 		}
-	} else {
+	} else if !p.EnforceGracePeriod {
 		log.Debugf("makebooking: grace period is not being enforced for %s", name)
 	}
 
@@ -2115,6 +2477,115 @@ func (s *Store) makeBookingWithName(slot, user string, when interval.Interval, n
 
 }
 
+// checkWhen checks that the interval requested for a booking in the slot is within the window for the slot,
+// and the book ahead, start, duration, granularity and usage limits of the policy, where currentUsage is the user's
+// usage under the policy, excluding the booking being checked. The checks on the start of the booking
+// are skipped if checkStart is false, e.g. when changing only the end of an existing booking.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) checkWhen(slot string, sl Slot, p Policy, when interval.Interval, currentUsage time.Duration, checkStart bool) error {
+
+	// check if booking is within slot window
+	fp, ok := s.Filters[sl.Window]
+
+	if !ok {
+		return errors.New("window filter " + sl.Window + " not found")
+	}
+
+	if !fp.Allowed(when) {
+		return errors.New("bookings cannot be made outside the window for the slot")
+	}
+
+	// check if booking is within bookahead window
+	if p.EnforceBookAhead {
+		if when.End.After(s.now().Add(p.BookAhead)) {
+			return errors.New("bookings cannot be made more than " +
+				HumaniseDuration(p.BookAhead) +
+				" ahead of the current time")
+		}
+	}
+
+	if checkStart {
+
+		// check if booking requested starts in past
+
+		now := s.now()
+
+		if p.EnforceAllowStartInPast { //make allowance for delays in receiving request, if policy permits
+			now = now.Add(-1 * p.AllowStartInPastWithin) //adjust the now value to perform the check required by the policy
+		}
+
+		if when.Start.Before(now) {
+			if p.EnforceAllowStartInPast {
+				return errors.New("booking cannot start more than " + HumaniseDuration(p.AllowStartInPastWithin) + " in the past")
+			} else {
+				return errors.New("booking cannot start in the past (start: " + when.Start.String() + ", now:" + now.String() + ")")
+			}
+		}
+
+		// check if booking is starting soon enough, if policy enforces StartsWithin
+		if p.EnforceStartsWithin {
+
+			now = s.now().Add(p.StartsWithin) //get fresh, undjusted, value of now to avoid incorrect policy decisions, and adjust as required to make the check
+
+			if when.Start.After(now) {
+				return errors.New("booking cannot start more than " + HumaniseDuration(p.StartsWithin) + " in the future")
+			}
+		}
+
+		if p.EnforceNextAvailable {
+
+			// check if booking is starting soon enough after the earliest current booking, or now, if there is no booking, if NextAvailable is enforced
+			a, err := s.getAvailability(slot, time.Time{}, time.Time{}, 1)
+
+			if err != nil {
+				return errors.New("enforcing next available policy setting failed because " + err.Error())
+			}
+
+			if len(a) < 1 {
+				return errors.New("enforcing next available policy setting because availability list was empty")
+			}
+
+			latest := a[0].Start.Add(p.NextAvailable)
+
+			if when.Start.After(latest) {
+				return errors.New("due to next available policy setting, booking cannot start more than " + HumaniseDuration(p.NextAvailable) + " after the last booking ends, i.e. " + latest.String())
+			}
+
+		}
+	}
+
+	duration := when.End.Sub(when.Start)
+
+	newUsage := currentUsage + duration
+
+	// Check if usage allowance sufficient
+	if p.EnforceMaxUsage && (newUsage > p.MaxUsage) {
+		remaining := p.MaxUsage - currentUsage
+		return errors.New("requested duration of " +
+			HumaniseDuration(duration) +
+			" exceeds remaining usage limit of " +
+			HumaniseDuration(remaining))
+	}
+
+	// Check minimum duration is ok
+	if p.EnforceMinDuration && (duration < p.MinDuration) {
+		return errors.New("requested duration of " +
+			HumaniseDuration(duration) +
+			" shorter than minimum permitted duration of " +
+			HumaniseDuration(p.MinDuration))
+	}
+
+	// check maximum duration is ok
+	if p.EnforceMaxDuration && (duration > p.MaxDuration) {
+		return errors.New("requested duration of " +
+			HumaniseDuration(duration) +
+			" longer than maximum permitted duration of " +
+			HumaniseDuration(p.MaxDuration))
+	}
+
+	return checkGranularity(p, when, checkStart)
+}
+
 // PruneAll is maintenance operation ensuring all bookings are moved
 // to the old bookings list, wherever that touches our implementation
 func (s *Store) PruneAll() {
@@ -2130,6 +2601,7 @@ func (s *Store) PruneAll() {
 	s.pruneBookings()
 	s.pruneDiaries()
 	s.pruneUserBookingsAll()
+	s.pruneWaitlist()
 
 }
 
@@ -2225,7 +2697,19 @@ func (s *Store) ReplaceBookings(bm map[string]Booking) (error, []string) {
 		s.Unlock()
 		log.Trace(where + " released lock")
 	}()
-	// do not take the lock except where we need it below - because we call on functions that take the lock
+
+	err, msg := s.replaceBookings(bm)
+
+	if err == nil {
+		s.recordPayload(history.Action{Do: history.ReplaceBookings}, bm)
+	}
+
+	return err, msg
+}
+
+// replaceBookings replaces the current bookings
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replaceBookings(bm map[string]Booking) (error, []string) {
 
 	// Check bookings are individually sane given our manifest
 	msg := []string{}
@@ -2267,12 +2751,13 @@ func (s *Store) ReplaceBookings(bm map[string]Booking) (error, []string) {
 	for k := range s.Resources {
 		r := s.Resources[k]
 		r.Diary = diary.New(k)
+		r.Diary.SetBuffer(r.Buffer)
 		s.Resources[k] = r
 	}
 
 	// Now make the bookings, respecting policy and usage
 	for _, v := range bm {
-		_, err := s.makeBookingWithName(v.Slot, v.User, v.When, v.Name, false) //ignore group check on bookings
+		_, err := s.makeBookingOnResource(v.Slot, v.Resource, v.User, v.When, v.Name, false, false) //ignore group check on bookings, keep any substitute resource
 
 		lm := "successful booking"
 
@@ -2293,9 +2778,15 @@ func (s *Store) ReplaceBookings(bm map[string]Booking) (error, []string) {
 }
 
 // ReplaceManifest overwrites the existing manifest with a new one i.e. does not retain existing elements from any previous manifests
-// but it does retain non-Manifest elements such as bookings.
+// but it does retain non-Manifest elements such as bookings. The new manifest is retained as a version with no author.
 func (s *Store) ReplaceManifest(m Manifest) error {
-	where := "store.ReplaceManifest"
+	return s.ReplaceManifestWithAuthor(m, "")
+}
+
+// ReplaceManifestWithAuthor overwrites the existing manifest as for ReplaceManifest, and retains the
+// new manifest as a version applied by the author (e.g. the subject of the admin's token)
+func (s *Store) ReplaceManifestWithAuthor(m Manifest, author string) error {
+	where := "store.ReplaceManifestWithAuthor"
 	log.Trace(where + " awaiting lock")
 	s.Lock()
 	log.Trace(where + " has lock")
@@ -2304,7 +2795,20 @@ func (s *Store) ReplaceManifest(m Manifest) error {
 		log.Trace(where + " released lock")
 	}()
 
-	// lock is taken after we check whether we need to alter the store (see below)
+	err := s.replaceManifest(m)
+
+	if err == nil {
+		s.addManifestVersion(author, s.now())
+		s.recordPayload(history.Action{Do: history.ReplaceManifest, User: author}, m)
+	}
+
+	return err
+}
+
+// replaceManifest overwrites the existing manifest with a new one
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replaceManifest(m Manifest) error {
+
 	err, _ := checkManifest(m)
 
 	if err != nil {
@@ -2318,11 +2822,17 @@ func (s *Store) ReplaceManifest(m Manifest) error {
 	for k, w := range m.Windows {
 
 		f := filter.New()
-		err = f.SetAllowed(w.Allowed)
+		allowed, err := windowAllowed(w)
+		if err == nil {
+			err = f.SetAllowed(allowed)
+		}
 		if err != nil {
 			return errors.New("failed to create allowed intervals for window " + k + ":" + err.Error())
 		}
-		err := f.SetDenied(w.Denied)
+		denied, err := windowDenied(w, m.Blackouts)
+		if err == nil {
+			err = f.SetDenied(denied)
+		}
 		if err != nil {
 			return errors.New("failed to create denied intervals for window " + k + ":" + err.Error())
 		}
@@ -2334,9 +2844,11 @@ func (s *Store) ReplaceManifest(m Manifest) error {
 	s.Filters = fm
 
 	// Make new maps for our new entities (note this is m for manifest, not m for swagger models)
+	s.Blackouts = m.Blackouts
 	s.Descriptions = m.Descriptions
 	s.DisplayGuides = m.DisplayGuides
 	s.Policies = m.Policies
+	s.Pools = m.Pools
 	s.Resources = m.Resources
 	s.Slots = m.Slots
 	s.Streams = m.Streams
@@ -2362,6 +2874,7 @@ func (s *Store) ReplaceManifest(m Manifest) error {
 	for k := range s.Resources {
 		r := s.Resources[k]
 		r.Diary = diary.New(k)
+		r.Diary.SetBuffer(r.Buffer)
 		s.Resources[k] = r
 		// default to available because unavailable kit is the exception
 		s.Resources[k].Diary.SetAvailable(status)
@@ -2401,6 +2914,10 @@ func (s *Store) ReplaceManifest(m Manifest) error {
 		gd := GroupDescribed{
 			Description:          d,
 			DescriptionReference: v.Description,
+			EnforceMaxBookings:   v.EnforceMaxBookings,
+			EnforceMaxUsage:      v.EnforceMaxUsage,
+			MaxBookings:          v.MaxBookings,
+			MaxUsage:             v.MaxUsage,
 			Policies:             v.Policies,
 		}
 		s.Groups[k] = gd
@@ -2423,6 +2940,19 @@ func (s *Store) ReplaceOldBookings(bm map[string]Booking) (error, []string) {
 		log.Trace(where + " released lock")
 	}()
 
+	err, msg := s.replaceOldBookings(bm)
+
+	if err == nil {
+		s.recordPayload(history.Action{Do: history.ReplaceOldBookings}, bm)
+	}
+
+	return err, msg
+}
+
+// replaceOldBookings replaces the old bookings and users
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replaceOldBookings(bm map[string]Booking) (error, []string) {
+
 	// Check bookings are individually sane given our manifest
 	msg := []string{}
 
@@ -2517,6 +3047,19 @@ func (s *Store) ReplaceUserGroups(u map[string][]string) (error, []string) {
 		log.Trace(where + " released lock")
 	}()
 
+	err, msg := s.replaceUserGroups(u)
+
+	if err == nil {
+		s.recordPayload(history.Action{Do: history.ReplaceUserGroups}, u)
+	}
+
+	return err, msg
+}
+
+// replaceUserGroups replaces the groups of the users
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) replaceUserGroups(u map[string][]string) (error, []string) {
+
 	msg := []string{}
 
 	for k, v := range u {
@@ -2571,6 +3114,31 @@ func (s *Store) Run(ctx context.Context, pruneEvery time.Duration, checkEvery ti
 			}
 		}
 	}()
+	s.Lock()
+	persistDir, persistEvery := s.persistDir, s.persistEvery
+	s.Unlock()
+
+	if persistDir != "" {
+		go func() { // snapshot regularly, so that a crash loses as little as possible
+			log.Debug("store will persist to " + persistDir + " every " + persistEvery.String())
+			defer func() {
+				log.Trace("store.Run persisting goro stopped")
+			}()
+			for {
+				select {
+				case <-ctx.Done():
+					log.Trace("store persisting stopped permanently")
+					return
+				case <-time.After(persistEvery):
+					err := s.Persist()
+					if err != nil {
+						log.Errorf("store failed to persist because %s", err.Error())
+					}
+				}
+			}
+		}()
+	}
+
 	go func() { //this is a routine maintenance operation to keep data structures free of stale data, and can run as infrequently, suggest 1 hour if most bookings are 30min+ sessions.
 		log.Debug("store will prune bookings & diaries every " + pruneEvery.String())
 		defer func() {
@@ -2595,6 +3163,14 @@ func (s *Store) SetResourceIsAvailable(resource string, available bool, reason s
 	s.Lock()
 	defer s.Unlock()
 
+	return s.setResourceIsAvailable(resource, available, reason)
+}
+
+// setResourceIsAvailable sets the resource's availability, and records it in the history
+// Bookings on a resource that goes offline are moved to another resource in its pool, if there is one free
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) setResourceIsAvailable(resource string, available bool, reason string) error {
+
 	r, ok := s.Resources[resource]
 
 	if !ok {
@@ -2607,6 +3183,17 @@ func (s *Store) SetResourceIsAvailable(resource string, available bool, reason s
 		r.Diary.SetUnavailable(reason)
 	}
 
+	s.record(history.Action{
+		Do:       history.SetResourceIsAvailable,
+		Resource: resource,
+		Flag:     available,
+		Reason:   reason,
+	})
+
+	if !available {
+		s.substituteBookings(resource)
+	}
+
 	return nil
 
 }
@@ -2621,19 +3208,7 @@ func (s *Store) SetSlotIsAvailable(slot string, available bool, reason string) e
 		return errors.New("slot " + slot + " not found")
 	}
 
-	r, ok := s.Resources[sl.Resource]
-
-	if !ok {
-		return errors.New("resource " + sl.Resource + " not found")
-	}
-
-	if available {
-		r.Diary.SetAvailable(reason)
-	} else {
-		r.Diary.SetUnavailable(reason)
-	}
-
-	return nil
+	return s.setResourceIsAvailable(sl.Resource, available, reason)
 
 }
 
@@ -2679,8 +3254,8 @@ func (s *Store) validateBooking(booking Booking) error {
 		return errors.New("cancelled")
 	}
 
-	// check availability
-	ok, reason, err := s.getSlotIsAvailable(b.Slot)
+	// check availability (of the substitute resource, if there is one)
+	ok, reason, err := s.getResourceIsAvailable(s.bookingResource(*b))
 
 	if err != nil {
 		return err
@@ -2696,12 +3271,33 @@ func (s *Store) validateBooking(booking Booking) error {
 
 // Operations not on the store
 
-// availability returns a slice of available intervals between start and end, given a set of unavailable intervals
-func availability(bi []interval.Interval, start, end time.Time) []interval.Interval {
+// availability returns a slice of available intervals between start and end, given a set of unavailable intervals,
+// stopping after limit intervals if limit is greater than zero
+func availability(bi []interval.Interval, start, end time.Time, limit int) []interval.Interval {
+
+	// only the unavailable intervals that overlap the window can affect the availability within it,
+	// so drop the others before inverting (e.g. blocked periods from the rest of the year)
+	wi := []interval.Interval{}
+
+	for _, i := range bi {
+		if i.End.Before(start) || i.Start.After(end) {
+			continue
+		}
+		wi = append(wi, i)
+	}
+
+	if len(wi) == 0 { // nothing unavailable in the window
+		return []interval.Interval{
+			interval.Interval{
+				Start: start,
+				End:   end,
+			},
+		}
+	}
 
 	// interval.Invert merges and sort intervals
 	// so we don't need to check for overlaps and ordering
-	a := interval.Invert(bi)
+	a := interval.Invert(wi)
 
 	// The inverted list will start at zero time and end at infinity
 	// so make a filtered list with no values before start or after end
@@ -2731,6 +3327,10 @@ func availability(bi []interval.Interval, start, end time.Time) []interval.Inter
 		}
 
 		fa = append(fa, i)
+
+		if limit > 0 && len(fa) >= limit {
+			break
+		}
 	}
 
 	return fa
@@ -2761,6 +3361,15 @@ func calculateUsage(b Booking, p Policy) (time.Duration, error) {
 		return b.When.End.Sub(b.When.Start), nil
 	}
 
+	// bookings displaced by a higher priority booking are only charged for the time actually used
+	// as are bookings cancelled because the manifest no longer supports them
+	if b.CancelledBy == Bumped || b.CancelledBy == ManifestChanged {
+		if !b.Started {
+			return time.Duration(0), nil
+		}
+		return b.CancelledAt.Sub(b.When.Start), nil
+	}
+
 	if p.EnforceGracePeriod {
 
 		if b.CancelledAt.Before(b.When.Start.Add(p.GracePeriod)) {
@@ -2841,6 +3450,14 @@ func checkGroups(items map[string]Group) (error, []string) {
 		return errors.New("missing field"), msg
 	}
 
+	for k, item := range items {
+		msg = append(msg, checkGroupLimitSettings(k, item)...)
+	}
+
+	if len(msg) > 0 {
+		return errors.New("invalid field"), msg
+	}
+
 	return nil, []string{}
 
 }
@@ -2857,7 +3474,13 @@ func checkManifest(m Manifest) (error, []string) {
 
 	// check if any elements have duplicate or missing names
 
-	err, msg := checkDescriptions(m.Descriptions)
+	err, msg := checkBlackouts(m.Blackouts)
+
+	if err != nil {
+		return err, msg
+	}
+
+	err, msg = checkDescriptions(m.Descriptions)
 
 	if err != nil {
 		return err, msg
@@ -2881,6 +3504,12 @@ func checkManifest(m Manifest) (error, []string) {
 		return err, msg
 	}
 
+	err, msg = checkPools(m.Pools)
+
+	if err != nil {
+		return err, msg
+	}
+
 	err, msg = checkResources(m.Resources)
 
 	if err != nil {
@@ -2911,7 +3540,7 @@ func checkManifest(m Manifest) (error, []string) {
 		return err, msg
 	}
 
-	err, msg = checkWindows(m.Windows)
+	err, msg = checkWindows(m.Windows, m.Blackouts)
 
 	if err != nil {
 		return err, msg
@@ -2919,6 +3548,14 @@ func checkManifest(m Manifest) (error, []string) {
 
 	// Check that all references are present
 
+	// Blackout -> Description
+	for k, v := range m.Blackouts {
+		if _, ok := m.Descriptions[v.Description]; !ok {
+			m := "blackout " + k + " references non-existent description: " + v.Description
+			msg = append(msg, m)
+		}
+	}
+
 	// Description -> N/A
 
 	// Group -> Description, Policies
@@ -2955,6 +3592,26 @@ func checkManifest(m Manifest) (error, []string) {
 		}
 	}
 
+	// Pool -> Description, Resources
+	inPool := make(map[string]string)
+	for k, v := range m.Pools {
+		if _, ok := m.Descriptions[v.Description]; !ok {
+			m := "pool " + k + " references non-existent description: " + v.Description
+			msg = append(msg, m)
+		}
+		for _, r := range v.Resources {
+			if _, ok := m.Resources[r]; !ok {
+				m := "pool " + k + " references non-existent resource: " + r
+				msg = append(msg, m)
+			}
+			if p, ok := inPool[r]; ok && p != k {
+				m := "resource " + r + " is in more than one pool: " + p + ", " + k
+				msg = append(msg, m)
+			}
+			inPool[r] = k
+		}
+	}
+
 	// Resource ->  Description, Stream
 	for k, v := range m.Resources {
 		if _, ok := m.Descriptions[v.Description]; !ok {
@@ -3021,6 +3678,16 @@ func checkManifest(m Manifest) (error, []string) {
 		}
 	}
 
+	// Window -> Blackouts
+	for k, v := range m.Windows {
+		for _, b := range v.Blackouts {
+			if _, ok := m.Blackouts[b]; !ok {
+				m := "window " + k + " references non-existent blackout: " + b
+				msg = append(msg, m)
+			}
+		}
+	}
+
 	if len(msg) > 0 {
 		return errors.New("missing reference(s)"), msg
 	}
@@ -3046,6 +3713,57 @@ func checkPolicies(items map[string]Policy) (error, []string) {
 		return errors.New("missing field"), msg
 	}
 
+	for k, item := range items {
+		msg = append(msg, checkGranularityPolicy(k, item)...)
+		msg = append(msg, checkQuotaPolicy(k, item)...)
+	}
+
+	if len(msg) > 0 {
+		return errors.New("invalid field"), msg
+	}
+
+	return nil, []string{}
+
+}
+
+func checkBlackouts(items map[string]Blackout) (error, []string) {
+
+	msg := []string{}
+
+	for k, item := range items {
+		if item.Description == "" {
+			msg = append(msg, "missing description field in blackout "+k)
+		}
+		if item.Denied == nil {
+			msg = append(msg, "missing denied field in blackout "+k)
+		}
+	}
+
+	if len(msg) > 0 {
+		return errors.New("missing field"), msg
+	}
+
+	return nil, []string{}
+
+}
+
+func checkPools(items map[string]Pool) (error, []string) {
+
+	msg := []string{}
+
+	for k, item := range items {
+		if item.Description == "" {
+			msg = append(msg, "missing description field in pool "+k)
+		}
+		if item.Resources == nil {
+			msg = append(msg, "missing resources field in pool "+k)
+		}
+	}
+
+	if len(msg) > 0 {
+		return errors.New("missing field"), msg
+	}
+
 	return nil, []string{}
 
 }
@@ -3071,6 +3789,16 @@ func checkResources(items map[string]Resource) (error, []string) {
 		return errors.New("missing field"), msg
 	}
 
+	for k, item := range items {
+		if item.Buffer < 0 {
+			msg = append(msg, "buffer cannot be negative in resource "+k)
+		}
+	}
+
+	if len(msg) > 0 {
+		return errors.New("invalid field"), msg
+	}
+
 	return nil, []string{}
 
 }
@@ -3172,14 +3900,14 @@ func checkUISets(items map[string]UISet) (error, []string) {
 
 }
 
-func checkWindows(items map[string]Window) (error, []string) {
+func checkWindows(items map[string]Window, blackouts map[string]Blackout) (error, []string) {
 
 	msg := []string{}
 
 	for k, item := range items {
 		// a window has to have at least one allowed period to be valid
 		// a slot should be deleted rather than have a window with no allowed periods
-		if item.Allowed == nil {
+		if item.Allowed == nil && len(item.AllowedRules) == 0 {
 			msg = append(msg, "missing allowed field in window "+k)
 		}
 	}
@@ -3193,11 +3921,17 @@ func checkWindows(items map[string]Window) (error, []string) {
 	for k, w := range items {
 
 		f := filter.New()
-		err := f.SetAllowed(w.Allowed)
+		allowed, err := windowAllowed(w)
+		if err == nil {
+			err = f.SetAllowed(allowed)
+		}
 		if err != nil {
 			msg = append(msg, "failed to create allowed intervals for window "+k+": "+err.Error())
 		}
-		err = f.SetDenied(w.Denied)
+		denied, err := windowDenied(w, blackouts)
+		if err == nil {
+			err = f.SetDenied(denied)
+		}
 		if err != nil {
 			msg = append(msg, "failed to create denied intervals for window "+k+": "+err.Error())
 		}
@@ -3223,26 +3957,39 @@ func (p *Policy) UnmarshalJSON(data []byte) (err error) {
 		// durations are set to string for now
 		AllowStartInPastWithin string `json:"allow_start_in_past_within"  yaml:"allow_start_in_past_within"`
 		BookAhead              string `json:"book_ahead"  yaml:"book_ahead"`
+		DurationStep           string `json:"duration_step"  yaml:"duration_step"`
+		GracePenalty           string `json:"grace_penalty"  yaml:"grace_penalty"`
+		GracePeriod            string `json:"grace_period"  yaml:"grace_period"`
 		MaxDuration            string `json:"max_duration"  yaml:"max_duration"`
 		MinDuration            string `json:"min_duration"  yaml:"min_duration"`
 		MaxUsage               string `json:"max_usage"  yaml:"max_usage"`
+		MaxUsagePerPeriod      string `json:"max_usage_per_period"  yaml:"max_usage_per_period"`
 		NextAvailable          string `json:"next_available"  yaml:"next_available"`
+		StartAlignment         string `json:"start_alignment"  yaml:"start_alignment"`
 		StartsWithin           string `json:"starts_within"  yaml:"starts_within"`
 
 		// other fields stay the same
-		Description             string   `json:"description"  yaml:"description"`
-		DisplayGuides           []string `json:"display_guides"  yaml:"display_guides"`
-		EnforceAllowStartInPast bool     `json:"enforce_allow_start_in_past"  yaml:"enforce_allow_start_in_past"`
-		EnforceBookAhead        bool     `json:"enforce_book_ahead"  yaml:"enforce_book_ahead"`
-		EnforceMaxBookings      bool     `json:"enforce_max_bookings"  yaml:"enforce_max_bookings"`
-		EnforceMaxDuration      bool     `json:"enforce_max_duration"  yaml:"enforce_max_duration"`
-		EnforceMinDuration      bool     `json:"enforce_min_duration"  yaml:"enforce_min_duration"`
-		EnforceMaxUsage         bool     `json:"enforce_max_usage"  yaml:"enforce_max_usage"`
-		EnforceNextAvailable    bool     `json:"enforce_next_available"  yaml:"enforce_next_available"`
-		EnforceStartsWithin     bool     `json:"enforce_starts_within"  yaml:"enforce_starts_within"`
-		EnforceUnlimitedUsers   bool     `json:"enforce_unlimited_users"  yaml:"enforce_unlimited_users"`
-		MaxBookings             int64    `json:"max_bookings"  yaml:"max_bookings"`
-		Slots                   []string `json:"slots" yaml:"slots"`
+		AutoBookWaitlist         bool                `json:"auto_book_waitlist"  yaml:"auto_book_waitlist"`
+		Description              string              `json:"description"  yaml:"description"`
+		DisplayGuides            []string            `json:"display_guides"  yaml:"display_guides"`
+		EnforceAllowStartInPast  bool                `json:"enforce_allow_start_in_past"  yaml:"enforce_allow_start_in_past"`
+		EnforceBookAhead         bool                `json:"enforce_book_ahead"  yaml:"enforce_book_ahead"`
+		EnforceDurationStep      bool                `json:"enforce_duration_step"  yaml:"enforce_duration_step"`
+		EnforceGracePeriod       bool                `json:"enforce_grace_period"  yaml:"enforce_grace_period"`
+		EnforceMaxBookings       bool                `json:"enforce_max_bookings"  yaml:"enforce_max_bookings"`
+		EnforceMaxDuration       bool                `json:"enforce_max_duration"  yaml:"enforce_max_duration"`
+		EnforceMinDuration       bool                `json:"enforce_min_duration"  yaml:"enforce_min_duration"`
+		EnforceMaxUsage          bool                `json:"enforce_max_usage"  yaml:"enforce_max_usage"`
+		EnforceMaxUsagePerPeriod bool                `json:"enforce_max_usage_per_period"  yaml:"enforce_max_usage_per_period"`
+		EnforceNextAvailable     bool                `json:"enforce_next_available"  yaml:"enforce_next_available"`
+		EnforceStartAlignment    bool                `json:"enforce_start_alignment"  yaml:"enforce_start_alignment"`
+		EnforceStartsWithin      bool                `json:"enforce_starts_within"  yaml:"enforce_starts_within"`
+		EnforceUnlimitedUsers    bool                `json:"enforce_unlimited_users"  yaml:"enforce_unlimited_users"`
+		MaxBookings              int64               `json:"max_bookings"  yaml:"max_bookings"`
+		Priority                 int64               `json:"priority,omitempty"  yaml:"priority,omitempty"`
+		Slots                    []string            `json:"slots" yaml:"slots"`
+		UsagePeriod              string              `json:"usage_period"  yaml:"usage_period"`
+		UsageTerms               []interval.Interval `json:"usage_terms"  yaml:"usage_terms"`
 	}
 
 	if err = json.Unmarshal(data, &tmp); err != nil {
@@ -3271,6 +4018,21 @@ func (p *Policy) UnmarshalJSON(data []byte) (err error) {
 	if tmp.MaxUsage == "" {
 		tmp.MaxUsage = "0s"
 	}
+	if tmp.DurationStep == "" {
+		tmp.DurationStep = "0s"
+	}
+	if tmp.StartAlignment == "" {
+		tmp.StartAlignment = "0s"
+	}
+	if tmp.MaxUsagePerPeriod == "" {
+		tmp.MaxUsagePerPeriod = "0s"
+	}
+	if tmp.GracePeriod == "" {
+		tmp.GracePeriod = "0s"
+	}
+	if tmp.GracePenalty == "" {
+		tmp.GracePenalty = "0s"
+	}
 
 	// parse durations
 	ba, err := time.ParseDuration(tmp.BookAhead)
@@ -3301,28 +4063,61 @@ func (p *Policy) UnmarshalJSON(data []byte) (err error) {
 	if err != nil {
 		return err
 	}
+	ds, err := time.ParseDuration(tmp.DurationStep)
+	if err != nil {
+		return err
+	}
+	sa, err := time.ParseDuration(tmp.StartAlignment)
+	if err != nil {
+		return err
+	}
+	xp, err := time.ParseDuration(tmp.MaxUsagePerPeriod)
+	if err != nil {
+		return err
+	}
+	gp, err := time.ParseDuration(tmp.GracePeriod)
+	if err != nil {
+		return err
+	}
+	gn, err := time.ParseDuration(tmp.GracePenalty)
+	if err != nil {
+		return err
+	}
 
 	p.AllowStartInPastWithin = sp
 	p.BookAhead = ba
+	p.DurationStep = ds
+	p.GracePenalty = gn
+	p.GracePeriod = gp
 	p.MaxDuration = xd
 	p.NextAvailable = na
 	p.MinDuration = nd
 	p.MaxUsage = xu
+	p.MaxUsagePerPeriod = xp
+	p.StartAlignment = sa
 	p.StartsWithin = sw
 
+	p.AutoBookWaitlist = tmp.AutoBookWaitlist
 	p.Description = tmp.Description
 	p.DisplayGuides = tmp.DisplayGuides
 	p.EnforceAllowStartInPast = tmp.EnforceAllowStartInPast
 	p.EnforceBookAhead = tmp.EnforceBookAhead
+	p.EnforceDurationStep = tmp.EnforceDurationStep
+	p.EnforceGracePeriod = tmp.EnforceGracePeriod
 	p.EnforceMaxBookings = tmp.EnforceMaxBookings
 	p.EnforceMaxDuration = tmp.EnforceMaxDuration
 	p.EnforceMinDuration = tmp.EnforceMinDuration
 	p.EnforceMaxUsage = tmp.EnforceMaxUsage
+	p.EnforceMaxUsagePerPeriod = tmp.EnforceMaxUsagePerPeriod
 	p.EnforceNextAvailable = tmp.EnforceNextAvailable
+	p.EnforceStartAlignment = tmp.EnforceStartAlignment
 	p.EnforceStartsWithin = tmp.EnforceStartsWithin
 	p.EnforceUnlimitedUsers = tmp.EnforceUnlimitedUsers
 	p.MaxBookings = tmp.MaxBookings
+	p.Priority = tmp.Priority
 	p.Slots = tmp.Slots
+	p.UsagePeriod = tmp.UsagePeriod
+	p.UsageTerms = tmp.UsageTerms
 
 	return nil
 
@@ -3371,3 +4166,83 @@ func (d *DisplayGuide) UnmarshalJSON(data []byte) (err error) {
 	return nil
 
 }
+
+func (g *Group) UnmarshalJSON(data []byte) (err error) {
+
+	var tmp struct {
+		// durations are set to string for now
+		MaxUsage string `json:"max_usage" yaml:"max_usage"`
+
+		//others stay the same
+		Description        string   `json:"description" yaml:"description"`
+		EnforceMaxBookings bool     `json:"enforce_max_bookings" yaml:"enforce_max_bookings"`
+		EnforceMaxUsage    bool     `json:"enforce_max_usage" yaml:"enforce_max_usage"`
+		MaxBookings        int64    `json:"max_bookings" yaml:"max_bookings"`
+		Policies           []string `json:"policies" yaml:"policies"`
+	}
+
+	if err = json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	// set default durations
+	if tmp.MaxUsage == "" {
+		tmp.MaxUsage = "0s"
+	}
+
+	// parse durations
+	xu, err := time.ParseDuration(tmp.MaxUsage)
+	if err != nil {
+		return err
+	}
+
+	g.Description = tmp.Description
+	g.EnforceMaxBookings = tmp.EnforceMaxBookings
+	g.EnforceMaxUsage = tmp.EnforceMaxUsage
+	g.MaxBookings = tmp.MaxBookings
+	g.MaxUsage = xu
+	g.Policies = tmp.Policies
+
+	return nil
+
+}
+
+func (r *Resource) UnmarshalJSON(data []byte) (err error) {
+
+	var tmp struct {
+		// durations are set to string for now
+		Buffer string `json:"buffer" yaml:"buffer"`
+
+		//others stay the same
+		ConfigURL   string   `json:"config_url" yaml:"config_url"`
+		Description string   `json:"description" yaml:"description"`
+		Streams     []string `json:"streams" yaml:"streams"`
+		Tests       []string `json:"tests" yaml:"tests"`
+		TopicStub   string   `json:"topic_stub" yaml:"topic_stub"`
+	}
+
+	if err = json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	// set default durations
+	if tmp.Buffer == "" {
+		tmp.Buffer = "0s"
+	}
+
+	// parse durations
+	bu, err := time.ParseDuration(tmp.Buffer)
+	if err != nil {
+		return err
+	}
+
+	r.Buffer = bu
+	r.ConfigURL = tmp.ConfigURL
+	r.Description = tmp.Description
+	r.Streams = tmp.Streams
+	r.Tests = tmp.Tests
+	r.TopicStub = tmp.TopicStub
+
+	return nil
+
+}
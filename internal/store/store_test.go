@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -941,12 +943,85 @@ func TestAvailability(t *testing.T) {
 		bi = append(bi, b.When)
 	}
 
-	a := availability(bi, start, end)
+	a := availability(bi, start, end, 0)
 
 	assert.Equal(t, exp, a)
 
 }
 
+func TestGetAvailabilityBetween(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+	s := New()
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	now := time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC)
+	s.SetNow(func() time.Time { return now })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	b1 := interval.Interval{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}
+	_, err = s.MakeBooking("sl-a", "u-a", b1)
+	assert.NoError(t, err)
+	b2 := interval.Interval{Start: now.Add(3 * time.Hour), End: now.Add(4 * time.Hour)}
+	_, err = s.MakeBooking("sl-a", "u-a", b2)
+	assert.NoError(t, err)
+
+	all, err := s.GetAvailability("sl-a")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(all))
+
+	// zero from and to leave the window as set by the policy
+	a, err := s.GetAvailabilityBetween("sl-a", time.Time{}, time.Time{}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, all, a)
+
+	// limit
+	a, err = s.GetAvailabilityBetween("sl-a", time.Time{}, time.Time{}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, all[:2], a)
+
+	// window with a start and end that are trimmed
+	from := now.Add(30 * time.Minute)
+	to := now.Add(150 * time.Minute)
+	a, err = s.GetAvailabilityBetween("sl-a", from, to, 0)
+	assert.NoError(t, err)
+	exp := []interval.Interval{
+		interval.Interval{Start: from, End: b1.Start.Add(-time.Nanosecond)},
+		interval.Interval{Start: b1.End.Add(time.Nanosecond), End: to},
+	}
+	assert.Equal(t, exp, a)
+
+	// window with no bookings in it
+	a, err = s.GetAvailabilityBetween("sl-a", now.Add(5*time.Hour), now.Add(6*time.Hour), 0)
+	assert.NoError(t, err)
+	exp = []interval.Interval{
+		interval.Interval{Start: now.Add(5 * time.Hour), End: now.Add(6 * time.Hour)},
+	}
+	assert.Equal(t, exp, a)
+
+	// cannot see availability in the past
+	a, err = s.GetAvailabilityBetween("sl-a", now.Add(-time.Hour), now.Add(30*time.Minute), 0)
+	assert.NoError(t, err)
+	exp = []interval.Interval{
+		interval.Interval{Start: now, End: now.Add(30 * time.Minute)},
+	}
+	assert.Equal(t, exp, a)
+
+	a, err = s.GetAvailabilityBetween("sl-a", now.Add(-2*time.Hour), now.Add(-time.Hour), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []interval.Interval{}, a)
+
+	_, err = s.GetAvailabilityBetween("sl-a", to, from, 0)
+	assert.Error(t, err)
+
+	_, err = s.GetAvailabilityBetween("sl-x", from, to, 0)
+	assert.Error(t, err)
+}
+
 // TestBooking checks whether the availability calculations result in bookable
 // sessions that do not overlap the existing booked sessions.
 func TestAvailabilityTimeBoundaries(t *testing.T) {
@@ -980,7 +1055,7 @@ func TestAvailabilityTimeBoundaries(t *testing.T) {
 		bi = append(bi, b.When)
 	}
 
-	a := availability(bi, start, end)
+	a := availability(bi, start, end, 0)
 
 	d := diary.New("test")
 
@@ -3565,3 +3640,141 @@ func TestAddGroupForNewUser(t *testing.T) {
 	s.AddGroupForUser("user-a", "a")
 
 }
+
+// benchmarkConcurrent runs users refreshing availability, bookings and policies in parallel,
+// with every writeEvery-th operation (if non-zero) being a booking that is then cancelled,
+// so that the throughput of readers can be compared with and without writers taking the lock
+func benchmarkConcurrent(b *testing.B, writeEvery int64) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	s := New()
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC) })
+
+	err = s.ReplaceManifest(m)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	var n int64
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+
+			k := atomic.AddInt64(&n, 1)
+
+			if writeEvery > 0 && k%writeEvery == 0 {
+				// spread the bookings over the day so that they rarely clash
+				start := time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC).Add(time.Duration(k%1000) * time.Minute)
+				bk, err := s.MakeBooking("sl-a", "u-a", interval.Interval{Start: start, End: start.Add(30 * time.Second)})
+				if err == nil {
+					s.CancelBooking(bk, "u-a")
+				}
+				continue
+			}
+
+			switch k % 3 {
+			case 0:
+				s.GetAvailability("sl-a")
+			case 1:
+				s.GetBookingsFor("u-a")
+			case 2:
+				s.GetPolicy("p-a")
+			}
+		}
+	})
+}
+
+func BenchmarkConcurrentReaders(b *testing.B) {
+	benchmarkConcurrent(b, 0)
+}
+
+func BenchmarkConcurrentReadersAndWriters(b *testing.B) {
+	for _, w := range []int64{100, 10} {
+		b.Run("write-every-"+strconv.FormatInt(w, 10), func(b *testing.B) {
+			benchmarkConcurrent(b, w)
+		})
+	}
+}
+
+func TestResourceBuffer(t *testing.T) {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	r := m.Resources["r-a"]
+	r.Buffer = -5 * time.Minute
+	m.Resources["r-a"] = r
+
+	err, msg := CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"buffer cannot be negative in resource r-a"}, msg)
+
+	r.Buffer = 5 * time.Minute
+	m.Resources["r-a"] = r
+
+	s := New()
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	user := "u-a"
+	s.AddGroupForUser(user, "g-a")
+
+	_, err = s.MakeBooking("sl-a", user, interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 10, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	_, err = s.MakeBooking("sl-a", user, interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 12, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 20, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+
+	// the turnaround time is shown as unavailable
+	a, err := s.GetAvailabilityBetween("sl-a", time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC), time.Date(2022, 11, 5, 2, 30, 0, 0, time.UTC), 0)
+	assert.NoError(t, err)
+
+	exp := []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 1, 55, 0, 0, time.UTC),
+		},
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 2, 15, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 2, 30, 0, 0, time.UTC),
+		},
+	}
+	assert.Equal(t, exp, a)
+
+	// but is not charged to the user
+	assert.Equal(t, 10*time.Minute, *s.Users[user].Usage["p-a"])
+
+	// a booking in the first gap keeps its own turnaround time free
+	_, err = s.MakeBooking("sl-a", user, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 55, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	a, err = s.GetAvailabilityBetween("sl-a", time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC), time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []interval.Interval{
+		interval.Interval{
+			Start: time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, 1, 45, 0, 0, time.UTC),
+		},
+	}, a)
+}
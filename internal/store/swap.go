@@ -0,0 +1,149 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// SwapBooking cancels an existing booking and replaces it with a new booking for the slot and interval,
+// under the same lock, so that the user cannot lose their booking to someone else in between.
+// If the new booking cannot be made, the existing booking is restored unchanged.
+// The usage for the existing booking is refunded before the new booking is checked against
+// the policy, so a user at their usage limit can still move their booking.
+// Bookings that have started cannot be swapped, because the relay cannot be told to undo a cancellation.
+func (s *Store) SwapBooking(booking Booking, slot string, when interval.Interval, cancelledBy string) (Booking, error) {
+	where := "store.SwapBooking"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	name := uuid.New().String()
+
+	b, err := s.swapBooking(booking, slot, when, name, cancelledBy)
+
+	msg := "successful swap"
+
+	if err != nil {
+		msg = "failed swap because " + err.Error()
+	} else {
+		s.record(history.Action{
+			Do:         history.SwapBooking,
+			Booking:    booking.Name,
+			NewBooking: name,
+			Slot:       slot,
+			User:       booking.User,
+			When:       when,
+			Reason:     cancelledBy,
+		})
+
+		s.offerFreedTime()
+	}
+
+	log.WithFields(log.Fields{"booking": booking.Name, "slot": slot, "user": booking.User, "start": when.Start.String(), "end": when.End.String(), "name": name}).Info(msg)
+
+	return b, err
+}
+
+// swapBooking cancels the booking and makes a new one with the given name, restoring the original
+// booking if the new booking fails. The new booking is for the same user, and checks their groups.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) swapBooking(booking Booking, slot string, when interval.Interval, name, cancelledBy string) (Booking, error) {
+
+	b, ok := s.Bookings[booking.Name]
+
+	if !ok {
+		return Booking{}, errors.New("booking " + booking.Name + " not found")
+	}
+
+	if b.Started {
+		return Booking{}, errors.New("cannot swap booking that has already been used")
+	}
+
+	err := s.cancelBooking(booking, cancelledBy)
+
+	if err != nil {
+		return Booking{}, errors.New("could not cancel existing booking because " + err.Error())
+	}
+
+	nb, err := s.makeBookingWithName(slot, booking.User, when, name, true)
+
+	if err != nil {
+
+		rerr := s.uncancelBooking(b)
+
+		if rerr != nil {
+			// should not happen, because the diary and usage have only just been freed
+			log.WithFields(log.Fields{"user": b.User, "booking": b.Name}).Errorf("swap failed to restore booking because %s", rerr.Error())
+			return Booking{}, errors.New("could not make new booking because " + err.Error() + " and could not restore existing booking because " + rerr.Error())
+		}
+
+		return Booking{}, errors.New("could not make new booking because " + err.Error())
+	}
+
+	return nb, nil
+}
+
+// uncancelBooking reverses the cancellation of an unstarted booking, by returning it to the
+// current bookings (including the user's) and the diary, and charging the usage that was refunded on cancellation
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) uncancelBooking(b *Booking) error {
+
+	p, err := s.getPolicy(b.Policy)
+
+	if err != nil {
+		return err
+	}
+
+	usage, err := calculateUsage(*b, p)
+
+	if err != nil {
+		return err
+	}
+
+	refund := b.When.End.Sub(b.When.Start) - usage
+
+	if !p.EnforceUnlimitedUsers {
+
+		r := s.Resources[s.bookingResource(*b)]
+
+		// unavailable diaries do not accept bookings, but the booking was in the diary
+		// before the swap was attempted, so put it back without changing the availability
+		if ok, _ := r.Diary.IsAvailable(); !ok {
+			status := r.Diary.Status()
+			r.Diary.SetAvailable(status)
+			defer r.Diary.SetUnavailable(status)
+		}
+
+		err := r.Diary.Request(b.When, b.Name)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	delete(s.OldBookings, b.Name)
+
+	b.Cancelled = false
+	b.CancelledAt = time.Time{}
+	b.CancelledBy = ""
+
+	s.Bookings[b.Name] = b
+
+	if u, ok := s.Users[b.User]; ok {
+		// the cancelled booking may already have been pruned to the user's old bookings
+		delete(u.OldBookings, b.Name)
+		u.Bookings[b.Name] = b
+		*u.Usage[b.Policy] = *u.Usage[b.Policy] + refund
+	}
+
+	return nil
+}
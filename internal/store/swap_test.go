@@ -0,0 +1,147 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestSwapBooking(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	err = s.AddGroupForUser("user1", "g-b")
+	assert.NoError(t, err)
+	err = s.AddGroupForUser("user2", "g-b")
+	assert.NoError(t, err)
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk0", true)
+	assert.NoError(t, err)
+
+	b1, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 7, 0, 0, time.UTC),
+	}, "bk1", true)
+	assert.NoError(t, err)
+
+	_, err = s.MakeBookingWithName("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 20, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 30, 0, 0, time.UTC),
+	}, "bk2", true)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 17*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// user1 is at max_bookings, and the new booking overlaps the old one,
+	// so this only succeeds if the old booking is cancelled first
+	nb, err := s.SwapBooking(b0, "sl-b", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 35, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 43, 0, 0, time.UTC),
+	}, "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", nb.User)
+	assert.Equal(t, "p-b", nb.Policy)
+
+	_, err = s.GetBooking("bk0")
+	assert.Error(t, err)
+	assert.True(t, s.OldBookings["bk0"].Cancelled)
+	assert.Equal(t, "user1", s.OldBookings["bk0"].CancelledBy)
+
+	// cancelled before start, so the whole of the old booking is refunded
+	assert.Equal(t, 15*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// the new booking conflicts with bk2, so bk1 is kept unchanged
+	_, err = s.SwapBooking(b1, "sl-b", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 20, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 27, 0, 0, time.UTC),
+	}, "user1")
+	assert.Error(t, err)
+
+	b, err := s.GetBooking("bk1")
+	assert.NoError(t, err)
+	assert.False(t, b.Cancelled)
+	_, ok := s.OldBookings["bk1"]
+	assert.False(t, ok)
+	assert.Equal(t, 15*time.Minute, *s.Users["user1"].Usage["p-b"])
+
+	// the restored booking still counts towards the user's bookings
+	_, ok = s.Users["user1"].Bookings["bk1"]
+	assert.True(t, ok)
+	_, ok = s.Users["user1"].OldBookings["bk1"]
+	assert.False(t, ok)
+
+	ps, err := s.GetPolicyStatusFor("user1", "p-b")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), ps.CurrentBookings)
+
+	_, err = s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 3, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 3, 7, 0, 0, time.UTC),
+	}, "bk4", true)
+	assert.Error(t, err)
+
+	// bk1 is still in the diary
+	_, err = s.MakeBookingWithName("sl-b", "user2", b1.When, "bk3", true)
+	assert.Error(t, err)
+
+	// a failed swap does not lose the booking if the resource has become unavailable
+	err = s.SetResourceIsAvailable("r-b", false, "broken")
+	assert.NoError(t, err)
+
+	_, err = s.SwapBooking(b1, "sl-b", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 40, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 47, 0, 0, time.UTC),
+	}, "user1")
+	assert.Error(t, err)
+
+	ok, reason, err := s.GetResourceIsAvailable("r-b")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "unavailable because broken", reason)
+
+	err = s.SetResourceIsAvailable("r-b", true, "fixed")
+	assert.NoError(t, err)
+
+	_, err = s.MakeBookingWithName("sl-b", "user2", b1.When, "bk3", true)
+	assert.Error(t, err)
+
+	// started bookings cannot be swapped
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 2, 1, 0, 0, time.UTC) })
+
+	_, err = s.GetActivity(b1)
+	assert.NoError(t, err)
+
+	_, err = s.SwapBooking(b1, "sl-b", interval.Interval{
+		Start: time.Date(2022, 11, 5, 2, 40, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 2, 47, 0, 0, time.UTC),
+	}, "user1")
+	assert.Error(t, err)
+
+	// swaps are replayed from the history
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 2, 1, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportOldBookings(), s2.ExportOldBookings())
+}
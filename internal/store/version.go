@@ -0,0 +1,119 @@
+package store
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultMaxManifestVersions is how many of the most recently applied manifests are retained, unless set otherwise
+const DefaultMaxManifestVersions = 10
+
+// ManifestVersionInfo represents when a version of the manifest was applied, and by whom
+type ManifestVersionInfo struct {
+	Applied time.Time `json:"applied" yaml:"applied"`
+	Author  string    `json:"author" yaml:"author"`
+	Version int       `json:"version" yaml:"version"`
+}
+
+// ManifestVersion represents a manifest that was applied to the store, so that it can be reapplied later
+type ManifestVersion struct {
+	Applied  time.Time `json:"applied" yaml:"applied"`
+	Author   string    `json:"author" yaml:"author"`
+	Manifest Manifest  `json:"manifest" yaml:"manifest"`
+	Version  int       `json:"version" yaml:"version"`
+}
+
+// WithMaxManifestVersions sets how many of the most recently applied manifests are retained
+// At least the current manifest is always retained
+func (s *Store) WithMaxManifestVersions(n int) *Store {
+	s.Lock()
+	defer s.Unlock()
+	s.MaxManifestVersions = n
+	s.trimManifestVersions()
+	return s
+}
+
+// GetManifestVersions returns when each of the retained versions of the manifest was applied, and by whom,
+// oldest first. The last version is the current manifest.
+func (s *Store) GetManifestVersions() []ManifestVersionInfo {
+	where := "store.GetManifestVersions"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	vs := []ManifestVersionInfo{}
+
+	for _, v := range s.ManifestVersions {
+		vs = append(vs, ManifestVersionInfo{
+			Applied: v.Applied,
+			Author:  v.Author,
+			Version: v.Version,
+		})
+	}
+
+	return vs
+}
+
+// GetManifestVersion returns a retained version of the manifest, including the manifest itself,
+// so that it can be reapplied with ReplaceManifest
+func (s *Store) GetManifestVersion(version int) (ManifestVersion, error) {
+	where := "store.GetManifestVersion"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	for _, v := range s.ManifestVersions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+
+	return ManifestVersion{}, errors.New("manifest version " + strconv.Itoa(version) + " not found")
+}
+
+// addManifestVersion retains the current manifest as a new version, numbered one more than the last version,
+// discarding the oldest versions if there are more than MaxManifestVersions
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) addManifestVersion(author string, applied time.Time) {
+
+	version := 1
+
+	if n := len(s.ManifestVersions); n > 0 {
+		version = s.ManifestVersions[n-1].Version + 1
+	}
+
+	s.ManifestVersions = append(s.ManifestVersions, ManifestVersion{
+		Applied:  applied,
+		Author:   author,
+		Manifest: s.exportManifest(),
+		Version:  version,
+	})
+
+	s.trimManifestVersions()
+}
+
+// trimManifestVersions discards the oldest versions if there are more than MaxManifestVersions
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) trimManifestVersions() {
+
+	max := s.MaxManifestVersions
+
+	if max < 1 {
+		max = 1
+	}
+
+	if n := len(s.ManifestVersions); n > max {
+		s.ManifestVersions = append([]ManifestVersion{}, s.ManifestVersions[n-max:]...)
+	}
+}
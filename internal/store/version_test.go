@@ -0,0 +1,121 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestManifestVersions(t *testing.T) {
+
+	h := history.New("test")
+
+	t0 := time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC)
+	t1 := time.Date(2022, 11, 5, 1, 1, 0, 0, time.UTC)
+	t2 := time.Date(2022, 11, 5, 1, 2, 0, 0, time.UTC)
+	t3 := time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC)
+
+	s := New().WithHistory(h).WithMaxManifestVersions(3)
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return t0 })
+
+	err = s.ReplaceManifestWithAuthor(m, "alice")
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return t1 })
+
+	err, msg := s.PatchManifestWithAuthor(ManifestPatch{
+		Set: Manifest{
+			Streams: map[string]Stream{"st-new": m.Streams["st-a"]},
+		},
+	}, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	s.SetNow(func() time.Time { return t2 })
+
+	_, err, msg = s.ReconcileManifestWithAuthor(m, ReconcileKeep, "carol")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	expected := []ManifestVersionInfo{
+		{Applied: t0, Author: "alice", Version: 1},
+		{Applied: t1, Author: "bob", Version: 2},
+		{Applied: t2, Author: "carol", Version: 3},
+	}
+
+	assert.Equal(t, expected, s.GetManifestVersions())
+
+	v, err := s.GetManifestVersion(2)
+	assert.NoError(t, err)
+	_, ok := v.Manifest.Streams["st-new"]
+	assert.True(t, ok)
+
+	v, err = s.GetManifestVersion(1)
+	assert.NoError(t, err)
+	_, ok = v.Manifest.Streams["st-new"]
+	assert.False(t, ok)
+
+	// failed replacements are not retained
+	bad := Manifest{}
+	err = yaml.Unmarshal(manifestYAML, &bad)
+	assert.NoError(t, err)
+	delete(bad.Policies, "p-a")
+
+	err = s.ReplaceManifest(bad)
+	assert.Error(t, err)
+	assert.Equal(t, 3, len(s.GetManifestVersions()))
+
+	// rolling back reapplies an earlier version, as a new version, discarding the oldest
+	s.SetNow(func() time.Time { return t3 })
+
+	v, err = s.GetManifestVersion(2)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifestWithAuthor(v.Manifest, "dave")
+	assert.NoError(t, err)
+
+	expected = []ManifestVersionInfo{
+		{Applied: t1, Author: "bob", Version: 2},
+		{Applied: t2, Author: "carol", Version: 3},
+		{Applied: t3, Author: "dave", Version: 4},
+	}
+
+	assert.Equal(t, expected, s.GetManifestVersions())
+
+	_, ok = s.ExportManifest().Streams["st-new"]
+	assert.True(t, ok)
+
+	_, err = s.GetManifestVersion(1)
+	assert.Error(t, err)
+	assert.Equal(t, "manifest version 1 not found", err.Error())
+
+	// the versions are rebuilt when the history is replayed
+	s2 := New().WithMaxManifestVersions(3)
+
+	err, msg = s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, expected, s2.GetManifestVersions())
+
+	// and restored from a snapshot
+	s3 := New().WithMaxManifestVersions(3)
+
+	err, msg = s3.RestoreSnapshot(s.ExportSnapshot())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, expected, s3.GetManifestVersions())
+
+	v, err = s3.GetManifestVersion(4)
+	assert.NoError(t, err)
+	assert.Equal(t, "dave", v.Author)
+	assert.Equal(t, s.ExportManifest(), v.Manifest)
+
+}
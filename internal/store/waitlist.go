@@ -0,0 +1,522 @@
+package store
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultWaitlistOfferHold is how long freed time offered to a waiting user is held for them, unless set otherwise
+const DefaultWaitlistOfferHold = 15 * time.Minute
+
+// WaitlistEntry represents a user's interest in booking a slot for a time range that is
+// currently unavailable. When an overlapping booking on the slot's resource is cancelled,
+// the freed time is offered to the first user waiting, or booked for them automatically
+// if the slot's policy has AutoBookWaitlist set. Offered time is held for the user until
+// the offer expires, so that no-one else can book it in the meantime.
+type WaitlistEntry struct {
+	// JoinedAt is when the user joined the waitlist, so that entries are served first come, first served
+	JoinedAt time.Time `json:"joined_at" yaml:"joined_at"`
+	Name     string    `json:"name" yaml:"name"`
+	// Offered is the freed time offered to the user, which they can then book as usual (zero if there is no offer)
+	Offered interval.Interval `json:"offered" yaml:"offered"`
+	// OfferedAt is when the offer was made
+	OfferedAt time.Time `json:"offered_at" yaml:"offered_at"`
+	// OfferExpiresAt is when the offered time stops being held for the user
+	OfferExpiresAt time.Time         `json:"offer_expires_at" yaml:"offer_expires_at"`
+	Slot           string            `json:"slot" yaml:"slot"`
+	User           string            `json:"user" yaml:"user"`
+	When           interval.Interval `json:"when" yaml:"when"`
+}
+
+// WithWaitlistOfferHold sets how long freed time offered to a waiting user is held for them
+func (s *Store) WithWaitlistOfferHold(d time.Duration) *Store {
+	s.Lock()
+	defer s.Unlock()
+	s.waitlistOfferHold = d
+	return s
+}
+
+// JoinWaitlist registers a user's interest in booking the slot during the interval,
+// so that they are offered (or given) any overlapping time that is freed by cancelling, shortening or moving a booking
+func (s *Store) JoinWaitlist(slot, user string, when interval.Interval) (WaitlistEntry, error) {
+	where := "store.JoinWaitlist"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	name := uuid.New().String()
+
+	w, err := s.joinWaitlist(slot, user, when, name)
+
+	if err != nil {
+		return WaitlistEntry{}, err
+	}
+
+	s.record(history.Action{
+		Do:    history.JoinWaitlist,
+		Entry: name,
+		Slot:  slot,
+		User:  user,
+		When:  when,
+	})
+
+	return w, nil
+}
+
+// joinWaitlist adds an entry to the waitlist, if the user could book the slot
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) joinWaitlist(slot, user string, when interval.Interval, name string) (WaitlistEntry, error) {
+
+	if _, ok := s.Waitlist[name]; ok {
+		return WaitlistEntry{}, errors.New("name already in use")
+	}
+
+	if !when.End.After(when.Start) {
+		return WaitlistEntry{}, errors.New("end must be after start")
+	}
+
+	if !when.End.After(s.now()) {
+		return WaitlistEntry{}, errors.New("cannot wait for time that has already passed")
+	}
+
+	err := s.checkUserCanBook(slot, user)
+
+	if err != nil {
+		return WaitlistEntry{}, err
+	}
+
+	w := WaitlistEntry{
+		JoinedAt: s.now(),
+		Name:     name,
+		Slot:     slot,
+		User:     user,
+		When:     when,
+	}
+
+	s.Waitlist[name] = &w
+
+	return w, nil
+}
+
+// LeaveWaitlist removes an entry from the waitlist, if it belongs to the user
+func (s *Store) LeaveWaitlist(user, name string) error {
+	where := "store.LeaveWaitlist"
+	log.Trace(where + " awaiting lock")
+	s.Lock()
+	log.Trace(where + " has lock")
+	defer func() {
+		s.Unlock()
+		log.Trace(where + " released lock")
+	}()
+
+	err := s.leaveWaitlist(user, name)
+
+	if err != nil {
+		return err
+	}
+
+	s.record(history.Action{
+		Do:    history.LeaveWaitlist,
+		Entry: name,
+		User:  user,
+	})
+
+	return nil
+}
+
+// leaveWaitlist removes an entry from the waitlist
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) leaveWaitlist(user, name string) error {
+
+	w, ok := s.Waitlist[name]
+
+	if !ok {
+		return errors.New("not found")
+	}
+
+	if w.User != user {
+		return errors.New("not found")
+	}
+
+	delete(s.Waitlist, name)
+
+	return nil
+}
+
+// GetWaitlistFor returns the user's waitlist entries, in the order that they were joined
+func (s *Store) GetWaitlistFor(user string) ([]WaitlistEntry, error) {
+	where := "store.GetWaitlistFor"
+	log.Trace(where + " awaiting Rlock")
+	s.RLock()
+	log.Trace(where + " has Rlock")
+	defer func() {
+		s.RUnlock()
+		log.Trace(where + " released Rlock")
+	}()
+
+	if _, ok := s.Users[user]; !ok {
+		return []WaitlistEntry{}, errors.New("user not found")
+	}
+
+	ws := []WaitlistEntry{}
+
+	for _, w := range s.getWaitlist() {
+		if w.User == user {
+			ws = append(ws, *w)
+		}
+	}
+
+	return ws, nil
+}
+
+// getWaitlist returns all the waitlist entries, first come first served
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) getWaitlist() []*WaitlistEntry {
+
+	ws := []*WaitlistEntry{}
+
+	for _, w := range s.Waitlist {
+		ws = append(ws, w)
+	}
+
+	sort.Slice(ws, func(i, j int) bool {
+		if ws[i].JoinedAt.Equal(ws[j].JoinedAt) {
+			return ws[i].Name < ws[j].Name
+		}
+		return ws[i].JoinedAt.Before(ws[j].JoinedAt)
+	})
+
+	return ws
+}
+
+// checkUserCanBook checks that the slot exists and that the user belongs to a group
+// that includes the slot's policy
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) checkUserCanBook(slot, user string) error {
+
+	sl, ok := s.Slots[slot]
+
+	if !ok {
+		return errors.New("slot " + slot + " not found")
+	}
+
+	return s.checkUserHasPolicy(user, sl.Policy)
+}
+
+// checkUserHasPolicy checks that the user belongs to a group that includes the policy
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) checkUserHasPolicy(user, policy string) error {
+
+	u, ok := s.Users[user]
+
+	if !ok {
+		return errors.New("user " + user + " not found")
+	}
+
+	for gn := range u.Groups {
+		if g, ok := s.Groups[gn]; ok {
+			for _, p := range g.Policies {
+				if p == policy {
+					return nil
+				}
+			}
+		}
+	}
+
+	return errors.New("user " + user + " belongs to no group that includes this policy")
+}
+
+// freedTime represents time freed on a resource that is yet to be offered to the waitlist
+type freedTime struct {
+	resource string
+	when     interval.Interval
+}
+
+// freeTime notes that time has been freed on the resource, e.g. by cancelling, shortening or moving
+// a booking, so that it can be offered to the waitlist once the change has been recorded
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) freeTime(resource string, when interval.Interval) {
+
+	if s.replaying {
+		return
+	}
+
+	s.freed = append(s.freed, freedTime{resource, when})
+}
+
+// offerFreedTime offers the parts of the freed time that are still free to the waitlist, e.g. leaving out
+// the new interval of a booking that has been moved, and forgets the freed time. It must be called after
+// the change that freed the time has been recorded, so that the offers are recorded after it.
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) offerFreedTime() {
+
+	// time freed by bumping when making waitlist bookings is added to the end, and offered in turn
+	for len(s.freed) > 0 {
+
+		f := s.freed[0]
+		s.freed = s.freed[1:]
+
+		if s.replaying || len(s.Waitlist) == 0 {
+			continue
+		}
+
+		r, ok := s.Resources[f.resource]
+
+		if !ok || r.Diary == nil {
+			continue
+		}
+
+		for _, when := range freeParts(r, f.when) {
+			s.fillFromWaitlist(f.resource, when)
+		}
+	}
+}
+
+// freeParts returns the parts of the interval that are not kept out of the resource's diary by
+// the bookings in it, including their turnaround time
+func freeParts(r Resource, when interval.Interval) []interval.Interval {
+
+	occupied := []interval.Interval{}
+
+	for _, b := range r.Diary.GetBookingsOverlapping(r.Diary.Occupied(when)) {
+		occupied = append(occupied, r.Diary.Occupied(b.When))
+	}
+
+	if len(occupied) == 0 {
+		return []interval.Interval{when}
+	}
+
+	parts := []interval.Interval{}
+
+	for _, f := range interval.Invert(occupied) {
+
+		if f.Start.Before(when.Start) {
+			f.Start = when.Start
+		}
+
+		if f.End.After(when.End) {
+			f.End = when.End
+		}
+
+		if f.End.After(f.Start) {
+			parts = append(parts, f)
+		}
+	}
+
+	return parts
+}
+
+// fillFromWaitlist offers the time freed on the resource to the first user waiting
+// for an overlapping time on the same resource. If the policy of the slot they are waiting for
+// has AutoBookWaitlist set, then the time is booked for them instead, and they leave the waitlist.
+// Users are only offered, or given, time that they could book themselves under the policy.
+// The outcome is recorded, rather than being repeated during replay, because automatic bookings
+// are given new names. The freed time must be free in the resource's diary, and the change that
+// freed it must be recorded before calling this function (see offerFreedTime).
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) fillFromWaitlist(resource string, freed interval.Interval) {
+
+	if s.replaying || len(s.Waitlist) == 0 {
+		return
+	}
+
+	if freed.Start.Before(s.now()) {
+		freed.Start = s.now()
+	}
+
+	if !freed.End.After(freed.Start) {
+		return
+	}
+
+	for _, w := range s.getWaitlist() {
+
+		wsl, ok := s.Slots[w.Slot]
+
+		if !ok || wsl.Resource != resource {
+			continue
+		}
+
+		if s.offerIsHeld(w) {
+			continue // already has an offer, so let the next user have this one
+		}
+
+		if !(w.When.Start.Before(freed.End) && w.When.End.After(freed.Start)) {
+			continue // no overlap
+		}
+
+		when := w.When
+
+		if when.Start.Before(freed.Start) {
+			when.Start = freed.Start
+		}
+
+		if when.End.After(freed.End) {
+			when.End = freed.End
+		}
+
+		p, ok := s.Policies[wsl.Policy]
+
+		if !ok {
+			continue
+		}
+
+		if p.AutoBookWaitlist {
+
+			name := uuid.New().String()
+
+			_, err := s.makeBookingWithName(w.Slot, w.User, when, name, true)
+
+			if err != nil {
+				log.WithFields(log.Fields{"user": w.User, "slot": w.Slot, "entry": w.Name}).Debugf("waitlist booking not made because %s", err.Error())
+				continue
+			}
+
+			s.record(history.Action{
+				Do:      history.RequestBooking,
+				Slot:    w.Slot,
+				User:    w.User,
+				When:    when,
+				Booking: name,
+				Flag:    true,
+			})
+
+			delete(s.Waitlist, w.Name)
+
+			s.record(history.Action{
+				Do:     history.LeaveWaitlist,
+				Entry:  w.Name,
+				User:   w.User,
+				Reason: name,
+			})
+
+			log.WithFields(log.Fields{"user": w.User, "slot": w.Slot, "entry": w.Name, "booking": name}).Info("booked freed time for waiting user")
+
+			return
+		}
+
+		err := s.checkUserCanBook(w.Slot, w.User)
+
+		if err == nil {
+			currentUsage := time.Duration(0)
+			if ut, ok := s.Users[w.User].Usage[wsl.Policy]; ok {
+				currentUsage = *ut
+			}
+			err = s.checkWhen(w.Slot, wsl, p, when, currentUsage, true)
+		}
+
+		if err == nil {
+			err = s.checkPeriodUsage(w.User, wsl.Policy, p, when, "")
+		}
+
+		if err != nil {
+			log.WithFields(log.Fields{"user": w.User, "slot": w.Slot, "entry": w.Name}).Debugf("waitlist offer not made because %s", err.Error())
+			continue
+		}
+
+		s.offerWaitlist(w.Name, when)
+
+		s.record(history.Action{
+			Do:    history.OfferWaitlist,
+			Entry: w.Name,
+			User:  w.User,
+			When:  when,
+		})
+
+		log.WithFields(log.Fields{"user": w.User, "slot": w.Slot, "entry": w.Name}).Info("offered freed time to waiting user")
+
+		return
+	}
+}
+
+// offerWaitlist records an offer of freed time against a waitlist entry, holding the time for the
+// user until the offer expires, or the offered time ends, whichever is sooner
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) offerWaitlist(name string, when interval.Interval) error {
+
+	w, ok := s.Waitlist[name]
+
+	if !ok {
+		return errors.New("waitlist entry " + name + " not found")
+	}
+
+	w.Offered = when
+	w.OfferedAt = s.now()
+	w.OfferExpiresAt = s.now().Add(s.waitlistOfferHold)
+
+	if w.OfferExpiresAt.After(when.End) {
+		w.OfferExpiresAt = when.End
+	}
+
+	return nil
+}
+
+// offerIsHeld returns true if the entry has an offer of freed time that has not yet expired
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) offerIsHeld(w *WaitlistEntry) bool {
+	return w.OfferExpiresAt.After(s.now())
+}
+
+// checkWaitlistHolds returns an error if the interval on the resource overlaps time that is held
+// for another user, because it has been offered to them from the waitlist
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) checkWaitlistHolds(resource, user string, when interval.Interval) error {
+
+	for _, w := range s.Waitlist {
+
+		if w.User == user || !s.offerIsHeld(w) {
+			continue
+		}
+
+		if sl, ok := s.Slots[w.Slot]; !ok || sl.Resource != resource {
+			continue
+		}
+
+		if w.Offered.Start.Before(when.End) && w.Offered.End.After(when.Start) {
+			return errors.New("time is held for a user on the waitlist until " + w.OfferExpiresAt.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+// takeWaitlistOffers removes the user's waitlist entries with offers held on the resource that overlap
+// the interval, because the user has booked the offered time
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) takeWaitlistOffers(resource, user string, when interval.Interval) {
+
+	for k, w := range s.Waitlist {
+
+		if w.User != user || !s.offerIsHeld(w) {
+			continue
+		}
+
+		if sl, ok := s.Slots[w.Slot]; !ok || sl.Resource != resource {
+			continue
+		}
+
+		if w.Offered.Start.Before(when.End) && w.Offered.End.After(when.Start) {
+			delete(s.Waitlist, k)
+		}
+	}
+}
+
+// pruneWaitlist removes entries for time ranges that have passed
+// Internal usage only - no lock, calling function must take the lock
+func (s *Store) pruneWaitlist() {
+
+	for k, v := range s.Waitlist {
+		if s.now().After(v.When.End) {
+			delete(s.Waitlist, k)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/history"
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWaitlistOffer(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	for _, u := range []string{"user1", "user2", "user3"} {
+		err = s.AddGroupForUser(u, "g-b")
+		assert.NoError(t, err)
+	}
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", when, "bk0", true)
+	assert.NoError(t, err)
+
+	// users must be able to book the slot to wait for it
+	_, err = s.JoinWaitlist("sl-a", "user2", when)
+	assert.Error(t, err)
+
+	w2, err := s.JoinWaitlist("sl-b", "user2", when)
+	assert.NoError(t, err)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 1, 0, 0, time.UTC) })
+
+	w3, err := s.JoinWaitlist("sl-b", "user3", when)
+	assert.NoError(t, err)
+
+	// a cancellation on another resource does not affect the waitlist
+	_, err = s.MakeBookingWithName("sl-a", "user1", when, "bk1", false)
+	assert.NoError(t, err)
+	err = s.CancelBooking(Booking{Name: "bk1", Policy: "p-a", Slot: "sl-a", User: "user1", When: when}, "user1")
+	assert.NoError(t, err)
+
+	ws, err := s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{w2}, ws)
+
+	err = s.CancelBooking(b0, "user1")
+	assert.NoError(t, err)
+
+	// first come, first served
+	ws, err = s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ws))
+	assert.Equal(t, when, ws[0].Offered)
+	ws, err = s.GetWaitlistFor("user3")
+	assert.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{w3}, ws)
+
+	// the offered time is held for the user, until the offer expires
+	ws, err = s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 11, 5, 1, 16, 0, 0, time.UTC), ws[0].OfferExpiresAt)
+
+	_, err = s.MakeBookingWithName("sl-b", "user3", when, "bk3", true)
+	assert.Error(t, err)
+	assert.Equal(t, "time is held for a user on the waitlist until 2022-11-05T01:16:00Z", err.Error())
+
+	err = s.LeaveWaitlist("user3", w2.Name)
+	assert.Error(t, err)
+
+	// the offer is not a booking, so the user books it as usual, which takes up the offer
+	_, err = s.MakeBookingWithName("sl-b", "user2", when, "bk2", true)
+	assert.NoError(t, err)
+
+	ws, err = s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{}, ws)
+
+	// the waitlist is replayed from the history
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 1, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportSnapshot().Waitlist, s2.ExportSnapshot().Waitlist)
+
+	// entries are pruned once the time has passed
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 41, 0, 0, time.UTC) })
+	s.PruneAll()
+	ws, err = s.GetWaitlistFor("user3")
+	assert.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{}, ws)
+}
+
+func TestWaitlistAutoBook(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	p := m.Policies["p-b"]
+	p.AutoBookWaitlist = true
+	m.Policies["p-b"] = p
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	for _, u := range []string{"user1", "user2", "user3"} {
+		err = s.AddGroupForUser(u, "g-b")
+		assert.NoError(t, err)
+	}
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", when, "bk0", true)
+	assert.NoError(t, err)
+
+	// user2 is at max_bookings, so will be skipped
+	_, err = s.MakeBookingWithName("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 10, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 15, 0, 0, time.UTC),
+	}, "bk1", true)
+	assert.NoError(t, err)
+	_, err = s.MakeBookingWithName("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 16, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 21, 0, 0, time.UTC),
+	}, "bk2", true)
+	assert.NoError(t, err)
+
+	_, err = s.JoinWaitlist("sl-b", "user2", when)
+	assert.NoError(t, err)
+
+	// only the overlapping time is booked
+	w3, err := s.JoinWaitlist("sl-b", "user3", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 25, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 38, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	err = s.CancelBooking(b0, "user1")
+	assert.NoError(t, err)
+
+	bs, err := s.GetBookingsFor("user3")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(bs))
+	assert.Equal(t, "sl-b", bs[0].Slot)
+	assert.Equal(t, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 38, 0, 0, time.UTC),
+	}, bs[0].When)
+	assert.Equal(t, 8*time.Minute, *s.Users["user3"].Usage["p-b"])
+
+	ws, err := s.GetWaitlistFor("user3")
+	assert.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{}, ws)
+	_, ok := s.Waitlist[w3.Name]
+	assert.False(t, ok)
+
+	ws, err = s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ws))
+
+	// the automatic booking is replayed from the history
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 1, 0, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportSnapshot().Waitlist, s2.ExportSnapshot().Waitlist)
+}
+
+func TestWaitlistOfferExpires(t *testing.T) {
+
+	s := New().WithWaitlistOfferHold(5 * time.Minute)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	for _, u := range []string{"user1", "user2", "user3"} {
+		err = s.AddGroupForUser(u, "g-b")
+		assert.NoError(t, err)
+	}
+
+	when := interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", when, "bk0", true)
+	assert.NoError(t, err)
+
+	_, err = s.JoinWaitlist("sl-b", "user2", when)
+	assert.NoError(t, err)
+
+	err = s.CancelBooking(b0, "user1")
+	assert.NoError(t, err)
+
+	// held for user2, including against the user who cancelled
+	_, err = s.MakeBookingWithName("sl-b", "user1", when, "bk1", true)
+	assert.Error(t, err)
+
+	// nor can another booking be extended into the held time
+	b2, err := s.MakeBookingWithName("sl-b", "user3", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 20, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 25, 0, 0, time.UTC),
+	}, "bk2", true)
+	assert.NoError(t, err)
+
+	_, err = s.ResizeBooking(b2, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 23, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 32, 0, 0, time.UTC),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "time is held for a user on the waitlist until 2022-11-05T01:05:00Z", err.Error())
+
+	// once the offer expires, anyone can book the time
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 6, 0, 0, time.UTC) })
+
+	_, err = s.MakeBookingWithName("sl-b", "user1", when, "bk1", true)
+	assert.NoError(t, err)
+}
+
+func TestWaitlistOfferAfterSwapAndResize(t *testing.T) {
+
+	h := history.New("test")
+
+	s := New().WithHistory(h)
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 0, 0, 0, time.UTC) })
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	err = s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	for _, u := range []string{"user1", "user2", "user3"} {
+		err = s.AddGroupForUser(u, "g-b")
+		assert.NoError(t, err)
+	}
+
+	b0, err := s.MakeBookingWithName("sl-b", "user1", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+	}, "bk0", true)
+	assert.NoError(t, err)
+
+	w2, err := s.JoinWaitlist("sl-b", "user2", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 36, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	w3, err := s.JoinWaitlist("sl-b", "user3", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 50, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	// the time freed by moving the booking later is offered, but not the time it moves to
+	nb, err := s.SwapBooking(b0, "sl-b", interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 37, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 47, 0, 0, time.UTC),
+	}, "user1")
+	assert.NoError(t, err)
+
+	ws, err := s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ws))
+	assert.Equal(t, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 36, 0, 0, time.UTC),
+	}, ws[0].Offered)
+
+	ws, err = s.GetWaitlistFor("user3")
+	assert.NoError(t, err)
+	assert.Equal(t, []WaitlistEntry{w3}, ws)
+
+	// the time freed by shortening a started booking is offered
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 37, 30, 0, time.UTC) })
+
+	_, err = s.GetActivity(nb)
+	assert.NoError(t, err)
+
+	_, err = s.ResizeBooking(nb, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 37, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 39, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	ws, err = s.GetWaitlistFor("user3")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ws))
+	assert.Equal(t, interval.Interval{
+		Start: time.Date(2022, 11, 5, 1, 40, 0, 0, time.UTC),
+		End:   time.Date(2022, 11, 5, 1, 47, 0, 0, time.UTC),
+	}, ws[0].Offered)
+
+	// user2's offer is unchanged
+	ws, err = s.GetWaitlistFor("user2")
+	assert.NoError(t, err)
+	assert.Equal(t, w2.Name, ws[0].Name)
+	assert.Equal(t, time.Date(2022, 11, 5, 1, 30, 0, 0, time.UTC), ws[0].Offered.Start)
+
+	// the offers are replayed from the history
+	s2 := New()
+	s2.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 37, 30, 0, time.UTC) })
+
+	err, msg := s2.Replay(h.NewReplayAll())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+	assert.Equal(t, s.ExportBookings(), s2.ExportBookings())
+	assert.Equal(t, s.ExportSnapshot().Waitlist, s2.ExportSnapshot().Waitlist)
+}
@@ -0,0 +1,160 @@
+package store
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+
+	// embed the time zone database so that window rules work on hosts without one
+	_ "time/tzdata"
+)
+
+// WindowRule is a recurring period, e.g. weekdays 09:00-17:00 Europe/London for a semester,
+// that is expanded into an interval on each day from From until Until (inclusive) that is
+// one of the Days, or every day if no Days are given. Start and End are times of day in the
+// format 15:04, in the TimeZone (UTC if not given), so the intervals follow any changes to
+// daylight saving time. From and Until are taken as dates, ignoring the time of day.
+type WindowRule struct {
+	Days     []string  `json:"days,omitempty"  yaml:"days,omitempty"`
+	End      string    `json:"end"  yaml:"end"`
+	From     time.Time `json:"from"  yaml:"from"`
+	Start    string    `json:"start"  yaml:"start"`
+	TimeZone string    `json:"time_zone,omitempty"  yaml:"time_zone,omitempty"`
+	Until    time.Time `json:"until"  yaml:"until"`
+}
+
+// weekdays maps the names of days used in window rules to their weekday
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// intervals returns the intervals on each day that the rule applies
+func (r WindowRule) intervals() ([]interval.Interval, error) {
+
+	loc := time.UTC
+
+	if r.TimeZone != "" {
+		l, err := time.LoadLocation(r.TimeZone)
+		if err != nil {
+			return []interval.Interval{}, errors.New("unknown time_zone " + r.TimeZone)
+		}
+		loc = l
+	}
+
+	start, err := time.Parse("15:04", r.Start)
+
+	if err != nil {
+		return []interval.Interval{}, errors.New("start " + r.Start + " is not a time of day in the format 15:04")
+	}
+
+	end, err := time.Parse("15:04", r.End)
+
+	if err != nil {
+		return []interval.Interval{}, errors.New("end " + r.End + " is not a time of day in the format 15:04")
+	}
+
+	if !end.After(start) {
+		return []interval.Interval{}, errors.New("end " + r.End + " must be after start " + r.Start)
+	}
+
+	days := make(map[time.Weekday]bool)
+
+	for _, d := range r.Days {
+		wd, ok := weekdays[strings.ToLower(d)]
+		if !ok {
+			return []interval.Interval{}, errors.New("day " + d + " is not a day of the week")
+		}
+		days[wd] = true
+	}
+
+	fy, fm, fd := r.From.Date()
+	uy, um, ud := r.Until.Date()
+
+	from := time.Date(fy, fm, fd, 0, 0, 0, 0, loc)
+	until := time.Date(uy, um, ud, 0, 0, 0, 0, loc)
+
+	if until.Before(from) {
+		return []interval.Interval{}, errors.New("until must not be before from")
+	}
+
+	ii := []interval.Interval{}
+
+	for k := 0; ; k++ {
+
+		day := from.AddDate(0, 0, k)
+
+		if day.After(until) {
+			break
+		}
+
+		if k >= maxOccurrences {
+			return []interval.Interval{}, errors.New("window rule covers more than " + strconv.Itoa(maxOccurrences) + " days")
+		}
+
+		if len(days) > 0 && !days[day.Weekday()] {
+			continue
+		}
+
+		y, m, d := day.Date()
+
+		ii = append(ii, interval.Interval{
+			Start: time.Date(y, m, d, start.Hour(), start.Minute(), 0, 0, loc),
+			End:   time.Date(y, m, d, end.Hour(), end.Minute(), 0, 0, loc),
+		})
+	}
+
+	return ii, nil
+}
+
+// windowAllowed returns the allowed periods of the window, including those of its rules
+func windowAllowed(w Window) ([]interval.Interval, error) {
+
+	allowed := []interval.Interval{}
+
+	allowed = append(allowed, w.Allowed...)
+
+	for _, r := range w.AllowedRules {
+		ii, err := r.intervals()
+		if err != nil {
+			return []interval.Interval{}, err
+		}
+		allowed = append(allowed, ii...)
+	}
+
+	return allowed, nil
+}
+
+// windowDenied returns the denied periods of the window, including those of its rules, and of the
+// blackouts that the window references. Blackouts that do not exist are ignored, because missing
+// references are reported by CheckManifest.
+func windowDenied(w Window, blackouts map[string]Blackout) ([]interval.Interval, error) {
+
+	denied := []interval.Interval{}
+
+	denied = append(denied, w.Denied...)
+
+	for _, r := range w.DeniedRules {
+		ii, err := r.intervals()
+		if err != nil {
+			return []interval.Interval{}, err
+		}
+		denied = append(denied, ii...)
+	}
+
+	for _, b := range w.Blackouts {
+		if bo, ok := blackouts[b]; ok {
+			denied = append(denied, bo.Denied...)
+		}
+	}
+
+	return denied, nil
+}
@@ -0,0 +1,136 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/practable/book/internal/interval"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWindowRuleIntervals(t *testing.T) {
+
+	// the clocks go forward in London on Sunday 26 March 2023
+	r := WindowRule{
+		Days:     []string{"monday", "tuesday", "wednesday", "thursday", "friday"},
+		End:      "17:00",
+		From:     time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+		Start:    "09:00",
+		TimeZone: "Europe/London",
+		Until:    time.Date(2023, 3, 27, 0, 0, 0, 0, time.UTC),
+	}
+
+	ii, err := r.intervals()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(ii))
+
+	// Friday is in GMT, the weekend is skipped, and Monday is in BST
+	assert.True(t, time.Date(2023, 3, 24, 9, 0, 0, 0, time.UTC).Equal(ii[0].Start))
+	assert.True(t, time.Date(2023, 3, 24, 17, 0, 0, 0, time.UTC).Equal(ii[0].End))
+	assert.True(t, time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC).Equal(ii[1].Start))
+	assert.True(t, time.Date(2023, 3, 27, 16, 0, 0, 0, time.UTC).Equal(ii[1].End))
+
+	// every day, in UTC, if no days or time zone are given
+	r.Days = nil
+	r.TimeZone = ""
+
+	ii, err = r.intervals()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(ii))
+	assert.True(t, time.Date(2023, 3, 26, 9, 0, 0, 0, time.UTC).Equal(ii[2].Start))
+
+	r.Days = []string{"funday"}
+	_, err = r.intervals()
+	assert.Error(t, err)
+	assert.Equal(t, "day funday is not a day of the week", err.Error())
+
+	r.Days = nil
+	r.End = "08:00"
+	_, err = r.intervals()
+	assert.Error(t, err)
+	assert.Equal(t, "end 08:00 must be after start 09:00", err.Error())
+}
+
+// ruleManifest replaces the allowed periods of window w-a with a rule for 02:00-05:00 London time
+// on Fridays and Saturdays, and denies 03:00-04:00 on Saturday 5 Nov 2022 with another rule
+func ruleManifest(t *testing.T) Manifest {
+
+	m := Manifest{}
+	err := yaml.Unmarshal(manifestYAML, &m)
+	assert.NoError(t, err)
+
+	m.Windows["w-a"] = Window{
+		AllowedRules: []WindowRule{
+			WindowRule{
+				Days:     []string{"friday", "saturday"},
+				End:      "05:00",
+				From:     time.Date(2022, 11, 4, 0, 0, 0, 0, time.UTC),
+				Start:    "02:00",
+				TimeZone: "Europe/London",
+				Until:    time.Date(2022, 11, 6, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		DeniedRules: []WindowRule{
+			WindowRule{
+				End:   "04:00",
+				From:  time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC),
+				Start: "03:00",
+				Until: time.Date(2022, 11, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	return m
+}
+
+func TestCheckManifestWindowRules(t *testing.T) {
+
+	m := ruleManifest(t)
+
+	err, msg := CheckManifest(m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, msg)
+
+	w := m.Windows["w-a"]
+	w.AllowedRules[0].TimeZone = "Europe/Londres"
+	m.Windows["w-a"] = w
+
+	err, msg = CheckManifest(m)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"failed to create allowed intervals for window w-a: unknown time_zone Europe/Londres"}, msg)
+}
+
+func TestWindowRules(t *testing.T) {
+
+	m := ruleManifest(t)
+
+	s := New()
+	err := s.ReplaceManifest(m)
+	assert.NoError(t, err)
+
+	// the rules are exported as rules, rather than as the intervals they expand into
+	assert.Equal(t, m.Windows["w-a"], s.ExportManifest().Windows["w-a"])
+
+	s.SetNow(func() time.Time { return time.Date(2022, 11, 5, 1, 3, 0, 0, time.UTC) })
+
+	s.AddGroupForUser("u-a", "g-a")
+
+	book := func(sh, sm, eh, em int) (Booking, error) {
+		return s.MakeBooking("sl-a", "u-a", interval.Interval{
+			Start: time.Date(2022, 11, 5, sh, sm, 0, 0, time.UTC),
+			End:   time.Date(2022, 11, 5, eh, em, 0, 0, time.UTC),
+		})
+	}
+
+	_, err = book(2, 0, 2, 30)
+	assert.NoError(t, err)
+
+	// denied by the rule for the day
+	_, err = book(3, 10, 3, 40)
+	assert.Error(t, err)
+
+	// outside the times allowed by the rule
+	_, err = book(5, 10, 5, 40)
+	assert.Error(t, err)
+}